@@ -0,0 +1,18 @@
+-- Create "refresh_tokens" table
+CREATE TABLE "refresh_tokens" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "family_id" uuid NOT NULL,
+  "token_hash" character varying(64) NOT NULL,
+  "expires_at" timestamp NOT NULL,
+  "rotated_at" timestamp NULL,
+  "revoked_at" timestamp NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "refresh_tokens_token_hash_unique" UNIQUE ("token_hash"),
+  CONSTRAINT "refresh_tokens_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "refresh_tokens_family_id_idx" to table: "refresh_tokens"
+CREATE INDEX "refresh_tokens_family_id_idx" ON "refresh_tokens" ("family_id");
+-- Create index "refresh_tokens_user_id_idx" to table: "refresh_tokens"
+CREATE INDEX "refresh_tokens_user_id_idx" ON "refresh_tokens" ("user_id");
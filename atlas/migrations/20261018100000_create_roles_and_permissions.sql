@@ -0,0 +1,49 @@
+-- Create "roles" table
+CREATE TABLE "roles" (
+  "id" smallserial NOT NULL,
+  "name" character varying(50) NOT NULL,
+  "description" character varying(255) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "roles_name_unique" UNIQUE ("name")
+);
+-- Create "permissions" table
+CREATE TABLE "permissions" (
+  "id" smallserial NOT NULL,
+  "name" character varying(100) NOT NULL,
+  "description" character varying(255) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "permissions_name_unique" UNIQUE ("name")
+);
+-- Create "role_permissions" table
+CREATE TABLE "role_permissions" (
+  "role_id" smallint NOT NULL,
+  "permission_id" smallint NOT NULL,
+  PRIMARY KEY ("role_id", "permission_id"),
+  CONSTRAINT "role_permissions_permission_id_fk" FOREIGN KEY ("permission_id") REFERENCES "permissions" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "role_permissions_role_id_fk" FOREIGN KEY ("role_id") REFERENCES "roles" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Seed default roles
+INSERT INTO "roles" ("name", "description") VALUES
+  ('user', 'Regular user managing its own account'),
+  ('admin', 'Administrator with full access to user management'),
+  ('support', 'Support agent with read access to user accounts');
+-- Seed permissions
+INSERT INTO "permissions" ("name", "description") VALUES
+  ('profile:read', 'Read own profile'),
+  ('profile:write', 'Update own profile'),
+  ('users:read', 'Read any user account'),
+  ('users:write', 'Update any user account'),
+  ('users:delete', 'Delete or restore any user account'),
+  ('roles:manage', 'Manage roles and permissions');
+-- Grant permissions to default roles
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT r."id", p."id"
+FROM "roles" r
+JOIN "permissions" p ON
+  (r."name" = 'user' AND p."name" IN ('profile:read', 'profile:write')) OR
+  (r."name" = 'support' AND p."name" IN ('profile:read', 'profile:write', 'users:read')) OR
+  (r."name" = 'admin');
+-- Modify "users" table
+ALTER TABLE "users" ADD CONSTRAINT "users_role_fk" FOREIGN KEY ("role") REFERENCES "roles" ("name") ON UPDATE CASCADE ON DELETE NO ACTION;
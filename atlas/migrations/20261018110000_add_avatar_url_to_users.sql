@@ -0,0 +1,2 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "avatar_url" character varying(2048) NULL;
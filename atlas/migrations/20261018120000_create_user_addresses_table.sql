@@ -0,0 +1,27 @@
+-- Create "user_addresses" table
+CREATE TABLE "user_addresses" (
+  "id" bigserial NOT NULL,
+  "public_id" uuid NOT NULL,
+  "user_id" bigint NOT NULL,
+  "label" character varying(50) NULL,
+  "street" character varying(255) NOT NULL,
+  "number" character varying(20) NOT NULL,
+  "complement" character varying(255) NULL,
+  "neighborhood" character varying(120) NOT NULL,
+  "city" character varying(120) NOT NULL,
+  "state" character(2) NOT NULL,
+  "zip_code" character(8) NOT NULL,
+  "is_default_shipping" boolean NOT NULL DEFAULT false,
+  "is_default_billing" boolean NOT NULL DEFAULT false,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "updated_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_addresses_public_id_unique" UNIQUE ("public_id"),
+  CONSTRAINT "user_addresses_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "user_addresses_user_id_idx" to table: "user_addresses"
+CREATE INDEX "user_addresses_user_id_idx" ON "user_addresses" ("user_id");
+-- Create index "user_addresses_default_shipping_unique" to table: "user_addresses"
+CREATE UNIQUE INDEX "user_addresses_default_shipping_unique" ON "user_addresses" ("user_id") WHERE is_default_shipping;
+-- Create index "user_addresses_default_billing_unique" to table: "user_addresses"
+CREATE UNIQUE INDEX "user_addresses_default_billing_unique" ON "user_addresses" ("user_id") WHERE is_default_billing;
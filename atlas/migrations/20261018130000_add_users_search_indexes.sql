@@ -0,0 +1,10 @@
+-- Enable "pg_trgm" extension
+CREATE EXTENSION IF NOT EXISTS "pg_trgm";
+-- Create index "users_created_at_id_idx" to table: "users"
+CREATE INDEX "users_created_at_id_idx" ON "users" ("created_at", "id");
+-- Create index "users_email_lower_prefix_idx" to table: "users"
+CREATE INDEX "users_email_lower_prefix_idx" ON "users" ((lower((email)::text)) text_pattern_ops);
+-- Create index "users_name_trgm_idx" to table: "users"
+CREATE INDEX "users_name_trgm_idx" ON "users" USING gin ("name" gin_trgm_ops);
+-- Create index "users_role_created_at_id_idx" to table: "users"
+CREATE INDEX "users_role_created_at_id_idx" ON "users" ("role", "created_at", "id");
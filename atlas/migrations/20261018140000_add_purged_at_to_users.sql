@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "purged_at" timestamp NULL;
+-- Create index "users_pending_purge_idx" to table: "users"
+CREATE INDEX "users_pending_purge_idx" ON "users" ("deleted_at") WHERE ((deleted_at IS NOT NULL) AND (purged_at IS NULL));
@@ -0,0 +1,21 @@
+-- Create "data_exports" table
+CREATE TABLE "data_exports" (
+  "id" bigserial NOT NULL,
+  "public_id" uuid NOT NULL,
+  "user_id" bigint NOT NULL,
+  "format" character varying(10) NOT NULL,
+  "status" character varying(20) NOT NULL,
+  "payload" bytea NULL,
+  "failure_reason" text NULL,
+  "requested_at" timestamp NOT NULL DEFAULT now(),
+  "completed_at" timestamp NULL,
+  "expires_at" timestamp NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "data_exports_public_id_unique" UNIQUE ("public_id"),
+  CONSTRAINT "data_exports_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "data_exports_format_check" CHECK ((format)::text = ANY ((ARRAY['json'::character varying, 'zip'::character varying])::text[]))
+);
+-- Create index "data_exports_user_id_idx" to table: "data_exports"
+CREATE INDEX "data_exports_user_id_idx" ON "data_exports" ("user_id", "requested_at");
+-- Create index "data_exports_pending_idx" to table: "data_exports"
+CREATE INDEX "data_exports_pending_idx" ON "data_exports" ("requested_at") WHERE ((status)::text = 'pending'::text);
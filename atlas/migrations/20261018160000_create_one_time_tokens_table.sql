@@ -0,0 +1,15 @@
+-- Create "one_time_tokens" table
+CREATE TABLE "one_time_tokens" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "purpose" character varying(30) NOT NULL,
+  "token_hash" character varying(64) NOT NULL,
+  "expires_at" timestamp NOT NULL,
+  "used_at" timestamp NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "one_time_tokens_token_hash_unique" UNIQUE ("token_hash"),
+  CONSTRAINT "one_time_tokens_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "one_time_tokens_user_id_purpose_idx" to table: "one_time_tokens"
+CREATE INDEX "one_time_tokens_user_id_purpose_idx" ON "one_time_tokens" ("user_id", "purpose") WHERE (used_at IS NULL);
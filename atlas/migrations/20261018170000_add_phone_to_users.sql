@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "phone" character varying(20) NULL, ADD COLUMN "phone_verified_at" timestamp NULL;
+-- Create index "users_verified_phone_unique" to table: "users"
+CREATE UNIQUE INDEX "users_verified_phone_unique" ON "users" ("phone") WHERE ((phone_verified_at IS NOT NULL) AND (deleted_at IS NULL));
@@ -0,0 +1,4 @@
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "email_verified_at" timestamp NULL;
+-- Accounts created before verification existed keep working when it becomes mandatory
+UPDATE "users" SET "email_verified_at" = "created_at";
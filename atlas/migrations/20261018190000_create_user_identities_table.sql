@@ -0,0 +1,15 @@
+-- Create "user_identities" table
+CREATE TABLE "user_identities" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "provider" character varying(30) NOT NULL,
+  "subject" character varying(255) NOT NULL,
+  "email" character varying(255) NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "last_login_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "user_identities_provider_subject_unique" UNIQUE ("provider", "subject"),
+  CONSTRAINT "user_identities_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "user_identities_user_id_idx" to table: "user_identities"
+CREATE INDEX "user_identities_user_id_idx" ON "user_identities" ("user_id");
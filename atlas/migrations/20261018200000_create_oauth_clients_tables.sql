@@ -0,0 +1,53 @@
+-- Create "oauth_clients" table
+CREATE TABLE "oauth_clients" (
+  "id" bigserial NOT NULL,
+  "client_id" character varying(64) NOT NULL,
+  "client_secret_hash" character varying(64) NULL,
+  "name" character varying(100) NOT NULL,
+  "redirect_uris" text[] NOT NULL,
+  "scopes" text[] NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "updated_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "oauth_clients_client_id_unique" UNIQUE ("client_id")
+);
+-- Create "oauth_consents" table
+CREATE TABLE "oauth_consents" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "client_id" bigint NOT NULL,
+  "scopes" text[] NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "updated_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "oauth_consents_user_id_client_id_unique" UNIQUE ("user_id", "client_id"),
+  CONSTRAINT "oauth_consents_client_id_fk" FOREIGN KEY ("client_id") REFERENCES "oauth_clients" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "oauth_consents_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create "oauth_authorization_codes" table
+CREATE TABLE "oauth_authorization_codes" (
+  "id" bigserial NOT NULL,
+  "code_hash" character varying(64) NOT NULL,
+  "client_id" bigint NOT NULL,
+  "user_id" bigint NOT NULL,
+  "redirect_uri" text NOT NULL,
+  "scopes" text[] NOT NULL,
+  "code_challenge" character varying(128) NOT NULL,
+  "expires_at" timestamp NOT NULL,
+  "consumed_at" timestamp NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "oauth_authorization_codes_code_hash_unique" UNIQUE ("code_hash"),
+  CONSTRAINT "oauth_authorization_codes_client_id_fk" FOREIGN KEY ("client_id") REFERENCES "oauth_clients" ("id") ON UPDATE NO ACTION ON DELETE CASCADE,
+  CONSTRAINT "oauth_authorization_codes_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "oauth_authorization_codes_expires_at_idx" to table: "oauth_authorization_codes"
+CREATE INDEX "oauth_authorization_codes_expires_at_idx" ON "oauth_authorization_codes" ("expires_at");
+-- Seed permissions
+INSERT INTO "permissions" ("name", "description") VALUES
+  ('clients:manage', 'Register and manage OAuth clients');
+-- Grant permissions to default roles
+INSERT INTO "role_permissions" ("role_id", "permission_id")
+SELECT r."id", p."id"
+FROM "roles" r
+JOIN "permissions" p ON r."name" = 'admin' AND p."name" = 'clients:manage';
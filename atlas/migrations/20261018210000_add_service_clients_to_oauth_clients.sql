@@ -0,0 +1,2 @@
+-- Modify "oauth_clients" table
+ALTER TABLE "oauth_clients" ADD COLUMN "grant_types" text[] NOT NULL DEFAULT '{authorization_code}', ADD COLUMN "audiences" text[] NOT NULL DEFAULT '{}', ADD COLUMN "disabled_at" timestamp NULL;
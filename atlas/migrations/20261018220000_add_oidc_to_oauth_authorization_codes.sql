@@ -0,0 +1,2 @@
+-- Modify "oauth_authorization_codes" table
+ALTER TABLE "oauth_authorization_codes" ADD COLUMN "nonce" character varying(255) NULL, ADD COLUMN "auth_time" timestamp NULL;
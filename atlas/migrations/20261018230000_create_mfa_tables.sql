@@ -0,0 +1,22 @@
+-- Create "user_mfa" table
+CREATE TABLE "user_mfa" (
+  "user_id" bigint NOT NULL,
+  "totp_secret" character varying(64) NOT NULL,
+  "confirmed_at" timestamp NULL,
+  "last_used_step" bigint NOT NULL DEFAULT 0,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "updated_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("user_id"),
+  CONSTRAINT "user_mfa_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create "mfa_recovery_codes" table
+CREATE TABLE "mfa_recovery_codes" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "code_hash" character varying(64) NOT NULL,
+  "used_at" timestamp NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "mfa_recovery_codes_user_id_code_hash_unique" UNIQUE ("user_id", "code_hash"),
+  CONSTRAINT "mfa_recovery_codes_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
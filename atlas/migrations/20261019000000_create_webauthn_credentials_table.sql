@@ -0,0 +1,21 @@
+-- Create "webauthn_credentials" table
+CREATE TABLE "webauthn_credentials" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "credential_id" character varying(1400) NOT NULL,
+  "public_key" bytea NOT NULL,
+  "algorithm" integer NOT NULL,
+  "sign_count" bigint NOT NULL DEFAULT 0,
+  "transports" text[] NOT NULL DEFAULT '{}',
+  "aaguid" character varying(36) NOT NULL,
+  "backup_eligible" boolean NOT NULL DEFAULT false,
+  "backed_up" boolean NOT NULL DEFAULT false,
+  "name" character varying(100) NOT NULL,
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "last_used_at" timestamp NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "webauthn_credentials_credential_id_unique" UNIQUE ("credential_id"),
+  CONSTRAINT "webauthn_credentials_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "webauthn_credentials_user_id_idx" to table: "webauthn_credentials"
+CREATE INDEX "webauthn_credentials_user_id_idx" ON "webauthn_credentials" ("user_id");
@@ -0,0 +1,22 @@
+-- Create "sessions" table
+CREATE TABLE "sessions" (
+  "id" bigserial NOT NULL,
+  "user_id" bigint NOT NULL,
+  "family_id" uuid NOT NULL,
+  "device_name" character varying(100) NOT NULL DEFAULT '',
+  "user_agent" character varying(512) NOT NULL DEFAULT '',
+  "ip_address" character varying(45) NOT NULL DEFAULT '',
+  "created_at" timestamp NOT NULL DEFAULT now(),
+  "last_seen_at" timestamp NOT NULL DEFAULT now(),
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sessions_family_id_unique" UNIQUE ("family_id"),
+  CONSTRAINT "sessions_user_id_fk" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "sessions_user_id_idx" to table: "sessions"
+CREATE INDEX "sessions_user_id_idx" ON "sessions" ("user_id");
+-- Backfill the sessions of refresh token families still in use
+INSERT INTO "sessions" ("user_id", "family_id", "created_at", "last_seen_at")
+SELECT "user_id", "family_id", MIN("created_at"), MAX("created_at")
+FROM "refresh_tokens"
+GROUP BY "user_id", "family_id"
+HAVING bool_or("revoked_at" IS NULL AND "rotated_at" IS NULL AND "expires_at" > now());
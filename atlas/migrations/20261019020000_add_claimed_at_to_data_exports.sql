@@ -0,0 +1,4 @@
+-- Modify "data_exports" table
+ALTER TABLE "data_exports" ADD COLUMN "claimed_at" timestamp NULL;
+-- Let exports stuck in processing be reclaimed
+UPDATE "data_exports" SET "claimed_at" = "requested_at" WHERE "status" = 'processing';
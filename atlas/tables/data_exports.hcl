@@ -0,0 +1,75 @@
+table "data_exports" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "public_id" {
+    type     = uuid
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "format" {
+    type     = varchar(10)
+    null     = false
+  }
+  column "status" {
+    type     = varchar(20)
+    null     = false
+  }
+  column "payload" {
+    type = bytea
+    null = true
+  }
+  column "failure_reason" {
+    type = text
+    null = true
+  }
+  column "requested_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+  column "claimed_at" {
+    type = timestamp
+    null = true
+  }
+  column "completed_at" {
+    type = timestamp
+    null = true
+  }
+  column "expires_at" {
+    type = timestamp
+    null = true
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  unique "data_exports_public_id_unique" {
+    columns = [column.public_id]
+  }
+
+  foreign_key "data_exports_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  index "data_exports_user_id_idx" {
+    columns = [column.user_id, column.requested_at]
+  }
+
+  index "data_exports_pending_idx" {
+    columns = [column.requested_at]
+    where   = "((status)::text = 'pending'::text)"
+  }
+
+  check "data_exports_format_check" {
+    expr = "((format)::text = ANY ((ARRAY['json'::character varying, 'zip'::character varying])::text[]))"
+  }
+}
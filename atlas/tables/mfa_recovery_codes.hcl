@@ -0,0 +1,38 @@
+table "mfa_recovery_codes" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "code_hash" {
+    type     = varchar(64)
+    null     = false
+  }
+  column "used_at" {
+    type = timestamp
+    null = true
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "mfa_recovery_codes_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "mfa_recovery_codes_user_id_code_hash_unique" {
+    columns = [column.user_id, column.code_hash]
+  }
+}
@@ -0,0 +1,76 @@
+table "oauth_authorization_codes" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "code_hash" {
+    type     = varchar(64)
+    null     = false
+  }
+  column "client_id" {
+    type     = bigint
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "redirect_uri" {
+    type     = text
+    null     = false
+  }
+  column "scopes" {
+    type     = sql("text[]")
+    null     = false
+  }
+  column "code_challenge" {
+    type     = varchar(128)
+    null     = false
+  }
+  column "nonce" {
+    type = varchar(255)
+    null = true
+  }
+  column "auth_time" {
+    type = timestamp
+    null = true
+  }
+  column "expires_at" {
+    type     = timestamp
+    null     = false
+  }
+  column "consumed_at" {
+    type = timestamp
+    null = true
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "oauth_authorization_codes_client_id_fk" {
+    columns     = [column.client_id]
+    ref_columns = [table.oauth_clients.column.id]
+    on_delete   = CASCADE
+  }
+
+  foreign_key "oauth_authorization_codes_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "oauth_authorization_codes_code_hash_unique" {
+    columns = [column.code_hash]
+  }
+
+  index "oauth_authorization_codes_expires_at_idx" {
+    columns = [column.expires_at]
+  }
+}
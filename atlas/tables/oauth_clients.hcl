@@ -0,0 +1,59 @@
+table "oauth_clients" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "client_id" {
+    type     = varchar(64)
+    null     = false
+  }
+  column "client_secret_hash" {
+    type = varchar(64)
+    null = true
+  }
+  column "name" {
+    type     = varchar(100)
+    null     = false
+  }
+  column "redirect_uris" {
+    type     = sql("text[]")
+    null     = false
+  }
+  column "scopes" {
+    type     = sql("text[]")
+    null     = false
+  }
+  column "grant_types" {
+    type     = sql("text[]")
+    default  = sql("'{authorization_code}'")
+    null     = false
+  }
+  column "audiences" {
+    type     = sql("text[]")
+    default  = sql("'{}'")
+    null     = false
+  }
+  column "disabled_at" {
+    type = timestamp
+    null = true
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+  column "updated_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  unique "oauth_clients_client_id_unique" {
+    columns = [column.client_id]
+  }
+}
@@ -0,0 +1,49 @@
+table "oauth_consents" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "client_id" {
+    type     = bigint
+    null     = false
+  }
+  column "scopes" {
+    type     = sql("text[]")
+    null     = false
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+  column "updated_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "oauth_consents_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  foreign_key "oauth_consents_client_id_fk" {
+    columns     = [column.client_id]
+    ref_columns = [table.oauth_clients.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "oauth_consents_user_id_client_id_unique" {
+    columns = [column.user_id, column.client_id]
+  }
+}
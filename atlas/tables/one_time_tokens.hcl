@@ -0,0 +1,51 @@
+table "one_time_tokens" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "purpose" {
+    type     = varchar(30)
+    null     = false
+  }
+  column "token_hash" {
+    type     = varchar(64)
+    null     = false
+  }
+  column "expires_at" {
+    type     = timestamp
+    null     = false
+  }
+  column "used_at" {
+    type = timestamp
+    null = true
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "one_time_tokens_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "one_time_tokens_token_hash_unique" {
+    columns = [column.token_hash]
+  }
+
+  index "one_time_tokens_user_id_purpose_idx" {
+    columns = [column.user_id, column.purpose]
+    where   = "(used_at IS NULL)"
+  }
+}
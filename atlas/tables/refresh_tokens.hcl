@@ -0,0 +1,58 @@
+table "refresh_tokens" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "family_id" {
+    type     = uuid
+    null     = false
+  }
+  column "token_hash" {
+    type     = varchar(64)
+    null     = false
+  }
+  column "expires_at" {
+    type     = timestamp
+    null     = false
+  }
+  column "rotated_at" {
+    type = timestamp
+    null = true
+  }
+  column "revoked_at" {
+    type = timestamp
+    null = true
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "refresh_tokens_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "refresh_tokens_token_hash_unique" {
+    columns = [column.token_hash]
+  }
+
+  index "refresh_tokens_family_id_idx" {
+    columns = [column.family_id]
+  }
+
+  index "refresh_tokens_user_id_idx" {
+    columns = [column.user_id]
+  }
+}
@@ -0,0 +1,85 @@
+table "roles" {
+  schema = schema.public
+  column "id" {
+    type = smallserial
+    null = false
+  }
+  column "name" {
+    type = varchar(50)
+    null = false
+  }
+  column "description" {
+    type = varchar(255)
+    null = false
+  }
+  column "created_at" {
+    type    = timestamp
+    default = sql("now()")
+    null    = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  unique "roles_name_unique" {
+    columns = [column.name]
+  }
+}
+
+table "permissions" {
+  schema = schema.public
+  column "id" {
+    type = smallserial
+    null = false
+  }
+  column "name" {
+    type = varchar(100)
+    null = false
+  }
+  column "description" {
+    type = varchar(255)
+    null = false
+  }
+  column "created_at" {
+    type    = timestamp
+    default = sql("now()")
+    null    = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  unique "permissions_name_unique" {
+    columns = [column.name]
+  }
+}
+
+table "role_permissions" {
+  schema = schema.public
+  column "role_id" {
+    type = smallint
+    null = false
+  }
+  column "permission_id" {
+    type = smallint
+    null = false
+  }
+
+  primary_key {
+    columns = [column.role_id, column.permission_id]
+  }
+
+  foreign_key "role_permissions_role_id_fk" {
+    columns     = [column.role_id]
+    ref_columns = [table.roles.column.id]
+    on_delete   = CASCADE
+  }
+
+  foreign_key "role_permissions_permission_id_fk" {
+    columns     = [column.permission_id]
+    ref_columns = [table.permissions.column.id]
+    on_delete   = CASCADE
+  }
+}
@@ -0,0 +1,58 @@
+table "sessions" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "family_id" {
+    type     = uuid
+    null     = false
+  }
+  column "device_name" {
+    type     = varchar(100)
+    default  = ""
+    null     = false
+  }
+  column "user_agent" {
+    type     = varchar(512)
+    default  = ""
+    null     = false
+  }
+  column "ip_address" {
+    type     = varchar(45)
+    default  = ""
+    null     = false
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+  column "last_seen_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "sessions_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "sessions_family_id_unique" {
+    columns = [column.family_id]
+  }
+
+  index "sessions_user_id_idx" {
+    columns = [column.user_id]
+  }
+}
@@ -0,0 +1,97 @@
+table "user_addresses" {
+  schema = schema.public
+  column "id" {
+    type = bigserial
+    null = false
+  }
+  column "public_id" {
+    type = uuid
+    null = false
+  }
+  column "user_id" {
+    type = bigint
+    null = false
+  }
+  column "label" {
+    type = varchar(50)
+    null = true
+  }
+  column "street" {
+    type = varchar(255)
+    null = false
+  }
+  column "number" {
+    type = varchar(20)
+    null = false
+  }
+  column "complement" {
+    type = varchar(255)
+    null = true
+  }
+  column "neighborhood" {
+    type = varchar(120)
+    null = false
+  }
+  column "city" {
+    type = varchar(120)
+    null = false
+  }
+  column "state" {
+    type = char(2)
+    null = false
+  }
+  column "zip_code" {
+    type = char(8)
+    null = false
+  }
+  column "is_default_shipping" {
+    type    = boolean
+    default = false
+    null    = false
+  }
+  column "is_default_billing" {
+    type    = boolean
+    default = false
+    null    = false
+  }
+  column "created_at" {
+    type    = timestamp
+    default = sql("now()")
+    null    = false
+  }
+  column "updated_at" {
+    type    = timestamp
+    default = sql("now()")
+    null    = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "user_addresses_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "user_addresses_public_id_unique" {
+    columns = [column.public_id]
+  }
+
+  index "user_addresses_user_id_idx" {
+    columns = [column.user_id]
+  }
+
+  index "user_addresses_default_shipping_unique" {
+    unique  = true
+    columns = [column.user_id]
+    where   = "is_default_shipping"
+  }
+
+  index "user_addresses_default_billing_unique" {
+    unique  = true
+    columns = [column.user_id]
+    where   = "is_default_billing"
+  }
+}
@@ -0,0 +1,51 @@
+table "user_identities" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "provider" {
+    type     = varchar(30)
+    null     = false
+  }
+  column "subject" {
+    type     = varchar(255)
+    null     = false
+  }
+  column "email" {
+    type = varchar(255)
+    null = true
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+  column "last_login_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "user_identities_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "user_identities_provider_subject_unique" {
+    columns = [column.provider, column.subject]
+  }
+
+  index "user_identities_user_id_idx" {
+    columns = [column.user_id]
+  }
+}
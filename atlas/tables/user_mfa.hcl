@@ -0,0 +1,40 @@
+table "user_mfa" {
+  schema = schema.public
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "totp_secret" {
+    type     = varchar(64)
+    null     = false
+  }
+  column "confirmed_at" {
+    type = timestamp
+    null = true
+  }
+  column "last_used_step" {
+    type     = bigint
+    default  = 0
+    null     = false
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+  column "updated_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+
+  primary_key {
+    columns = [column.user_id]
+  }
+
+  foreign_key "user_mfa_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+}
@@ -14,6 +14,10 @@ table "users" {
     type     = varchar(255)
     null     = false
   }
+  column "email_verified_at" {
+    type = timestamp
+    null = true
+  }
   column "password_hash" {
     type     = text
     null     = false
@@ -26,6 +30,18 @@ table "users" {
     type     = varchar(50)
     null     = false
   }
+  column "avatar_url" {
+    type = varchar(2048)
+    null = true
+  }
+  column "phone" {
+    type = varchar(20)
+    null = true
+  }
+  column "phone_verified_at" {
+    type = timestamp
+    null = true
+  }
   column "created_at" {
     type     = timestamp
     default  = sql("now()")
@@ -40,6 +56,10 @@ table "users" {
     type = timestamp
     null = true
   }
+  column "purged_at" {
+    type = timestamp
+    null = true
+  }
 
   primary_key {
     columns = [column.id]
@@ -53,5 +73,46 @@ table "users" {
     columns = [column.email]
   }
 
+  index "users_verified_phone_unique" {
+    unique  = true
+    columns = [column.phone]
+    where   = "(phone_verified_at IS NOT NULL) AND (deleted_at IS NULL)"
+  }
+
+  index "users_pending_purge_idx" {
+    columns = [column.deleted_at]
+    where   = "(deleted_at IS NOT NULL) AND (purged_at IS NULL)"
+  }
+
+  index "users_created_at_id_idx" {
+    columns = [column.created_at, column.id]
+  }
+
+  index "users_role_created_at_id_idx" {
+    columns = [column.role, column.created_at, column.id]
+  }
+
+  index "users_email_lower_prefix_idx" {
+    on {
+      expr = "lower((email)::text)"
+      ops  = text_pattern_ops
+    }
+  }
+
+  index "users_name_trgm_idx" {
+    type = GIN
+    on {
+      column = column.name
+      ops    = "gin_trgm_ops"
+    }
+  }
+
+  foreign_key "users_role_fk" {
+    columns     = [column.role]
+    ref_columns = [table.roles.column.name]
+    on_update   = CASCADE
+    on_delete   = NO_ACTION
+  }
+
 
 }
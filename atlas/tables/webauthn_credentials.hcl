@@ -0,0 +1,78 @@
+table "webauthn_credentials" {
+  schema = schema.public
+  column "id" {
+    type     = bigserial
+    null     = false
+  }
+  column "user_id" {
+    type     = bigint
+    null     = false
+  }
+  column "credential_id" {
+    type     = varchar(1400)
+    null     = false
+  }
+  column "public_key" {
+    type     = bytea
+    null     = false
+  }
+  column "algorithm" {
+    type     = integer
+    null     = false
+  }
+  column "sign_count" {
+    type     = bigint
+    default  = 0
+    null     = false
+  }
+  column "transports" {
+    type     = sql("text[]")
+    default  = sql("'{}'")
+    null     = false
+  }
+  column "aaguid" {
+    type     = varchar(36)
+    null     = false
+  }
+  column "backup_eligible" {
+    type    = boolean
+    default = false
+    null    = false
+  }
+  column "backed_up" {
+    type    = boolean
+    default = false
+    null    = false
+  }
+  column "name" {
+    type     = varchar(100)
+    null     = false
+  }
+  column "created_at" {
+    type     = timestamp
+    default  = sql("now()")
+    null     = false
+  }
+  column "last_used_at" {
+    type = timestamp
+    null = true
+  }
+
+  primary_key {
+    columns = [column.id]
+  }
+
+  foreign_key "webauthn_credentials_user_id_fk" {
+    columns     = [column.user_id]
+    ref_columns = [table.users.column.id]
+    on_delete   = CASCADE
+  }
+
+  unique "webauthn_credentials_credential_id_unique" {
+    columns = [column.credential_id]
+  }
+
+  index "webauthn_credentials_user_id_idx" {
+    columns = [column.user_id]
+  }
+}
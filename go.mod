@@ -5,6 +5,7 @@ go 1.25.0
 require (
 	github.com/amirsalarsafaei/sqlc-pgx-monitoring v1.6.0
 	github.com/go-chi/chi/v5 v5.2.2
+	github.com/golang-jwt/jwt/v5 v5.3.1
 	github.com/google/uuid v1.6.0
 	github.com/jackc/pgx/v5 v5.7.5
 	github.com/lmittmann/tint v1.1.2
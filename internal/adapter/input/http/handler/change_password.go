@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type ChangePasswordHandler struct {
+	command    command.ChangePassword
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewChangePasswordHandler(
+	cmd command.ChangePassword,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *ChangePasswordHandler {
+	return &ChangePasswordHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *ChangePasswordHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "ChangePasswordHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	input, err := helpers2.RequestDecoder[dto.ChangePasswordInput](r)
+	if err != nil {
+		span.RecordError(err)
+		h.log.ErrorJSON("failed decode request body",
+			slog.String("trace_id", traceID),
+			slog.Any("error", err))
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me/password", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	if err := h.command.Execute(ctx, input); err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me/password", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseSuccess[any](w, http.StatusNoContent, nil)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/users/me/password", "http", http.StatusNoContent, "success", float64(duration.Milliseconds()))
+}
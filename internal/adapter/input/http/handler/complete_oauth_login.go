@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type CompleteOAuthLoginHandler struct {
+	command    command.CompleteOAuthLogin
+	cookie     oauthStateCookie
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewCompleteOAuthLoginHandler(
+	cmd command.CompleteOAuthLogin,
+	callbackBaseURL string,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *CompleteOAuthLoginHandler {
+	return &CompleteOAuthLoginHandler{
+		command:    cmd,
+		cookie:     newOAuthStateCookie(callbackBaseURL),
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *CompleteOAuthLoginHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "CompleteOAuthLoginHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	params := r.URL.Query()
+	input := dto.CompleteOAuthLoginInput{
+		Provider:     chi.URLParam(r, "provider"),
+		Code:         params.Get("code"),
+		State:        params.Get("state"),
+		StateBinding: h.cookie.read(r),
+		Error:        params.Get("error"),
+	}
+	// the state is single use, so the binding is dropped whatever the outcome
+	h.cookie.clear(w)
+
+	res, err := h.command.Execute(ctx, input)
+	if err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/auth/oauth/{provider}/callback", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseSuccess(w, http.StatusOK, res)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/auth/oauth/{provider}/callback", "http", http.StatusOK, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type ConfirmMFAEnrollmentHandler struct {
+	command    command.ConfirmMFAEnrollment
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewConfirmMFAEnrollmentHandler(
+	cmd command.ConfirmMFAEnrollment,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *ConfirmMFAEnrollmentHandler {
+	return &ConfirmMFAEnrollmentHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *ConfirmMFAEnrollmentHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "ConfirmMFAEnrollmentHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	input, err := helpers2.RequestDecoder[dto.MFACodeInput](r)
+	if err != nil {
+		span.RecordError(err)
+		h.log.ErrorJSON("failed decode request body",
+			slog.String("trace_id", traceID),
+			slog.Any("error", err))
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me/mfa/totp/confirm", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	res, err := h.command.Execute(ctx, input)
+	if err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me/mfa/totp/confirm", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	helpers2.ResponseSuccess(w, http.StatusOK, res)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/users/me/mfa/totp/confirm", "http", http.StatusOK, "success", float64(duration.Milliseconds()))
+}
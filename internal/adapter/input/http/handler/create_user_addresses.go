@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type CreateUserAddressesHandler struct {
+	command    command.CreateUserAddresses
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewCreateUserAddressesHandler(
+	cmd command.CreateUserAddresses,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *CreateUserAddressesHandler {
+	return &CreateUserAddressesHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *CreateUserAddressesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "CreateUserAddressesHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	input, err := helpers2.RequestDecoder[dto.CreateUserAddressesInput](r)
+	if err != nil {
+		span.RecordError(err)
+		h.log.ErrorJSON("failed decode request body",
+			slog.String("trace_id", traceID),
+			slog.Any("error", err))
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me/addresses", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	res, err := h.command.Execute(ctx, input)
+	if err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me/addresses", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseSuccess(w, http.StatusCreated, res)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/users/me/addresses", "http", http.StatusCreated, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type DeleteCurrentUserHandler struct {
+	command    command.DeleteCurrentUser
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewDeleteCurrentUserHandler(
+	cmd command.DeleteCurrentUser,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *DeleteCurrentUserHandler {
+	return &DeleteCurrentUserHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *DeleteCurrentUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "DeleteCurrentUserHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	res, err := h.command.Execute(ctx)
+	if err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseSuccess(w, http.StatusAccepted, res)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/users/me", "http", http.StatusAccepted, "success", float64(duration.Milliseconds()))
+}
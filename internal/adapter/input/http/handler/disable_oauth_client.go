@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type DisableOAuthClientHandler struct {
+	command    command.DisableOAuthClient
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewDisableOAuthClientHandler(
+	cmd command.DisableOAuthClient,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *DisableOAuthClientHandler {
+	return &DisableOAuthClientHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *DisableOAuthClientHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "DisableOAuthClientHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	input := dto.DisableOAuthClientInput{ClientID: chi.URLParam(r, "client_id")}
+
+	if err := h.command.Execute(ctx, input); err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/admin/oauth/clients/{client_id}/disable", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseSuccess[any](w, http.StatusNoContent, nil)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/admin/oauth/clients/{client_id}/disable", "http", http.StatusNoContent, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type DownloadDataExportHandler struct {
+	query      query.DownloadDataExport
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewDownloadDataExportHandler(
+	qry query.DownloadDataExport,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *DownloadDataExportHandler {
+	return &DownloadDataExportHandler{
+		query:      qry,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *DownloadDataExportHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "DownloadDataExportHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	params := r.URL.Query()
+	input := dto.DownloadDataExportInput{
+		ExportID:  chi.URLParam(r, "id"),
+		Expires:   params.Get("expires"),
+		Signature: params.Get("signature"),
+	}
+
+	res, err := h.query.Execute(ctx, input)
+	if err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/users/me/exports/{id}/download", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseFile(w, http.StatusOK, res.FileName, res.ContentType, res.Content)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/users/me/exports/{id}/download", "http", http.StatusOK, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type ExchangeOAuthTokenHandler struct {
+	command    command.ExchangeOAuthToken
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewExchangeOAuthTokenHandler(
+	cmd command.ExchangeOAuthToken,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *ExchangeOAuthTokenHandler {
+	return &ExchangeOAuthTokenHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *ExchangeOAuthTokenHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "ExchangeOAuthTokenHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	if err := r.ParseForm(); err != nil {
+		oauthErr := errors.ErrorOAuthInvalidRequest("The request body must be form encoded.")
+		span.RecordError(oauthErr)
+		status := helpers2.ResponseOAuthError(w, oauthErr)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/oauth/token", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	input := dto.OAuthTokenInput{
+		GrantType:    r.PostForm.Get("grant_type"),
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		ClientID:     r.PostForm.Get("client_id"),
+		ClientSecret: r.PostForm.Get("client_secret"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		Scope:        r.PostForm.Get("scope"),
+		Audience:     r.PostForm.Get("audience"),
+	}
+	basicAuth := false
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		// RFC 6749 §2.3.1: the credentials are form encoded before Basic encoding
+		input.ClientID, _ = url.QueryUnescape(clientID)
+		input.ClientSecret, _ = url.QueryUnescape(clientSecret)
+		basicAuth = true
+	}
+
+	res, err := h.command.Execute(ctx, input)
+	if err != nil {
+		if basicAuth && err.OAuthCode() == "invalid_client" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+		}
+		status := helpers2.ResponseOAuthError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/oauth/token", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	helpers2.ResponseSuccess(w, http.StatusOK, res)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/oauth/token", "http", http.StatusOK, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/port/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type GetOpenIDConfigurationHandler struct {
+	query      query.GetOpenIDConfiguration
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewGetOpenIDConfigurationHandler(
+	qry query.GetOpenIDConfiguration,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *GetOpenIDConfigurationHandler {
+	return &GetOpenIDConfigurationHandler{
+		query:      qry,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *GetOpenIDConfigurationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "GetOpenIDConfigurationHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	res := h.query.Execute(ctx)
+
+	// the advertised algorithms follow the key set, so both are cached alike
+	w.Header().Set("Cache-Control", jwksMaxAge)
+	helpers2.ResponseSuccess(w, http.StatusOK, res)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/.well-known/openid-configuration", "http", http.StatusOK, "success", float64(duration.Milliseconds()))
+}
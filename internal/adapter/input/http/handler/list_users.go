@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type ListUsersHandler struct {
+	query      query.ListUsers
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewListUsersHandler(
+	qry query.ListUsers,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *ListUsersHandler {
+	return &ListUsersHandler{
+		query:      qry,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *ListUsersHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "ListUsersHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	params := r.URL.Query()
+	input := dto.ListUsersInput{
+		Role:        params.Get("role"),
+		CreatedFrom: params.Get("created_from"),
+		CreatedTo:   params.Get("created_to"),
+		Deleted:     params.Get("deleted"),
+		Email:       params.Get("email"),
+		Name:        params.Get("name"),
+		Sort:        params.Get("sort"),
+		Limit:       params.Get("limit"),
+		Cursor:      params.Get("cursor"),
+	}
+
+	res, err := h.query.Execute(ctx, input)
+	if err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/admin/users", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseSuccess(w, http.StatusOK, res)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/admin/users", "http", http.StatusOK, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type LogoutAuthUserHandler struct {
+	command    command.LogoutAuthUser
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewLogoutAuthUserHandler(
+	cmd command.LogoutAuthUser,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *LogoutAuthUserHandler {
+	return &LogoutAuthUserHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *LogoutAuthUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "LogoutAuthUserHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	var input dto.LogoutAuthUserInput
+	if r.ContentLength > 0 {
+		decoded, err := helpers2.RequestDecoder[dto.LogoutAuthUserInput](r)
+		if err != nil {
+			span.RecordError(err)
+			h.log.ErrorJSON("failed decode request body",
+				slog.String("trace_id", traceID),
+				slog.Any("error", err))
+			status := helpers2.ResponseError(w, err)
+			duration := time.Since(start)
+			h.prometheus.ObserveRequestDuration("/auth/logout", "http", status, "error", float64(duration.Milliseconds()))
+			return
+		}
+		input = decoded
+	}
+	input.AccessToken = helpers2.BearerToken(r)
+
+	if err := h.command.Execute(ctx, input); err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/auth/logout", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	helpers2.ResponseSuccess[any](w, http.StatusNoContent, nil)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/auth/logout", "http", http.StatusNoContent, "success", float64(duration.Milliseconds()))
+}
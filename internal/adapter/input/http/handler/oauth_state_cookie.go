@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const oauthStateCookieName = "oauth_state"
+
+// oauthStateCookie keeps the state binding of a social login in the browser
+// that started it. It is HttpOnly, sent only to the callbacks under
+// OAUTH_CALLBACK_BASE_URL, and SameSite=Lax so the provider redirect still
+// carries it.
+type oauthStateCookie struct {
+	path   string
+	secure bool
+}
+
+func newOAuthStateCookie(callbackBaseURL string) oauthStateCookie {
+	cookie := oauthStateCookie{path: "/"}
+	if base, err := url.Parse(callbackBaseURL); err == nil {
+		if path := strings.TrimSuffix(base.Path, "/"); path != "" {
+			cookie.path = path
+		}
+		cookie.secure = base.Scheme == "https"
+	}
+	return cookie
+}
+
+func (c oauthStateCookie) set(w http.ResponseWriter, binding string, ttl time.Duration) {
+	http.SetCookie(w, c.cookie(binding, int(ttl.Seconds())))
+}
+
+func (c oauthStateCookie) read(r *http.Request) string {
+	cookie, err := r.Cookie(oauthStateCookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
+
+func (c oauthStateCookie) clear(w http.ResponseWriter) {
+	http.SetCookie(w, c.cookie("", -1))
+}
+
+func (c oauthStateCookie) cookie(value string, maxAge int) *http.Cookie {
+	return &http.Cookie{
+		Name:     oauthStateCookieName,
+		Value:    value,
+		Path:     c.path,
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   c.secure,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type PrepareOAuthAuthorizationHandler struct {
+	command    command.PrepareOAuthAuthorization
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewPrepareOAuthAuthorizationHandler(
+	cmd command.PrepareOAuthAuthorization,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *PrepareOAuthAuthorizationHandler {
+	return &PrepareOAuthAuthorizationHandler{
+		command:    cmd,
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *PrepareOAuthAuthorizationHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "PrepareOAuthAuthorizationHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	query := r.URL.Query()
+	input := dto.OAuthAuthorizationRequest{
+		ResponseType:        query.Get("response_type"),
+		ClientID:            query.Get("client_id"),
+		RedirectURI:         query.Get("redirect_uri"),
+		Scope:               query.Get("scope"),
+		State:               query.Get("state"),
+		CodeChallenge:       query.Get("code_challenge"),
+		CodeChallengeMethod: query.Get("code_challenge_method"),
+		Nonce:               query.Get("nonce"),
+	}
+
+	res, err := h.command.Execute(ctx, input)
+	if err != nil {
+		status := helpers2.ResponseOAuthError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/oauth/authorize", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	http.Redirect(w, r, res.RedirectTo, http.StatusFound)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/oauth/authorize", "http", http.StatusFound, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	helpers2 "github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type StartOAuthLoginHandler struct {
+	command    command.StartOAuthLogin
+	cookie     oauthStateCookie
+	log        adapter2.Logger
+	prometheus adapter2.Prometheus
+	tracer     adapter2.Tracer
+}
+
+func NewStartOAuthLoginHandler(
+	cmd command.StartOAuthLogin,
+	callbackBaseURL string,
+	prometheus adapter2.Prometheus,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *StartOAuthLoginHandler {
+	return &StartOAuthLoginHandler{
+		command:    cmd,
+		cookie:     newOAuthStateCookie(callbackBaseURL),
+		log:        log,
+		prometheus: prometheus,
+		tracer:     tracer,
+	}
+}
+
+func (h *StartOAuthLoginHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	ctx, span := h.tracer.Start(r.Context(), "StartOAuthLoginHandler.Handle")
+	traceID := span.SpanContext().TraceID()
+	defer func() {
+		end := time.Since(start)
+		h.log.InfoJSON(
+			"end request",
+			slog.String("trace_id", traceID),
+			slog.Float64("duration", float64(end.Milliseconds())))
+		span.End()
+	}()
+
+	input := dto.StartOAuthLoginInput{Provider: chi.URLParam(r, "provider")}
+
+	res, err := h.command.Execute(ctx, input)
+	if err != nil {
+		status := helpers2.ResponseError(w, err)
+		duration := time.Since(start)
+		h.prometheus.ObserveRequestDuration("/auth/oauth/{provider}/start", "http", status, "error", float64(duration.Milliseconds()))
+		return
+	}
+
+	h.cookie.set(w, res.StateBinding, res.StateTTL)
+	http.Redirect(w, r, res.AuthorizationURL, http.StatusFound)
+	duration := time.Since(start)
+	h.prometheus.ObserveRequestDuration("/auth/oauth/{provider}/start", "http", http.StatusFound, "success", float64(duration.Milliseconds()))
+}
@@ -0,0 +1,21 @@
+package helpers
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	Authorization = "Authorization"
+	BearerPrefix  = "Bearer "
+)
+
+// BearerToken extracts the token from an "Authorization: Bearer <token>" header.
+// It returns an empty string when the header is missing or uses another scheme.
+func BearerToken(r *http.Request) string {
+	header := r.Header.Get(Authorization)
+	if len(header) <= len(BearerPrefix) || !strings.EqualFold(header[:len(BearerPrefix)], BearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(header[len(BearerPrefix):])
+}
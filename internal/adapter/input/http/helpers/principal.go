@@ -0,0 +1,12 @@
+package helpers
+
+import (
+	"net/http"
+
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// Principal returns the caller stored by the authentication middleware.
+func Principal(r *http.Request) (vo.Principal, bool) {
+	return vo.PrincipalFromContext(r.Context())
+}
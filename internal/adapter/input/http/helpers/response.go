@@ -2,7 +2,9 @@ package helpers
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/andreis3/auth-ms/internal/adapter/input/translator"
 	"github.com/andreis3/auth-ms/internal/domain/errors"
@@ -39,6 +41,9 @@ func ResponseSuccess[T any](write http.ResponseWriter, status int, data T) {
 func ResponseError(write http.ResponseWriter, err *errors.Error) int {
 	status := translator.ErrorTranslator[err.Code].HTTPStatus
 	write.Header().Set(ContentType, ApplicationJSON)
+	if retryAfter, ok := err.Fields[errors.RetryAfterField].(int64); ok {
+		write.Header().Set("Retry-After", strconv.FormatInt(retryAfter, 10))
+	}
 	write.WriteHeader(status)
 
 	result := TypeResponseError{
@@ -50,3 +55,27 @@ func ResponseError(write http.ResponseWriter, err *errors.Error) int {
 	_ = json.NewEncoder(write).Encode(result)
 	return status
 }
+
+// ResponseOAuthError answers in the RFC 6749 error format used by the OAuth
+// endpoints instead of the service's own error body.
+func ResponseOAuthError(write http.ResponseWriter, err *errors.Error) int {
+	status := translator.ErrorTranslator[err.Code].HTTPStatus
+	write.Header().Set(ContentType, ApplicationJSON)
+	write.Header().Set("Cache-Control", "no-store")
+	write.WriteHeader(status)
+
+	_ = json.NewEncoder(write).Encode(map[string]string{
+		"error":             err.OAuthCode(),
+		"error_description": err.FriendlyMessage,
+	})
+	return status
+}
+
+// ResponseFile writes content as an attachment named fileName.
+func ResponseFile(write http.ResponseWriter, status int, fileName, contentType string, content []byte) {
+	write.Header().Set(ContentType, contentType)
+	write.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	write.Header().Set("Content-Length", strconv.Itoa(len(content)))
+	write.WriteHeader(status)
+	_, _ = write.Write(content)
+}
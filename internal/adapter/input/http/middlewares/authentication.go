@@ -0,0 +1,62 @@
+package middlewares
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const WWWAuthenticate = "WWW-Authenticate"
+
+type Authentication struct {
+	authTokenService service.AuthTokenService
+	logger           adapter2.Logger
+	tracer           adapter2.Tracer
+}
+
+func NewAuthenticationMiddleware(authTokenService service.AuthTokenService, logger adapter2.Logger, tracer adapter2.Tracer) *Authentication {
+	return &Authentication{
+		authTokenService: authTokenService,
+		logger:           logger,
+		tracer:           tracer,
+	}
+}
+
+// Authenticate rejects requests without a valid, non-revoked bearer token and
+// stores the caller as a vo.Principal in the request context.
+func (a *Authentication) Authenticate() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := a.tracer.Start(r.Context(), "Authentication.Authenticate")
+			defer span.End()
+
+			token := helpers.BearerToken(r)
+			if token == "" {
+				a.reject(w, span, errors.ErrorMissingBearerToken())
+				return
+			}
+
+			claims, err := a.authTokenService.VerifyAccessToken(ctx, token)
+			if err != nil {
+				a.reject(w, span, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(vo.WithPrincipal(r.Context(), vo.NewPrincipal(*claims))))
+		})
+	}
+}
+
+func (a *Authentication) reject(w http.ResponseWriter, span adapter2.Span, err *errors.Error) {
+	span.RecordError(err)
+	a.logger.WarnJSON("request not authenticated",
+		slog.String("trace_id", span.SpanContext().TraceID()),
+		slog.String("error", err.Error()))
+	w.Header().Set(WWWAuthenticate, `Bearer realm="auth-ms"`)
+	helpers.ResponseError(w, err)
+}
@@ -0,0 +1,125 @@
+package middlewares
+
+import (
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+// Authorization guards routes already protected by Authentication; it must be
+// placed after it in RouteFields.Middlewares.
+type Authorization struct {
+	authorizationService service.AuthorizationService
+	logger               adapter2.Logger
+	tracer               adapter2.Tracer
+}
+
+func NewAuthorizationMiddleware(authorizationService service.AuthorizationService, logger adapter2.Logger, tracer adapter2.Tracer) *Authorization {
+	return &Authorization{
+		authorizationService: authorizationService,
+		logger:               logger,
+		tracer:               tracer,
+	}
+}
+
+// RequirePermission lets the request through only when the caller role grants
+// every listed permission. Tokens issued to OAuth clients must also carry each
+// permission as a granted scope.
+func (a *Authorization) RequirePermission(permissions ...entity.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := a.tracer.Start(r.Context(), "Authorization.RequirePermission")
+			defer span.End()
+
+			principal, ok := helpers.Principal(r)
+			if !ok {
+				a.reject(w, span, errors.ErrorMissingBearerToken())
+				return
+			}
+
+			for _, permission := range permissions {
+				if principal.IsDelegated() && !principal.HasScope(string(permission)) {
+					a.reject(w, span, errors.ErrorMissingScope(string(permission)))
+					return
+				}
+				allowed, err := a.authorizationService.HasPermission(ctx, entity.RoleTypes(principal.Role), permission)
+				if err != nil {
+					a.reject(w, span, err)
+					return
+				}
+				if !allowed {
+					a.reject(w, span, errors.ErrorMissingPermission(string(permission)))
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireRole lets the request through when the caller has any of the roles.
+func (a *Authorization) RequireRole(roles ...entity.RoleTypes) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, span := a.tracer.Start(r.Context(), "Authorization.RequireRole")
+			defer span.End()
+
+			principal, ok := helpers.Principal(r)
+			if !ok {
+				a.reject(w, span, errors.ErrorMissingBearerToken())
+				return
+			}
+
+			if !slices.Contains(roles, entity.RoleTypes(principal.Role)) {
+				a.reject(w, span, errors.ErrorMissingRole(principal.Role))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireMFA lets the request through only when the caller has confirmed an
+// MFA enrollment. Callers without one must enroll at /users/me/mfa first.
+func (a *Authorization) RequireMFA() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := a.tracer.Start(r.Context(), "Authorization.RequireMFA")
+			defer span.End()
+
+			principal, ok := helpers.Principal(r)
+			if !ok {
+				a.reject(w, span, errors.ErrorMissingBearerToken())
+				return
+			}
+
+			enabled, err := a.authorizationService.HasMFA(ctx, principal.PublicID)
+			if err != nil {
+				a.reject(w, span, err)
+				return
+			}
+			if !enabled {
+				a.reject(w, span, errors.ErrorMFARequired())
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (a *Authorization) reject(w http.ResponseWriter, span adapter2.Span, err *errors.Error) {
+	span.RecordError(err)
+	a.logger.WarnJSON("request not authorized",
+		slog.String("trace_id", span.SpanContext().TraceID()),
+		slog.String("error", err.Error()))
+	helpers.ResponseError(w, err)
+}
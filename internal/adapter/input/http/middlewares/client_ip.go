@@ -0,0 +1,48 @@
+package middlewares
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type ClientIP struct {
+	trustProxyHeaders bool
+}
+
+func NewClientIPMiddleware(trustProxyHeaders bool) *ClientIP {
+	return &ClientIP{
+		trustProxyHeaders: trustProxyHeaders,
+	}
+}
+
+// ClientIP stores the caller address and User-Agent in the request context.
+// Behind a reverse proxy the address is the last entry of X-Forwarded-For, the
+// one appended by the proxy itself: earlier entries are chosen by the client.
+func (c *ClientIP) ClientIP() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := vo.WithClientIP(r.Context(), c.resolve(r))
+			ctx = vo.WithUserAgent(ctx, r.UserAgent())
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func (c *ClientIP) resolve(r *http.Request) string {
+	if c.trustProxyHeaders {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			hops := strings.Split(forwarded, ",")
+			if ip := net.ParseIP(strings.TrimSpace(hops[len(hops)-1])); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
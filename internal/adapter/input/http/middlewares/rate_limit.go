@@ -0,0 +1,198 @@
+package middlewares
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	RateLimitPolicyDefault = "default"
+	RateLimitPolicyAuth    = "auth"
+)
+
+// rateLimitBodyLimit caps how much of a body KeyByEmail reads to find the
+// e-mail; the handler still receives the whole body.
+const rateLimitBodyLimit = 64 << 10
+
+// RateLimitKey names the caller a request is counted against. An empty key
+// counts the request against the caller address.
+type RateLimitKey func(r *http.Request) string
+
+type RateLimit struct {
+	limiter  adapter2.RateLimiter
+	policies map[string]vo.RateLimitPolicy
+	enabled  bool
+	metrics  adapter2.Prometheus
+	logger   adapter2.Logger
+	tracer   adapter2.Tracer
+}
+
+func NewRateLimitMiddleware(
+	limiter adapter2.RateLimiter,
+	policies []vo.RateLimitPolicy,
+	enabled bool,
+	metrics adapter2.Prometheus,
+	logger adapter2.Logger,
+	tracer adapter2.Tracer,
+) *RateLimit {
+	byName := make(map[string]vo.RateLimitPolicy, len(policies))
+	for _, policy := range policies {
+		byName[policy.Name] = policy
+	}
+	return &RateLimit{
+		limiter:  limiter,
+		policies: byName,
+		enabled:  enabled,
+		metrics:  metrics,
+		logger:   logger,
+		tracer:   tracer,
+	}
+}
+
+// Limit throttles the route with the named policy, counting requests per key.
+// Unknown policy names use the default policy. Every answer carries the
+// RateLimit-* headers; rejected requests get 429 with Retry-After. Should the
+// limiter fail the request goes through, as rate limiting must not take the
+// service down with it.
+func (rl *RateLimit) Limit(policyName string, key RateLimitKey) func(http.Handler) http.Handler {
+	policy, ok := rl.policies[policyName]
+	if !ok {
+		policy = rl.policies[RateLimitPolicyDefault]
+	}
+	return func(next http.Handler) http.Handler {
+		if !rl.enabled || policy.Limit <= 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, span := rl.tracer.Start(r.Context(), "RateLimit.Limit")
+			defer span.End()
+
+			identity := key(r)
+			if identity == "" {
+				identity = KeyByIP(r)
+			}
+
+			decision, err := rl.limiter.Allow(ctx, identity, policy)
+			if err != nil {
+				span.RecordError(err)
+				rl.logger.ErrorJSON("Error applying rate limit",
+					map[string]any{
+						"trace_id": span.SpanContext().TraceID(),
+						"policy":   policy.Name,
+						"error":    err.Error(),
+					})
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			header := w.Header()
+			header.Set("RateLimit-Limit", strconv.Itoa(decision.Limit))
+			header.Set("RateLimit-Remaining", strconv.Itoa(max(decision.Remaining, 0)))
+			header.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(decision.Reset), 10))
+			header.Set("RateLimit-Policy", strconv.Itoa(policy.Limit)+";w="+strconv.FormatInt(ceilSeconds(policy.Window), 10))
+
+			if !decision.Allowed {
+				rl.metrics.CounterRateLimited(routePattern(r), policy.Name)
+				limitErr := errors.ErrorRateLimited(decision.RetryAfter)
+				span.RecordError(limitErr)
+				rl.logger.WarnJSON("Rate limit exceeded",
+					map[string]any{
+						"trace_id": span.SpanContext().TraceID(),
+						"policy":   policy.Name,
+						"path":     r.URL.Path,
+					})
+				helpers.ResponseError(w, limitErr)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// KeyByIP counts requests per caller address.
+func KeyByIP(r *http.Request) string {
+	return "ip:" + vo.ClientIPFromContext(r.Context())
+}
+
+// KeyByUser counts requests per authenticated user; it must be placed after
+// Authentication.
+func KeyByUser(r *http.Request) string {
+	principal, ok := helpers.Principal(r)
+	if !ok || principal.PublicID == "" {
+		return ""
+	}
+	return "user:" + principal.PublicID
+}
+
+// KeyByClientID counts requests per OAuth client, taken from the access token,
+// the Basic credentials or the client_id form field, in that order.
+func KeyByClientID(r *http.Request) string {
+	if principal, ok := helpers.Principal(r); ok && principal.ClientID != "" {
+		return "client:" + principal.ClientID
+	}
+	if clientID, _, ok := r.BasicAuth(); ok && clientID != "" {
+		return "client:" + clientID
+	}
+	if clientID := r.PostFormValue("client_id"); clientID != "" {
+		return "client:" + clientID
+	}
+	return ""
+}
+
+// KeyByEmail counts requests per e-mail of the JSON body, so attempts against
+// one account are limited however many addresses they come from. The e-mail
+// is hashed to keep it out of the limiter store.
+func KeyByEmail(r *http.Request) string {
+	if r.Body == nil {
+		return ""
+	}
+	head, _ := io.ReadAll(io.LimitReader(r.Body, rateLimitBodyLimit))
+	r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(head), r.Body), Closer: r.Body}
+
+	var body struct {
+		Email string `json:"email"`
+	}
+	if json.Unmarshal(head, &body) != nil {
+		return ""
+	}
+	email := strings.ToLower(strings.TrimSpace(body.Email))
+	if email == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(email))
+	return "email:" + hex.EncodeToString(sum[:])
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func routePattern(r *http.Request) string {
+	if routeCtx := chi.RouteContext(r.Context()); routeCtx != nil {
+		if pattern := routeCtx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return r.URL.Path
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,464 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+)
+
+type Account struct {
+	GetCurrentUser             *handler.GetCurrentUser
+	UpdateCurrentUser          *handler.UpdateCurrentUser
+	DeleteCurrentUser          *handler.DeleteCurrentUser
+	ChangePassword             *handler.ChangePassword
+	ListUserAddresses          *handler.ListUserAddresses
+	CreateUserAddresses        *handler.CreateUserAddresses
+	UpdateUserAddress          *handler.UpdateUserAddress
+	DeleteUserAddress          *handler.DeleteUserAddress
+	RequestDataExport          *handler.RequestDataExport
+	ListDataExports            *handler.ListDataExports
+	GetDataExport              *handler.GetDataExport
+	DownloadDataExport         *handler.DownloadDataExport
+	UpdatePhone                *handler.UpdatePhone
+	VerifyPhone                *handler.VerifyPhone
+	StartMFAEnrollment         *handler.StartMFAEnrollment
+	ConfirmMFAEnrollment       *handler.ConfirmMFAEnrollment
+	RegenerateMFARecoveryCodes *handler.RegenerateMFARecoveryCodes
+	DisableMFA                 *handler.DisableMFA
+	StartPasskeyRegistration   *handler.StartPasskeyRegistration
+	FinishPasskeyRegistration  *handler.FinishPasskeyRegistration
+	ListPasskeys               *handler.ListPasskeys
+	DeletePasskey              *handler.DeletePasskey
+	ListSessions               *handler.ListSessions
+	RevokeSession              *handler.RevokeSession
+	RevokeOtherSessions        *handler.RevokeOtherSessions
+	loggingMiddleware          *middlewares.Logging
+	rateLimitMiddleware        *middlewares.RateLimit
+	authenticationMiddleware   *middlewares.Authentication
+	authorizationMiddleware    *middlewares.Authorization
+}
+
+func NewAccount(
+	GetCurrentUser *handler.GetCurrentUser,
+	UpdateCurrentUser *handler.UpdateCurrentUser,
+	DeleteCurrentUser *handler.DeleteCurrentUser,
+	ChangePassword *handler.ChangePassword,
+	ListUserAddresses *handler.ListUserAddresses,
+	CreateUserAddresses *handler.CreateUserAddresses,
+	UpdateUserAddress *handler.UpdateUserAddress,
+	DeleteUserAddress *handler.DeleteUserAddress,
+	RequestDataExport *handler.RequestDataExport,
+	ListDataExports *handler.ListDataExports,
+	GetDataExport *handler.GetDataExport,
+	DownloadDataExport *handler.DownloadDataExport,
+	UpdatePhone *handler.UpdatePhone,
+	VerifyPhone *handler.VerifyPhone,
+	StartMFAEnrollment *handler.StartMFAEnrollment,
+	ConfirmMFAEnrollment *handler.ConfirmMFAEnrollment,
+	RegenerateMFARecoveryCodes *handler.RegenerateMFARecoveryCodes,
+	DisableMFA *handler.DisableMFA,
+	StartPasskeyRegistration *handler.StartPasskeyRegistration,
+	FinishPasskeyRegistration *handler.FinishPasskeyRegistration,
+	ListPasskeys *handler.ListPasskeys,
+	DeletePasskey *handler.DeletePasskey,
+	ListSessions *handler.ListSessions,
+	RevokeSession *handler.RevokeSession,
+	RevokeOtherSessions *handler.RevokeOtherSessions,
+	loggingMiddleware *middlewares.Logging,
+	rateLimitMiddleware *middlewares.RateLimit,
+	authenticationMiddleware *middlewares.Authentication,
+	authorizationMiddleware *middlewares.Authorization,
+) *Account {
+	return &Account{
+		GetCurrentUser:             GetCurrentUser,
+		UpdateCurrentUser:          UpdateCurrentUser,
+		DeleteCurrentUser:          DeleteCurrentUser,
+		ChangePassword:             ChangePassword,
+		ListUserAddresses:          ListUserAddresses,
+		CreateUserAddresses:        CreateUserAddresses,
+		UpdateUserAddress:          UpdateUserAddress,
+		DeleteUserAddress:          DeleteUserAddress,
+		RequestDataExport:          RequestDataExport,
+		ListDataExports:            ListDataExports,
+		GetDataExport:              GetDataExport,
+		DownloadDataExport:         DownloadDataExport,
+		UpdatePhone:                UpdatePhone,
+		VerifyPhone:                VerifyPhone,
+		StartMFAEnrollment:         StartMFAEnrollment,
+		ConfirmMFAEnrollment:       ConfirmMFAEnrollment,
+		RegenerateMFARecoveryCodes: RegenerateMFARecoveryCodes,
+		DisableMFA:                 DisableMFA,
+		StartPasskeyRegistration:   StartPasskeyRegistration,
+		FinishPasskeyRegistration:  FinishPasskeyRegistration,
+		ListPasskeys:               ListPasskeys,
+		DeletePasskey:              DeletePasskey,
+		ListSessions:               ListSessions,
+		RevokeSession:              RevokeSession,
+		RevokeOtherSessions:        RevokeOtherSessions,
+		loggingMiddleware:          loggingMiddleware,
+		rateLimitMiddleware:        rateLimitMiddleware,
+		authenticationMiddleware:   authenticationMiddleware,
+		authorizationMiddleware:    authorizationMiddleware,
+	}
+}
+
+func (ar *Account) Routes() helpers.RouteType {
+	prefix := "/users"
+	return helpers.WithPrefix(prefix, helpers.RouteType{
+		{
+			Method: http.MethodGet,
+			Path:   "/me",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/me", func(w http.ResponseWriter, r *http.Request) {
+				ar.GetCurrentUser.NewGetCurrentUser().Handle(w, r)
+			}),
+			Description: "Get Current User",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileRead),
+			},
+		},
+		{
+			Method: http.MethodPatch,
+			Path:   "/me",
+			Handler: helpers.TraceHandler(http.MethodPatch, prefix+"/me", func(w http.ResponseWriter, r *http.Request) {
+				ar.UpdateCurrentUser.NewUpdateCurrentUser().Handle(w, r)
+			}),
+			Description: "Update Current User",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/me",
+			Handler: helpers.TraceHandler(http.MethodDelete, prefix+"/me", func(w http.ResponseWriter, r *http.Request) {
+				ar.DeleteCurrentUser.NewDeleteCurrentUser().Handle(w, r)
+			}),
+			Description: "Delete Current User",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/password",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/password", func(w http.ResponseWriter, r *http.Request) {
+				ar.ChangePassword.NewChangePassword().Handle(w, r)
+			}),
+			Description: "Change Password",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByUser),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/me/addresses",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/me/addresses", func(w http.ResponseWriter, r *http.Request) {
+				ar.ListUserAddresses.NewListUserAddresses().Handle(w, r)
+			}),
+			Description: "List User Addresses",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileRead),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/addresses",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/addresses", func(w http.ResponseWriter, r *http.Request) {
+				ar.CreateUserAddresses.NewCreateUserAddresses().Handle(w, r)
+			}),
+			Description: "Create User Addresses",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/me/addresses/{id}",
+			Handler: helpers.TraceHandler(http.MethodPut, prefix+"/me/addresses/{id}", func(w http.ResponseWriter, r *http.Request) {
+				ar.UpdateUserAddress.NewUpdateUserAddress().Handle(w, r)
+			}),
+			Description: "Update User Address",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/me/addresses/{id}",
+			Handler: helpers.TraceHandler(http.MethodDelete, prefix+"/me/addresses/{id}", func(w http.ResponseWriter, r *http.Request) {
+				ar.DeleteUserAddress.NewDeleteUserAddress().Handle(w, r)
+			}),
+			Description: "Delete User Address",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/me/exports",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/me/exports", func(w http.ResponseWriter, r *http.Request) {
+				ar.ListDataExports.NewListDataExports().Handle(w, r)
+			}),
+			Description: "List Data Exports",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileRead),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/exports",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/exports", func(w http.ResponseWriter, r *http.Request) {
+				ar.RequestDataExport.NewRequestDataExport().Handle(w, r)
+			}),
+			Description: "Request Data Export",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileRead),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/me/exports/{id}",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/me/exports/{id}", func(w http.ResponseWriter, r *http.Request) {
+				ar.GetDataExport.NewGetDataExport().Handle(w, r)
+			}),
+			Description: "Get Data Export",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileRead),
+			},
+		},
+		// the signed link is the credential, so no bearer token is required
+		{
+			Method: http.MethodGet,
+			Path:   "/me/exports/{id}/download",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/me/exports/{id}/download", func(w http.ResponseWriter, r *http.Request) {
+				ar.DownloadDataExport.NewDownloadDataExport().Handle(w, r)
+			}),
+			Description: "Download Data Export",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPut,
+			Path:   "/me/phone",
+			Handler: helpers.TraceHandler(http.MethodPut, prefix+"/me/phone", func(w http.ResponseWriter, r *http.Request) {
+				ar.UpdatePhone.NewUpdatePhone().Handle(w, r)
+			}),
+			Description: "Update Phone",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/phone/verify",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/phone/verify", func(w http.ResponseWriter, r *http.Request) {
+				ar.VerifyPhone.NewVerifyPhone().Handle(w, r)
+			}),
+			Description: "Verify Phone",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/mfa/totp",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/mfa/totp", func(w http.ResponseWriter, r *http.Request) {
+				ar.StartMFAEnrollment.NewStartMFAEnrollment().Handle(w, r)
+			}),
+			Description: "Start MFA Enrollment",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/mfa/totp/confirm",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/mfa/totp/confirm", func(w http.ResponseWriter, r *http.Request) {
+				ar.ConfirmMFAEnrollment.NewConfirmMFAEnrollment().Handle(w, r)
+			}),
+			Description: "Confirm MFA Enrollment",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/mfa/recovery-codes",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/mfa/recovery-codes", func(w http.ResponseWriter, r *http.Request) {
+				ar.RegenerateMFARecoveryCodes.NewRegenerateMFARecoveryCodes().Handle(w, r)
+			}),
+			Description: "Regenerate MFA Recovery Codes",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByUser),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/me/mfa",
+			Handler: helpers.TraceHandler(http.MethodDelete, prefix+"/me/mfa", func(w http.ResponseWriter, r *http.Request) {
+				ar.DisableMFA.NewDisableMFA().Handle(w, r)
+			}),
+			Description: "Disable MFA",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByUser),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/passkeys/register/options",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/passkeys/register/options", func(w http.ResponseWriter, r *http.Request) {
+				ar.StartPasskeyRegistration.NewStartPasskeyRegistration().Handle(w, r)
+			}),
+			Description: "Start Passkey Registration",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/me/passkeys/register",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/me/passkeys/register", func(w http.ResponseWriter, r *http.Request) {
+				ar.FinishPasskeyRegistration.NewFinishPasskeyRegistration().Handle(w, r)
+			}),
+			Description: "Finish Passkey Registration",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/me/passkeys",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/me/passkeys", func(w http.ResponseWriter, r *http.Request) {
+				ar.ListPasskeys.NewListPasskeys().Handle(w, r)
+			}),
+			Description: "List Passkeys",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileRead),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/me/passkeys/{id}",
+			Handler: helpers.TraceHandler(http.MethodDelete, prefix+"/me/passkeys/{id}", func(w http.ResponseWriter, r *http.Request) {
+				ar.DeletePasskey.NewDeletePasskey().Handle(w, r)
+			}),
+			Description: "Delete Passkey",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/me/sessions",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/me/sessions", func(w http.ResponseWriter, r *http.Request) {
+				ar.ListSessions.NewListSessions().Handle(w, r)
+			}),
+			Description: "List Sessions",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileRead),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/me/sessions",
+			Handler: helpers.TraceHandler(http.MethodDelete, prefix+"/me/sessions", func(w http.ResponseWriter, r *http.Request) {
+				ar.RevokeOtherSessions.NewRevokeOtherSessions().Handle(w, r)
+			}),
+			Description: "Revoke Other Sessions",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/me/sessions/{id}",
+			Handler: helpers.TraceHandler(http.MethodDelete, prefix+"/me/sessions/{id}", func(w http.ResponseWriter, r *http.Request) {
+				ar.RevokeSession.NewRevokeSession().Handle(w, r)
+			}),
+			Description: "Revoke Session",
+			Middlewares: helpers.Middlewares{
+				ar.loggingMiddleware.LoggingMiddleware(),
+				ar.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ar.authenticationMiddleware.Authenticate(),
+				ar.authorizationMiddleware.RequirePermission(entity.PermissionProfileWrite),
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+)
+
+type Admin struct {
+	ListUsers                *handler.ListUsers
+	RegisterOAuthClient      *handler.RegisterOAuthClient
+	RegisterServiceClient    *handler.RegisterServiceClient
+	RotateOAuthClientSecret  *handler.RotateOAuthClientSecret
+	DisableOAuthClient       *handler.DisableOAuthClient
+	ResetUserMFA             *handler.ResetUserMFA
+	UnlockUser               *handler.UnlockUser
+	loggingMiddleware        *middlewares.Logging
+	rateLimitMiddleware      *middlewares.RateLimit
+	authenticationMiddleware *middlewares.Authentication
+	authorizationMiddleware  *middlewares.Authorization
+}
+
+func NewAdmin(
+	ListUsers *handler.ListUsers,
+	RegisterOAuthClient *handler.RegisterOAuthClient,
+	RegisterServiceClient *handler.RegisterServiceClient,
+	RotateOAuthClientSecret *handler.RotateOAuthClientSecret,
+	DisableOAuthClient *handler.DisableOAuthClient,
+	ResetUserMFA *handler.ResetUserMFA,
+	UnlockUser *handler.UnlockUser,
+	loggingMiddleware *middlewares.Logging,
+	rateLimitMiddleware *middlewares.RateLimit,
+	authenticationMiddleware *middlewares.Authentication,
+	authorizationMiddleware *middlewares.Authorization,
+) *Admin {
+	return &Admin{
+		ListUsers:                ListUsers,
+		RegisterOAuthClient:      RegisterOAuthClient,
+		RegisterServiceClient:    RegisterServiceClient,
+		RotateOAuthClientSecret:  RotateOAuthClientSecret,
+		DisableOAuthClient:       DisableOAuthClient,
+		ResetUserMFA:             ResetUserMFA,
+		UnlockUser:               UnlockUser,
+		loggingMiddleware:        loggingMiddleware,
+		rateLimitMiddleware:      rateLimitMiddleware,
+		authenticationMiddleware: authenticationMiddleware,
+		authorizationMiddleware:  authorizationMiddleware,
+	}
+}
+
+func (ad *Admin) Routes() helpers.RouteType {
+	prefix := "/admin"
+	return helpers.WithPrefix(prefix, helpers.RouteType{
+		{
+			Method: http.MethodGet,
+			Path:   "/users",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/users", func(w http.ResponseWriter, r *http.Request) {
+				ad.ListUsers.NewListUsers().Handle(w, r)
+			}),
+			Description: "List Users",
+			Middlewares: helpers.Middlewares{
+				ad.loggingMiddleware.LoggingMiddleware(),
+				ad.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ad.authenticationMiddleware.Authenticate(),
+				ad.authorizationMiddleware.RequirePermission(entity.PermissionUsersRead),
+				ad.authorizationMiddleware.RequireMFA(),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/oauth/clients",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/oauth/clients", func(w http.ResponseWriter, r *http.Request) {
+				ad.RegisterOAuthClient.NewRegisterOAuthClient().Handle(w, r)
+			}),
+			Description: "Register OAuth Client",
+			Middlewares: helpers.Middlewares{
+				ad.loggingMiddleware.LoggingMiddleware(),
+				ad.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ad.authenticationMiddleware.Authenticate(),
+				ad.authorizationMiddleware.RequirePermission(entity.PermissionClientsManage),
+				ad.authorizationMiddleware.RequireMFA(),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/oauth/service-clients",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/oauth/service-clients", func(w http.ResponseWriter, r *http.Request) {
+				ad.RegisterServiceClient.NewRegisterServiceClient().Handle(w, r)
+			}),
+			Description: "Register Service Client",
+			Middlewares: helpers.Middlewares{
+				ad.loggingMiddleware.LoggingMiddleware(),
+				ad.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ad.authenticationMiddleware.Authenticate(),
+				ad.authorizationMiddleware.RequirePermission(entity.PermissionClientsManage),
+				ad.authorizationMiddleware.RequireMFA(),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/oauth/clients/{client_id}/secret",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/oauth/clients/{client_id}/secret", func(w http.ResponseWriter, r *http.Request) {
+				ad.RotateOAuthClientSecret.NewRotateOAuthClientSecret().Handle(w, r)
+			}),
+			Description: "Rotate OAuth Client Secret",
+			Middlewares: helpers.Middlewares{
+				ad.loggingMiddleware.LoggingMiddleware(),
+				ad.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ad.authenticationMiddleware.Authenticate(),
+				ad.authorizationMiddleware.RequirePermission(entity.PermissionClientsManage),
+				ad.authorizationMiddleware.RequireMFA(),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/oauth/clients/{client_id}/disable",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/oauth/clients/{client_id}/disable", func(w http.ResponseWriter, r *http.Request) {
+				ad.DisableOAuthClient.NewDisableOAuthClient().Handle(w, r)
+			}),
+			Description: "Disable OAuth Client",
+			Middlewares: helpers.Middlewares{
+				ad.loggingMiddleware.LoggingMiddleware(),
+				ad.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ad.authenticationMiddleware.Authenticate(),
+				ad.authorizationMiddleware.RequirePermission(entity.PermissionClientsManage),
+				ad.authorizationMiddleware.RequireMFA(),
+			},
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/users/{public_id}/mfa",
+			Handler: helpers.TraceHandler(http.MethodDelete, prefix+"/users/{public_id}/mfa", func(w http.ResponseWriter, r *http.Request) {
+				ad.ResetUserMFA.NewResetUserMFA().Handle(w, r)
+			}),
+			Description: "Reset User MFA",
+			Middlewares: helpers.Middlewares{
+				ad.loggingMiddleware.LoggingMiddleware(),
+				ad.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ad.authenticationMiddleware.Authenticate(),
+				ad.authorizationMiddleware.RequirePermission(entity.PermissionUsersWrite),
+				ad.authorizationMiddleware.RequireMFA(),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/users/{public_id}/unlock",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/users/{public_id}/unlock", func(w http.ResponseWriter, r *http.Request) {
+				ad.UnlockUser.NewUnlockUser().Handle(w, r)
+			}),
+			Description: "Unlock User",
+			Middlewares: helpers.Middlewares{
+				ad.loggingMiddleware.LoggingMiddleware(),
+				ad.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				ad.authenticationMiddleware.Authenticate(),
+				ad.authorizationMiddleware.RequirePermission(entity.PermissionUsersWrite),
+				ad.authorizationMiddleware.RequireMFA(),
+			},
+		},
+	})
+}
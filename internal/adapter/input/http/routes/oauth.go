@@ -0,0 +1,95 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+)
+
+type OAuth struct {
+	PrepareOAuthAuthorization *handler.PrepareOAuthAuthorization
+	AuthorizeOAuthClient      *handler.AuthorizeOAuthClient
+	ExchangeOAuthToken        *handler.ExchangeOAuthToken
+	GetUserInfo               *handler.GetUserInfo
+	loggingMiddleware         *middlewares.Logging
+	rateLimitMiddleware       *middlewares.RateLimit
+	authenticationMiddleware  *middlewares.Authentication
+}
+
+func NewOAuth(
+	PrepareOAuthAuthorization *handler.PrepareOAuthAuthorization,
+	AuthorizeOAuthClient *handler.AuthorizeOAuthClient,
+	ExchangeOAuthToken *handler.ExchangeOAuthToken,
+	GetUserInfo *handler.GetUserInfo,
+	loggingMiddleware *middlewares.Logging,
+	rateLimitMiddleware *middlewares.RateLimit,
+	authenticationMiddleware *middlewares.Authentication,
+) *OAuth {
+	return &OAuth{
+		PrepareOAuthAuthorization: PrepareOAuthAuthorization,
+		AuthorizeOAuthClient:      AuthorizeOAuthClient,
+		ExchangeOAuthToken:        ExchangeOAuthToken,
+		GetUserInfo:               GetUserInfo,
+		loggingMiddleware:         loggingMiddleware,
+		rateLimitMiddleware:       rateLimitMiddleware,
+		authenticationMiddleware:  authenticationMiddleware,
+	}
+}
+
+func (o *OAuth) Routes() helpers.RouteType {
+	prefix := "/oauth"
+	return helpers.WithPrefix(prefix, helpers.RouteType{
+		{
+			Method: http.MethodGet,
+			Path:   "/authorize",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/authorize", func(w http.ResponseWriter, r *http.Request) {
+				o.PrepareOAuthAuthorization.NewPrepareOAuthAuthorization().Handle(w, r)
+			}),
+			Description: "OAuth Authorization Request",
+			Middlewares: helpers.Middlewares{
+				o.loggingMiddleware.LoggingMiddleware(),
+				o.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/authorize",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/authorize", func(w http.ResponseWriter, r *http.Request) {
+				o.AuthorizeOAuthClient.NewAuthorizeOAuthClient().Handle(w, r)
+			}),
+			Description: "OAuth Authorization Decision",
+			Middlewares: helpers.Middlewares{
+				o.loggingMiddleware.LoggingMiddleware(),
+				o.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				o.authenticationMiddleware.Authenticate(),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/token",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/token", func(w http.ResponseWriter, r *http.Request) {
+				o.ExchangeOAuthToken.NewExchangeOAuthToken().Handle(w, r)
+			}),
+			Description: "OAuth Token",
+			Middlewares: helpers.Middlewares{
+				o.loggingMiddleware.LoggingMiddleware(),
+				o.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByClientID),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/userinfo",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/userinfo", func(w http.ResponseWriter, r *http.Request) {
+				o.GetUserInfo.NewGetUserInfo().Handle(w, r)
+			}),
+			Description: "OpenID Connect UserInfo",
+			Middlewares: helpers.Middlewares{
+				o.loggingMiddleware.LoggingMiddleware(),
+				o.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				o.authenticationMiddleware.Authenticate(),
+			},
+		},
+	})
+}
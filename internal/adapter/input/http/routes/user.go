@@ -9,17 +9,71 @@ import (
 )
 
 type User struct {
-	CreateAuthUser    *handler.CreateAuthUser
-	loggingMiddleware *middlewares.Logging
+	CreateAuthUser          *handler.CreateAuthUser
+	LoginAuthUser           *handler.LoginAuthUser
+	VerifyMFALogin          *handler.VerifyMFALogin
+	StartPasskeyLogin       *handler.StartPasskeyLogin
+	FinishPasskeyLogin      *handler.FinishPasskeyLogin
+	RequestMagicLink        *handler.RequestMagicLink
+	ConsumeMagicLink        *handler.ConsumeMagicLink
+	RefreshAuthToken        *handler.RefreshAuthToken
+	LogoutAuthUser          *handler.LogoutAuthUser
+	RestoreAuthUser         *handler.RestoreAuthUser
+	ForgotPassword          *handler.ForgotPassword
+	ResetPassword           *handler.ResetPassword
+	ForgotPasswordSms       *handler.ForgotPasswordSms
+	VerifyPasswordResetCode *handler.VerifyPasswordResetCode
+	VerifyEmail             *handler.VerifyEmail
+	ResendEmailVerification *handler.ResendEmailVerification
+	StartOAuthLogin         *handler.StartOAuthLogin
+	CompleteOAuthLogin      *handler.CompleteOAuthLogin
+	loggingMiddleware       *middlewares.Logging
+	rateLimitMiddleware     *middlewares.RateLimit
 }
 
 func NewUser(
 	CreateAuthUser *handler.CreateAuthUser,
+	LoginAuthUser *handler.LoginAuthUser,
+	VerifyMFALogin *handler.VerifyMFALogin,
+	StartPasskeyLogin *handler.StartPasskeyLogin,
+	FinishPasskeyLogin *handler.FinishPasskeyLogin,
+	RequestMagicLink *handler.RequestMagicLink,
+	ConsumeMagicLink *handler.ConsumeMagicLink,
+	RefreshAuthToken *handler.RefreshAuthToken,
+	LogoutAuthUser *handler.LogoutAuthUser,
+	RestoreAuthUser *handler.RestoreAuthUser,
+	ForgotPassword *handler.ForgotPassword,
+	ResetPassword *handler.ResetPassword,
+	ForgotPasswordSms *handler.ForgotPasswordSms,
+	VerifyPasswordResetCode *handler.VerifyPasswordResetCode,
+	VerifyEmail *handler.VerifyEmail,
+	ResendEmailVerification *handler.ResendEmailVerification,
+	StartOAuthLogin *handler.StartOAuthLogin,
+	CompleteOAuthLogin *handler.CompleteOAuthLogin,
 	loggingMiddleware *middlewares.Logging,
+	rateLimitMiddleware *middlewares.RateLimit,
 ) *User {
 	return &User{
-		CreateAuthUser:    CreateAuthUser,
-		loggingMiddleware: loggingMiddleware,
+		CreateAuthUser:          CreateAuthUser,
+		LoginAuthUser:           LoginAuthUser,
+		VerifyMFALogin:          VerifyMFALogin,
+		StartPasskeyLogin:       StartPasskeyLogin,
+		FinishPasskeyLogin:      FinishPasskeyLogin,
+		RequestMagicLink:        RequestMagicLink,
+		ConsumeMagicLink:        ConsumeMagicLink,
+		RefreshAuthToken:        RefreshAuthToken,
+		LogoutAuthUser:          LogoutAuthUser,
+		RestoreAuthUser:         RestoreAuthUser,
+		ForgotPassword:          ForgotPassword,
+		ResetPassword:           ResetPassword,
+		ForgotPasswordSms:       ForgotPasswordSms,
+		VerifyPasswordResetCode: VerifyPasswordResetCode,
+		VerifyEmail:             VerifyEmail,
+		ResendEmailVerification: ResendEmailVerification,
+		StartOAuthLogin:         StartOAuthLogin,
+		CompleteOAuthLogin:      CompleteOAuthLogin,
+		loggingMiddleware:       loggingMiddleware,
+		rateLimitMiddleware:     rateLimitMiddleware,
 	}
 }
 
@@ -35,6 +89,216 @@ func (cr *User) Routes() helpers.RouteType {
 			Description: "Create Customer",
 			Middlewares: helpers.Middlewares{
 				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/login",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/login", func(w http.ResponseWriter, r *http.Request) {
+				cr.LoginAuthUser.NewLoginAuthUser().Handle(w, r)
+			}),
+			Description: "Login User",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/login/mfa",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/login/mfa", func(w http.ResponseWriter, r *http.Request) {
+				cr.VerifyMFALogin.NewVerifyMFALogin().Handle(w, r)
+			}),
+			Description: "Verify MFA Login",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/passkeys/login/options",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/passkeys/login/options", func(w http.ResponseWriter, r *http.Request) {
+				cr.StartPasskeyLogin.NewStartPasskeyLogin().Handle(w, r)
+			}),
+			Description: "Start Passkey Login",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/passkeys/login",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/passkeys/login", func(w http.ResponseWriter, r *http.Request) {
+				cr.FinishPasskeyLogin.NewFinishPasskeyLogin().Handle(w, r)
+			}),
+			Description: "Finish Passkey Login",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/magic-link",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/magic-link", func(w http.ResponseWriter, r *http.Request) {
+				cr.RequestMagicLink.NewRequestMagicLink().Handle(w, r)
+			}),
+			Description: "Request Magic Link",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/magic-link/consume",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/magic-link/consume", func(w http.ResponseWriter, r *http.Request) {
+				cr.ConsumeMagicLink.NewConsumeMagicLink().Handle(w, r)
+			}),
+			Description: "Consume Magic Link",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/refresh",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/refresh", func(w http.ResponseWriter, r *http.Request) {
+				cr.RefreshAuthToken.NewRefreshAuthToken().Handle(w, r)
+			}),
+			Description: "Refresh Auth Token",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/logout",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/logout", func(w http.ResponseWriter, r *http.Request) {
+				cr.LogoutAuthUser.NewLogoutAuthUser().Handle(w, r)
+			}),
+			Description: "Logout User",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/restore",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/restore", func(w http.ResponseWriter, r *http.Request) {
+				cr.RestoreAuthUser.NewRestoreAuthUser().Handle(w, r)
+			}),
+			Description: "Restore Deleted User",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/password/forgot",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/password/forgot", func(w http.ResponseWriter, r *http.Request) {
+				cr.ForgotPassword.NewForgotPassword().Handle(w, r)
+			}),
+			Description: "Forgot Password",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/password/reset",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/password/reset", func(w http.ResponseWriter, r *http.Request) {
+				cr.ResetPassword.NewResetPassword().Handle(w, r)
+			}),
+			Description: "Reset Password",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/password/sms/forgot",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/password/sms/forgot", func(w http.ResponseWriter, r *http.Request) {
+				cr.ForgotPasswordSms.NewForgotPasswordSms().Handle(w, r)
+			}),
+			Description: "Forgot Password By SMS",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/password/sms/verify",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/password/sms/verify", func(w http.ResponseWriter, r *http.Request) {
+				cr.VerifyPasswordResetCode.NewVerifyPasswordResetCode().Handle(w, r)
+			}),
+			Description: "Verify Password Reset Code",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/verify-email",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/verify-email", func(w http.ResponseWriter, r *http.Request) {
+				cr.VerifyEmail.NewVerifyEmail().Handle(w, r)
+			}),
+			Description: "Verify Email",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/verify-email/resend",
+			Handler: helpers.TraceHandler(http.MethodPost, prefix+"/verify-email/resend", func(w http.ResponseWriter, r *http.Request) {
+				cr.ResendEmailVerification.NewResendEmailVerification().Handle(w, r)
+			}),
+			Description: "Resend Email Verification",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/oauth/{provider}/start",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/oauth/{provider}/start", func(w http.ResponseWriter, r *http.Request) {
+				cr.StartOAuthLogin.NewStartOAuthLogin().Handle(w, r)
+			}),
+			Description: "Redirect to a social login provider",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/oauth/{provider}/callback",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/oauth/{provider}/callback", func(w http.ResponseWriter, r *http.Request) {
+				cr.CompleteOAuthLogin.NewCompleteOAuthLogin().Handle(w, r)
+			}),
+			Description: "Complete a social login and issue tokens",
+			Middlewares: helpers.Middlewares{
+				cr.loggingMiddleware.LoggingMiddleware(),
+				cr.rateLimitMiddleware.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
 			},
 		},
 	})
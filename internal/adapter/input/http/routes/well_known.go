@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+)
+
+type WellKnown struct {
+	GetJWKS                *handler.GetJWKS
+	GetOpenIDConfiguration *handler.GetOpenIDConfiguration
+	loggingMiddleware      *middlewares.Logging
+}
+
+func NewWellKnown(
+	GetJWKS *handler.GetJWKS,
+	GetOpenIDConfiguration *handler.GetOpenIDConfiguration,
+	loggingMiddleware *middlewares.Logging,
+) *WellKnown {
+	return &WellKnown{
+		GetJWKS:                GetJWKS,
+		GetOpenIDConfiguration: GetOpenIDConfiguration,
+		loggingMiddleware:      loggingMiddleware,
+	}
+}
+
+func (wk *WellKnown) Routes() helpers.RouteType {
+	prefix := "/.well-known"
+	return helpers.WithPrefix(prefix, helpers.RouteType{
+		{
+			Method: http.MethodGet,
+			Path:   "/jwks.json",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+				wk.GetJWKS.NewGetJWKS().Handle(w, r)
+			}),
+			Description: "JSON Web Key Set",
+			Middlewares: helpers.Middlewares{
+				wk.loggingMiddleware.LoggingMiddleware(),
+			},
+		},
+		{
+			Method: http.MethodGet,
+			Path:   "/openid-configuration",
+			Handler: helpers.TraceHandler(http.MethodGet, prefix+"/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+				wk.GetOpenIDConfiguration.NewGetOpenIDConfiguration().Handle(w, r)
+			}),
+			Description: "OpenID Connect Discovery",
+			Middlewares: helpers.Middlewares{
+				wk.loggingMiddleware.LoggingMiddleware(),
+			},
+		},
+	})
+}
@@ -42,4 +42,12 @@ var ErrorTranslator = map[errors2.Code]ProtocolError{
 		HTTPStatus: http.StatusUnprocessableEntity,
 		GRPCCode:   codes.InvalidArgument,
 	},
+	errors2.ErrTooManyRequests: {
+		HTTPStatus: http.StatusTooManyRequests,
+		GRPCCode:   codes.ResourceExhausted,
+	},
+	errors2.ErrAccountLocked: {
+		HTTPStatus: http.StatusTooManyRequests,
+		GRPCCode:   codes.ResourceExhausted,
+	},
 }
@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+const (
+	loginThrottlePrefix   = "auth:login:"
+	loginFailuresSuffix   = ":failures"
+	loginLockedSuffix     = ":locked"
+	loginAddressSeparator = ":ip:"
+)
+
+type loginLock struct {
+	Until int64 `json:"until"`
+}
+
+// LoginThrottle slows password guessing down in Redis. Failures from one
+// address against an account are delayed exponentially, from backoffBase on
+// the second failure, and lock the account for that address once threshold is
+// reached. Failures from every address together lock the account everywhere
+// once accountThreshold is reached. Failures are counted in a window of
+// lockDuration from the first one, and every lock expires on its own.
+//
+// Accounts are keyed by a hash of the normalized e-mail, so unknown e-mails are
+// throttled like real accounts and addresses are kept out of the key names.
+type LoginThrottle struct {
+	cache            adapter2.Cache
+	threshold        int
+	accountThreshold int
+	lockDuration     time.Duration
+	backoffBase      time.Duration
+}
+
+func NewLoginThrottle(cache adapter2.Cache, threshold, accountThreshold int, lockDuration, backoffBase time.Duration) *LoginThrottle {
+	return &LoginThrottle{
+		cache:            cache,
+		threshold:        threshold,
+		accountThreshold: accountThreshold,
+		lockDuration:     lockDuration,
+		backoffBase:      backoffBase,
+	}
+}
+
+func (t *LoginThrottle) Check(ctx context.Context, account, ip string) (time.Duration, *errors2.Error) {
+	accountKey, addressKey := t.keys(account, ip)
+	now := time.Now()
+
+	var wait time.Duration
+	for _, key := range []string{accountKey, addressKey} {
+		var lock loginLock
+		found, err := t.cache.Get(ctx, key+loginLockedSuffix, &lock)
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			wait = max(wait, time.Unix(lock.Until, 0).Sub(now))
+		}
+	}
+	return max(wait, 0), nil
+}
+
+func (t *LoginThrottle) RecordFailure(ctx context.Context, account, ip string) (time.Duration, *errors2.Error) {
+	accountKey, addressKey := t.keys(account, ip)
+	window := ttlSeconds(t.lockDuration)
+
+	accountFailures, err := t.cache.Increment(ctx, accountKey+loginFailuresSuffix, window)
+	if err != nil {
+		return 0, err
+	}
+	addressFailures, err := t.cache.Increment(ctx, addressKey+loginFailuresSuffix, window)
+	if err != nil {
+		return 0, err
+	}
+
+	var wait time.Duration
+	if accountFailures >= int64(t.accountThreshold) {
+		if err := t.lock(ctx, accountKey, t.lockDuration); err != nil {
+			return 0, err
+		}
+		wait = t.lockDuration
+	}
+	if delay := t.delay(addressFailures); delay > 0 {
+		if err := t.lock(ctx, addressKey, delay); err != nil {
+			return 0, err
+		}
+		wait = max(wait, delay)
+	}
+	return wait, nil
+}
+
+// RecordSuccess forgets the failures of the account once its password was
+// given, so the owner starts afresh.
+func (t *LoginThrottle) RecordSuccess(ctx context.Context, account, ip string) *errors2.Error {
+	accountKey, addressKey := t.keys(account, ip)
+	for _, key := range []string{accountKey + loginFailuresSuffix, addressKey + loginFailuresSuffix, addressKey + loginLockedSuffix} {
+		if err := t.cache.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unlock lifts every lock of the account and forgets its failures, from every
+// address.
+func (t *LoginThrottle) Unlock(ctx context.Context, account string) *errors2.Error {
+	accountKey, _ := t.keys(account, "")
+	return t.cache.DeleteByPrefix(ctx, accountKey+":")
+}
+
+// delay is zero for the first failure, backoffBase for the second, doubled on
+// every further one and lockDuration from threshold on.
+func (t *LoginThrottle) delay(failures int64) time.Duration {
+	if failures >= int64(t.threshold) {
+		return t.lockDuration
+	}
+	if failures < 2 {
+		return 0
+	}
+	delay := t.backoffBase << (failures - 2)
+	if delay <= 0 || delay > t.lockDuration {
+		return t.lockDuration
+	}
+	return delay
+}
+
+func (t *LoginThrottle) lock(ctx context.Context, key string, wait time.Duration) *errors2.Error {
+	until := time.Now().Add(wait)
+	return t.cache.Set(ctx, key+loginLockedSuffix, loginLock{Until: until.Unix()}, max(ttlSeconds(wait), 1))
+}
+
+func (t *LoginThrottle) keys(account, ip string) (string, string) {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(account))))
+	accountKey := loginThrottlePrefix + hex.EncodeToString(sum[:])
+	return accountKey, accountKey + loginAddressSeparator + ip
+}
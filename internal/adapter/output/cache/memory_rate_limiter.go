@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// memorySweepInterval is how many calls go by between sweeps of expired keys.
+const memorySweepInterval = 1024
+
+type memoryRateLimitEntry struct {
+	hits    []time.Time
+	tokens  float64
+	updated time.Time
+	expires time.Time
+}
+
+// MemoryRateLimiter applies the same algorithms as RateLimiter inside this
+// process only. It stands in while Redis is unreachable, when each instance
+// enforces its limits on its own.
+type MemoryRateLimiter struct {
+	mu      sync.Mutex
+	entries map[string]*memoryRateLimitEntry
+	calls   int
+}
+
+func NewMemoryRateLimiter() *MemoryRateLimiter {
+	return &MemoryRateLimiter{
+		entries: make(map[string]*memoryRateLimitEntry),
+	}
+}
+
+func (m *MemoryRateLimiter) Allow(_ context.Context, key string, policy vo.RateLimitPolicy) (vo.RateLimitDecision, *errors2.Error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := time.Now()
+	m.sweep(now)
+
+	key = rateLimitKey(key, policy)
+	entry, ok := m.entries[key]
+	if !ok || now.After(entry.expires) {
+		entry = &memoryRateLimitEntry{tokens: float64(policy.Limit), updated: now}
+		m.entries[key] = entry
+	}
+	entry.expires = now.Add(policy.Window)
+
+	if policy.Algorithm == vo.RateLimitTokenBucket {
+		return takeToken(entry, now, policy), nil
+	}
+	return slideWindow(entry, now, policy), nil
+}
+
+func (m *MemoryRateLimiter) sweep(now time.Time) {
+	m.calls++
+	if m.calls%memorySweepInterval != 0 {
+		return
+	}
+	for key, entry := range m.entries {
+		if now.After(entry.expires) {
+			delete(m.entries, key)
+		}
+	}
+}
+
+func slideWindow(entry *memoryRateLimitEntry, now time.Time, policy vo.RateLimitPolicy) vo.RateLimitDecision {
+	cutoff := now.Add(-policy.Window)
+	kept := 0
+	for kept < len(entry.hits) && !entry.hits[kept].After(cutoff) {
+		kept++
+	}
+	entry.hits = entry.hits[kept:]
+
+	decision := vo.RateLimitDecision{Limit: policy.Limit}
+	if len(entry.hits) < policy.Limit {
+		entry.hits = append(entry.hits, now)
+		decision.Allowed = true
+	}
+	decision.Remaining = policy.Limit - len(entry.hits)
+	if len(entry.hits) > 0 {
+		decision.Reset = entry.hits[0].Add(policy.Window).Sub(now)
+	}
+	if !decision.Allowed {
+		decision.RetryAfter = decision.Reset
+	}
+	return decision
+}
+
+func takeToken(entry *memoryRateLimitEntry, now time.Time, policy vo.RateLimitPolicy) vo.RateLimitDecision {
+	capacity := float64(policy.Limit)
+	perSecond := capacity / policy.Window.Seconds()
+	entry.tokens = math.Min(capacity, entry.tokens+now.Sub(entry.updated).Seconds()*perSecond)
+	entry.updated = now
+
+	decision := vo.RateLimitDecision{Limit: policy.Limit}
+	if entry.tokens >= 1 {
+		entry.tokens--
+		decision.Allowed = true
+	} else {
+		decision.RetryAfter = seconds((1 - entry.tokens) / perSecond)
+	}
+	decision.Remaining = int(entry.tokens)
+	decision.Reset = seconds((capacity - entry.tokens) / perSecond)
+	return decision
+}
+
+func seconds(value float64) time.Duration {
+	return time.Duration(value * float64(time.Second))
+}
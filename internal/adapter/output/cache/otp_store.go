@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"time"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+const (
+	otpPrefix         = "auth:otp:"
+	otpAttemptsSuffix = ":attempts"
+	otpDigits         = 6
+)
+
+var otpUpperBound = big.NewInt(1_000_000)
+
+// OTPStore keeps short numeric codes in Redis as SHA-256 hashes for ttl. Every
+// guess is counted; once maxAttempts is exceeded the code is burned and the key
+// stays locked for ttl from the first guess, even if a new code is issued.
+type OTPStore struct {
+	cache       adapter2.Cache
+	ttl         time.Duration
+	maxAttempts int
+}
+
+func NewOTPStore(cache adapter2.Cache, ttl time.Duration, maxAttempts int) *OTPStore {
+	return &OTPStore{
+		cache:       cache,
+		ttl:         ttl,
+		maxAttempts: maxAttempts,
+	}
+}
+
+func (s *OTPStore) Issue(ctx context.Context, key string) (string, *errors2.Error) {
+	n, err := rand.Int(rand.Reader, otpUpperBound)
+	if err != nil {
+		return "", errors2.ErrorGenerateOTP(err)
+	}
+	code := fmt.Sprintf("%0*d", otpDigits, n.Int64())
+
+	if err := s.cache.Set(ctx, otpPrefix+key, hashOTP(code), ttlSeconds(s.ttl)); err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+func (s *OTPStore) Verify(ctx context.Context, key, code string) (bool, *errors2.Error) {
+	attempts, err := s.cache.Increment(ctx, otpPrefix+key+otpAttemptsSuffix, ttlSeconds(s.ttl))
+	if err != nil {
+		return false, err
+	}
+	if attempts > int64(s.maxAttempts) {
+		if err := s.cache.Delete(ctx, otpPrefix+key); err != nil {
+			return false, err
+		}
+		return false, errors2.ErrorTooManyOTPAttempts()
+	}
+
+	// Comparing and deleting in one step redeems a code at most once, even
+	// when the same code is submitted concurrently.
+	redeemed, err := s.cache.DeleteIfEqual(ctx, otpPrefix+key, hashOTP(code))
+	if err != nil || !redeemed {
+		return false, err
+	}
+
+	if err := s.cache.Delete(ctx, otpPrefix+key+otpAttemptsSuffix); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func hashOTP(code string) string {
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,145 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const rateLimitPrefix = "auth:ratelimit:"
+
+// slidingWindowScript keeps the requests of the last window in a sorted set
+// scored by time. It returns {allowed, remaining, retry_after_ms, reset_ms}.
+var slidingWindowScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call('ZREMRANGEBYSCORE', key, '-inf', now - window)
+local count = redis.call('ZCARD', key)
+local allowed = 0
+if count < limit then
+	redis.call('ZADD', key, now, ARGV[4])
+	count = count + 1
+	allowed = 1
+end
+redis.call('PEXPIRE', key, window)
+
+local reset = 0
+local oldest = redis.call('ZRANGE', key, 0, 0, 'WITHSCORES')
+if oldest[2] then
+	reset = tonumber(oldest[2]) + window - now
+end
+local retry = 0
+if allowed == 0 then
+	retry = reset
+end
+return {allowed, limit - count, retry, reset}
+`)
+
+// tokenBucketScript refills limit tokens evenly over the window and takes one
+// per request. It returns {allowed, remaining, retry_after_ms, reset_ms}.
+var tokenBucketScript = redis.NewScript(`
+local key = KEYS[1]
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local capacity = tonumber(ARGV[3])
+local rate = capacity / window
+
+local state = redis.call('HMGET', key, 'tokens', 'updated')
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+if tokens == nil or updated == nil then
+	tokens = capacity
+	updated = now
+end
+tokens = math.min(capacity, tokens + math.max(0, now - updated) * rate)
+
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+redis.call('HSET', key, 'tokens', tostring(tokens), 'updated', now)
+redis.call('PEXPIRE', key, window)
+
+return {allowed, math.floor(tokens), retry, math.ceil((capacity - tokens) / rate)}
+`)
+
+// RateLimiter decides in Redis, with Lua scripts so that concurrent requests
+// of every instance are counted atomically. While Redis is unreachable it
+// falls back to fallback, so an outage neither blocks nor unthrottles traffic.
+type RateLimiter struct {
+	client   *redis.Client
+	fallback adapter2.RateLimiter
+	log      adapter2.Logger
+	metrics  adapter2.Prometheus
+	tracer   adapter2.Tracer
+}
+
+func NewRateLimiter(client *redis.Client, fallback adapter2.RateLimiter, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer) *RateLimiter {
+	return &RateLimiter{
+		client:   client,
+		fallback: fallback,
+		log:      log,
+		metrics:  metrics,
+		tracer:   tracer,
+	}
+}
+
+func (l *RateLimiter) Allow(ctx context.Context, key string, policy vo.RateLimitPolicy) (vo.RateLimitDecision, *errors2.Error) {
+	ctx, span := l.tracer.Start(ctx, "RateLimiter.Allow")
+	start := time.Now()
+	defer func() {
+		end := time.Since(start)
+		l.metrics.ObserveInstructionDBDuration("redis", "rate_limit", "eval", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	now := time.Now().UnixMilli()
+	args := []any{now, policy.Window.Milliseconds(), policy.Limit}
+	script := slidingWindowScript
+	if policy.Algorithm == vo.RateLimitTokenBucket {
+		script = tokenBucketScript
+	} else {
+		args = append(args, fmt.Sprintf("%d-%016x", now, rand.Uint64()))
+	}
+
+	result, err := script.Run(ctx, l.client, []string{rateLimitPrefix + rateLimitKey(key, policy)}, args...).Int64Slice()
+	if err != nil || len(result) != 4 {
+		if err == nil {
+			err = fmt.Errorf("unexpected rate limit script result %v", result)
+		}
+		limiterErr := errors2.ErrorRateLimiter(err)
+		span.RecordError(limiterErr)
+		l.log.WarnJSON("Rate limiter unavailable, using the in-memory fallback",
+			map[string]any{
+				"trace_id": span.SpanContext().TraceID(),
+				"error":    limiterErr.Error(),
+			})
+		return l.fallback.Allow(ctx, key, policy)
+	}
+
+	return vo.RateLimitDecision{
+		Allowed:    result[0] == 1,
+		Limit:      policy.Limit,
+		Remaining:  int(result[1]),
+		RetryAfter: time.Duration(result[2]) * time.Millisecond,
+		Reset:      time.Duration(result[3]) * time.Millisecond,
+	}, nil
+}
+
+func rateLimitKey(key string, policy vo.RateLimitPolicy) string {
+	return policy.Name + ":" + key
+}
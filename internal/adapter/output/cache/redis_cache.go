@@ -3,6 +3,7 @@ package cache
 import (
 	"context"
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -11,6 +12,28 @@ import (
 	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
 )
 
+// globPatternEscaper quotes the characters SCAN MATCH treats as wildcards.
+var globPatternEscaper = strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+
+// incrementScript counts and sets the expiry in one step, so a counter can
+// never be left behind without a TTL.
+var incrementScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+if count == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// compareAndDeleteScript deletes the key only while it holds ARGV[1] and
+// returns the number of keys deleted.
+var compareAndDeleteScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
 type Cache struct {
 	client  *redis.Client
 	metrics adapter2.Prometheus
@@ -39,6 +62,10 @@ func (c *Cache) Get(ctx context.Context, key string, target any) (bool, *errors2
 		return false, nil
 	}
 
+	if err != nil {
+		return false, errors2.ErrorGetCache(err)
+	}
+
 	if err = json.Unmarshal([]byte(result), target); err != nil {
 		return false, errors2.ErrorGetCache(err)
 	}
@@ -68,3 +95,88 @@ func (c *Cache) Set(ctx context.Context, key string, value any, ttlSeconds int)
 
 	return nil
 }
+
+func (c *Cache) Delete(ctx context.Context, key string) *errors2.Error {
+	ctx, span := c.tracer.Start(ctx, "Cache.Delete")
+	start := time.Now()
+	defer func() {
+		end := time.Since(start)
+		c.metrics.ObserveInstructionDBDuration("redis", "cache", "delete", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	if err := c.client.Del(ctx, key).Err(); err != nil {
+		return errors2.ErrorDeleteCache(err)
+	}
+
+	return nil
+}
+
+// DeleteIfEqual removes key only if it still holds value, and reports whether
+// it did. The check and the delete are atomic, so a value is taken only once.
+func (c *Cache) DeleteIfEqual(ctx context.Context, key string, value any) (bool, *errors2.Error) {
+	ctx, span := c.tracer.Start(ctx, "Cache.DeleteIfEqual")
+	start := time.Now()
+	defer func() {
+		end := time.Since(start)
+		c.metrics.ObserveInstructionDBDuration("redis", "cache", "delete", float64(end.Milliseconds()))
+		span.End()
+	}()
+	bytes, err := json.Marshal(value)
+
+	if err != nil {
+		return false, errors2.ErrorDeleteCache(err)
+	}
+
+	deleted, err := compareAndDeleteScript.Run(ctx, c.client, []string{key}, string(bytes)).Int64()
+	if err != nil {
+		return false, errors2.ErrorDeleteCache(err)
+	}
+
+	return deleted == 1, nil
+}
+
+// Increment adds one to key and returns the new value. The TTL is set when the
+// counter is created, in the same script, so it measures a fixed window from
+// the first hit.
+func (c *Cache) Increment(ctx context.Context, key string, ttlSeconds int) (int64, *errors2.Error) {
+	ctx, span := c.tracer.Start(ctx, "Cache.Increment")
+	start := time.Now()
+	defer func() {
+		end := time.Since(start)
+		c.metrics.ObserveInstructionDBDuration("redis", "cache", "incr", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	ttl := (time.Duration(ttlSeconds) * time.Second).Milliseconds()
+	count, err := incrementScript.Run(ctx, c.client, []string{key}, ttl).Int64()
+	if err != nil {
+		return 0, errors2.ErrorIncrementCache(err)
+	}
+
+	return count, nil
+}
+
+// DeleteByPrefix removes every key starting with prefix. It walks the keyspace
+// with SCAN, so it is meant for rare administrative operations.
+func (c *Cache) DeleteByPrefix(ctx context.Context, prefix string) *errors2.Error {
+	ctx, span := c.tracer.Start(ctx, "Cache.DeleteByPrefix")
+	start := time.Now()
+	defer func() {
+		end := time.Since(start)
+		c.metrics.ObserveInstructionDBDuration("redis", "cache", "delete", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	iter := c.client.Scan(ctx, 0, globPatternEscaper.Replace(prefix)+"*", 100).Iterator()
+	for iter.Next(ctx) {
+		if err := c.client.Del(ctx, iter.Val()).Err(); err != nil {
+			return errors2.ErrorDeleteCacheByPrefix(err)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return errors2.ErrorDeleteCacheByPrefix(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const sessionPrefix = "auth:session:"
+
+// SessionCache keeps the activity of recently used sessions in Redis for ttl,
+// so authenticated requests do not reach Postgres to record it.
+type SessionCache struct {
+	cache adapter2.Cache
+	ttl   time.Duration
+}
+
+func NewSessionCache(cache adapter2.Cache, ttl time.Duration) *SessionCache {
+	return &SessionCache{
+		cache: cache,
+		ttl:   ttl,
+	}
+}
+
+func (s *SessionCache) Get(ctx context.Context, sessionID string) (*vo.SessionActivity, *errors2.Error) {
+	var activity vo.SessionActivity
+	found, err := s.cache.Get(ctx, sessionPrefix+sessionID, &activity)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &activity, nil
+}
+
+func (s *SessionCache) Set(ctx context.Context, sessionID string, activity vo.SessionActivity) *errors2.Error {
+	return s.cache.Set(ctx, sessionPrefix+sessionID, activity, ttlSeconds(s.ttl))
+}
+
+func (s *SessionCache) Delete(ctx context.Context, sessionID string) *errors2.Error {
+	return s.cache.Delete(ctx, sessionPrefix+sessionID)
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	revokedTokenPrefix   = "auth:revoked:jti:"
+	revokedSessionPrefix = "auth:revoked:sid:"
+	revokedUserPrefix    = "auth:revoked:user:"
+)
+
+// userRevocation holds the cutoff of every session of a user but the spared
+// one, which keeps the cutoff an earlier revocation had given it.
+type userRevocation struct {
+	RevokedAtMs       int64  `json:"revoked_at_ms"`
+	ExceptSessionID   string `json:"except_session_id,omitempty"`
+	ExceptRevokedAtMs int64  `json:"except_revoked_at_ms,omitempty"`
+}
+
+// cutoff is the issue time, in milliseconds, up to which the tokens of
+// sessionID are revoked.
+func (u userRevocation) cutoff(sessionID string) int64 {
+	if u.ExceptSessionID != "" && u.ExceptSessionID == sessionID {
+		return u.ExceptRevokedAtMs
+	}
+	return u.RevokedAtMs
+}
+
+// TokenDenylist keeps revoked access tokens in Redis only for as long as they
+// could still be presented: entries expire together with the tokens they cover.
+type TokenDenylist struct {
+	cache       adapter2.Cache
+	tokenExpiry time.Duration
+}
+
+func NewTokenDenylist(cache adapter2.Cache, tokenExpiry time.Duration) *TokenDenylist {
+	return &TokenDenylist{
+		cache:       cache,
+		tokenExpiry: tokenExpiry,
+	}
+}
+
+func (d *TokenDenylist) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) *errors2.Error {
+	ttl := ttlSeconds(time.Until(expiresAt))
+	if tokenID == "" || ttl <= 0 {
+		return nil
+	}
+	return d.cache.Set(ctx, revokedTokenPrefix+tokenID, true, ttl)
+}
+
+func (d *TokenDenylist) RevokeSession(ctx context.Context, sessionID string) *errors2.Error {
+	if sessionID == "" {
+		return nil
+	}
+	return d.cache.Set(ctx, revokedSessionPrefix+sessionID, true, ttlSeconds(d.tokenExpiry))
+}
+
+// RevokeUserTokens invalidates every access token issued to the user up to now,
+// optionally sparing the session that requested it. The spared session keeps
+// whatever cutoff an earlier revocation gave it, so sparing it now does not
+// bring back tokens that were already cut off.
+func (d *TokenDenylist) RevokeUserTokens(ctx context.Context, publicID, exceptSessionID string) *errors2.Error {
+	key := revokedUserPrefix + publicID
+	var previous userRevocation
+	if _, err := d.cache.Get(ctx, key, &previous); err != nil {
+		return err
+	}
+
+	revocation := userRevocation{
+		RevokedAtMs:     time.Now().UTC().UnixMilli(),
+		ExceptSessionID: exceptSessionID,
+	}
+	if exceptSessionID != "" {
+		revocation.ExceptRevokedAtMs = previous.cutoff(exceptSessionID)
+	}
+	return d.cache.Set(ctx, key, revocation, ttlSeconds(d.tokenExpiry))
+}
+
+func (d *TokenDenylist) IsRevoked(ctx context.Context, claims vo.TokenClaims) (bool, *errors2.Error) {
+	var revoked bool
+	if claims.ID != "" {
+		found, err := d.cache.Get(ctx, revokedTokenPrefix+claims.ID, &revoked)
+		if err != nil || found {
+			return found, err
+		}
+	}
+
+	if claims.SessionID != "" {
+		found, err := d.cache.Get(ctx, revokedSessionPrefix+claims.SessionID, &revoked)
+		if err != nil || found {
+			return found, err
+		}
+	}
+
+	var revocation userRevocation
+	found, err := d.cache.Get(ctx, revokedUserPrefix+claims.PublicID, &revocation)
+	if err != nil || !found {
+		return false, err
+	}
+
+	// Both sides are in milliseconds: a token issued right after the
+	// revocation, within the same second, stays valid.
+	return claims.IssuedAt.UnixMilli() <= revocation.cutoff(claims.SessionID), nil
+}
+
+func ttlSeconds(d time.Duration) int {
+	return int(d.Round(time.Second).Seconds())
+}
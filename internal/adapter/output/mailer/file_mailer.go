@@ -0,0 +1,35 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// FileMailer writes every message to its own file in dir instead of sending
+// it. Meant for local development.
+type FileMailer struct {
+	dir string
+}
+
+func NewFileMailer(dir string) *FileMailer {
+	return &FileMailer{dir: dir}
+}
+
+func (m *FileMailer) Send(_ context.Context, message vo.MailMessage) *errors.Error {
+	if err := os.MkdirAll(m.dir, 0o750); err != nil {
+		return errors.ErrorSendMail(err)
+	}
+
+	name := fmt.Sprintf("%d.eml", time.Now().UTC().UnixNano())
+	content := fmt.Sprintf("To: %s\nSubject: %s\n\n%s\n", message.To, message.Subject, message.Body)
+	if err := os.WriteFile(filepath.Join(m.dir, name), []byte(content), 0o600); err != nil {
+		return errors.ErrorSendMail(err)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package mailer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// MemoryMailer keeps sent messages in memory so tests can inspect them.
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []vo.MailMessage
+}
+
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+func (m *MemoryMailer) Send(_ context.Context, message vo.MailMessage) *errors.Error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, message)
+	return nil
+}
+
+// Messages returns a copy of every message sent so far.
+func (m *MemoryMailer) Messages() []vo.MailMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]vo.MailMessage(nil), m.messages...)
+}
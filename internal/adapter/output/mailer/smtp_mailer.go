@@ -0,0 +1,116 @@
+package mailer
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// SMTPMailer delivers messages through an SMTP relay. Authentication is only
+// used when a username is configured. Every delivery is bounded by timeout and
+// by the deadline of its context, so a stalled relay cannot hold a connection.
+type SMTPMailer struct {
+	host    string
+	addr    string
+	auth    smtp.Auth
+	from    string
+	timeout time.Duration
+}
+
+func NewSMTPMailer(host, port, username, password, from string, timeout time.Duration) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPMailer{
+		host:    host,
+		addr:    net.JoinHostPort(host, port),
+		auth:    auth,
+		from:    from,
+		timeout: timeout,
+	}
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message vo.MailMessage) *errors.Error {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	if err := m.send(ctx, message); err != nil {
+		return errors.ErrorSendMail(err)
+	}
+	return nil
+}
+
+// send is smtp.SendMail over a connection dialed with ctx, whose deadline
+// also bounds every read and write of the exchange.
+func (m *SMTPMailer) send(ctx context.Context, message vo.MailMessage) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp: server doesn't support AUTH")
+		}
+		if err := client.Auth(m.auth); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(m.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(m.build(message)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (m *SMTPMailer) build(message vo.MailMessage) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", m.from)
+	fmt.Fprintf(&b, "To: %s\r\n", message.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", message.Subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
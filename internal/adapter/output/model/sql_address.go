@@ -0,0 +1,70 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type Address struct {
+	ID                *int64     `db:"id"`
+	PublicID          *string    `db:"public_id"`
+	UserID            *int64     `db:"user_id"`
+	Label             *string    `db:"label"`
+	Street            *string    `db:"street"`
+	Number            *string    `db:"number"`
+	Complement        *string    `db:"complement"`
+	Neighborhood      *string    `db:"neighborhood"`
+	City              *string    `db:"city"`
+	State             *string    `db:"state"`
+	ZipCode           *string    `db:"zip_code"`
+	IsDefaultShipping bool       `db:"is_default_shipping"`
+	IsDefaultBilling  bool       `db:"is_default_billing"`
+	CreatedAt         *time.Time `db:"created_at"`
+	UpdatedAt         *time.Time `db:"updated_at"`
+}
+
+func NewAddress() *Address {
+	return &Address{}
+}
+
+func (a *Address) ToEntity() entity.Address {
+	return entity.BuilderAddress().
+		WithID(util.ToInt64(a.ID)).
+		WithPublicID(util.ToString(a.PublicID)).
+		WithUserID(util.ToInt64(a.UserID)).
+		WithLabel(util.ToString(a.Label)).
+		WithStreet(util.ToString(a.Street)).
+		WithNumber(util.ToString(a.Number)).
+		WithComplement(util.ToString(a.Complement)).
+		WithNeighborhood(util.ToString(a.Neighborhood)).
+		WithCity(util.ToString(a.City)).
+		WithState(util.ToString(a.State)).
+		WithZipCode(util.ToString(a.ZipCode)).
+		WithDefaultShipping(a.IsDefaultShipping).
+		WithDefaultBilling(a.IsDefaultBilling).
+		WithCreatedAt(util.ToTime(a.CreatedAt)).
+		WithUpdatedAt(util.ToTime(a.UpdatedAt)).
+		Build()
+}
+
+func (a *Address) ToModel(address entity.Address) *Address {
+	dateNow := time.Now().UTC()
+	return &Address{
+		PublicID:          util.ToStringPointer(address.PublicID()),
+		UserID:            util.ToInt64Pointer(address.UserID()),
+		Label:             toNullableString(address.Label()),
+		Street:            util.ToStringPointer(address.Street()),
+		Number:            util.ToStringPointer(address.Number()),
+		Complement:        toNullableString(address.Complement()),
+		Neighborhood:      util.ToStringPointer(address.Neighborhood()),
+		City:              util.ToStringPointer(address.City()),
+		State:             util.ToStringPointer(address.State()),
+		ZipCode:           util.ToStringPointer(address.ZipCode()),
+		IsDefaultShipping: address.IsDefaultShipping(),
+		IsDefaultBilling:  address.IsDefaultBilling(),
+		CreatedAt:         util.ToTimePointer(dateNow),
+		UpdatedAt:         util.ToTimePointer(dateNow),
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type DataExport struct {
+	ID            *int64     `db:"id"`
+	PublicID      *string    `db:"public_id"`
+	UserID        *int64     `db:"user_id"`
+	Format        *string    `db:"format"`
+	Status        *string    `db:"status"`
+	Payload       []byte     `db:"payload"`
+	FailureReason *string    `db:"failure_reason"`
+	RequestedAt   *time.Time `db:"requested_at"`
+	CompletedAt   *time.Time `db:"completed_at"`
+	ExpiresAt     *time.Time `db:"expires_at"`
+}
+
+func NewDataExport() *DataExport {
+	return &DataExport{}
+}
+
+func (d *DataExport) ToEntity() entity.DataExport {
+	return entity.BuilderDataExport().
+		WithID(util.ToInt64(d.ID)).
+		WithPublicID(util.ToString(d.PublicID)).
+		WithUserID(util.ToInt64(d.UserID)).
+		WithFormat(entity.ExportFormat(util.ToString(d.Format))).
+		WithStatus(entity.ExportStatus(util.ToString(d.Status))).
+		WithPayload(d.Payload).
+		WithFailureReason(util.ToString(d.FailureReason)).
+		WithRequestedAt(util.ToTime(d.RequestedAt)).
+		WithCompletedAt(d.CompletedAt).
+		WithExpiresAt(d.ExpiresAt).
+		Build()
+}
+
+func (d *DataExport) ToModel(export entity.DataExport) *DataExport {
+	return &DataExport{
+		PublicID:    util.ToStringPointer(export.PublicID()),
+		UserID:      util.ToInt64Pointer(export.UserID()),
+		Format:      util.ToStringPointer(string(export.Format())),
+		Status:      util.ToStringPointer(string(export.Status())),
+		RequestedAt: util.ToTimePointer(time.Now().UTC()),
+	}
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type AuthorizationCode struct {
+	ID            *int64     `db:"id"`
+	CodeHash      *string    `db:"code_hash"`
+	ClientID      *int64     `db:"client_id"`
+	UserID        *int64     `db:"user_id"`
+	RedirectURI   *string    `db:"redirect_uri"`
+	Scopes        []string   `db:"scopes"`
+	CodeChallenge *string    `db:"code_challenge"`
+	Nonce         *string    `db:"nonce"`
+	AuthTime      *time.Time `db:"auth_time"`
+	ExpiresAt     *time.Time `db:"expires_at"`
+	ConsumedAt    *time.Time `db:"consumed_at"`
+	CreatedAt     *time.Time `db:"created_at"`
+}
+
+func NewAuthorizationCode() *AuthorizationCode {
+	return &AuthorizationCode{}
+}
+
+func (a *AuthorizationCode) ToEntity() entity.AuthorizationCode {
+	return entity.BuilderAuthorizationCode().
+		WithID(util.ToInt64(a.ID)).
+		WithCodeHash(util.ToString(a.CodeHash)).
+		WithClientID(util.ToInt64(a.ClientID)).
+		WithUserID(util.ToInt64(a.UserID)).
+		WithRedirectURI(util.ToString(a.RedirectURI)).
+		WithScopes(a.Scopes).
+		WithCodeChallenge(util.ToString(a.CodeChallenge)).
+		WithNonce(util.ToString(a.Nonce)).
+		WithAuthTime(a.AuthTime).
+		WithExpiresAt(util.ToTime(a.ExpiresAt)).
+		WithConsumedAt(a.ConsumedAt).
+		WithCreatedAt(util.ToTime(a.CreatedAt)).
+		Build()
+}
+
+func (a *AuthorizationCode) ToModel(code entity.AuthorizationCode) *AuthorizationCode {
+	return &AuthorizationCode{
+		CodeHash:      util.ToStringPointer(code.CodeHash()),
+		ClientID:      util.ToInt64Pointer(code.ClientID()),
+		UserID:        util.ToInt64Pointer(code.UserID()),
+		RedirectURI:   util.ToStringPointer(code.RedirectURI()),
+		Scopes:        nonNilStrings(code.Scopes()),
+		CodeChallenge: util.ToStringPointer(code.CodeChallenge()),
+		Nonce:         toNullableString(code.Nonce()),
+		AuthTime:      code.AuthTime(),
+		ExpiresAt:     util.ToTimePointer(code.ExpiresAt()),
+		CreatedAt:     util.ToTimePointer(time.Now().UTC()),
+	}
+}
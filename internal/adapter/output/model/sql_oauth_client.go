@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type OAuthClient struct {
+	ID               *int64     `db:"id"`
+	ClientID         *string    `db:"client_id"`
+	ClientSecretHash *string    `db:"client_secret_hash"`
+	Name             *string    `db:"name"`
+	RedirectURIs     []string   `db:"redirect_uris"`
+	Scopes           []string   `db:"scopes"`
+	GrantTypes       []string   `db:"grant_types"`
+	Audiences        []string   `db:"audiences"`
+	DisabledAt       *time.Time `db:"disabled_at"`
+	CreatedAt        *time.Time `db:"created_at"`
+	UpdatedAt        *time.Time `db:"updated_at"`
+}
+
+func NewOAuthClient() *OAuthClient {
+	return &OAuthClient{}
+}
+
+func (c *OAuthClient) ToEntity() entity.OAuthClient {
+	return entity.BuilderOAuthClient().
+		WithID(util.ToInt64(c.ID)).
+		WithClientID(util.ToString(c.ClientID)).
+		WithSecretHash(util.ToString(c.ClientSecretHash)).
+		WithName(util.ToString(c.Name)).
+		WithRedirectURIs(c.RedirectURIs).
+		WithScopes(c.Scopes).
+		WithGrantTypes(c.GrantTypes).
+		WithAudiences(c.Audiences).
+		WithDisabledAt(c.DisabledAt).
+		WithCreatedAt(util.ToTime(c.CreatedAt)).
+		WithUpdatedAt(util.ToTime(c.UpdatedAt)).
+		Build()
+}
+
+func (c *OAuthClient) ToModel(client entity.OAuthClient) *OAuthClient {
+	dateNow := time.Now().UTC()
+	return &OAuthClient{
+		ClientID:         util.ToStringPointer(client.ClientID()),
+		ClientSecretHash: toNullableString(client.SecretHash()),
+		Name:             util.ToStringPointer(client.Name()),
+		RedirectURIs:     nonNilStrings(client.RedirectURIs()),
+		Scopes:           nonNilStrings(client.Scopes()),
+		GrantTypes:       nonNilStrings(client.GrantTypes()),
+		Audiences:        nonNilStrings(client.Audiences()),
+		DisabledAt:       client.DisabledAt(),
+		CreatedAt:        util.ToTimePointer(dateNow),
+		UpdatedAt:        util.ToTimePointer(dateNow),
+	}
+}
+
+// nonNilStrings keeps empty lists from being stored as NULL arrays.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
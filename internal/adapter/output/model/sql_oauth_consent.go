@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type OAuthConsent struct {
+	ID         *int64     `db:"id"`
+	UserID     *int64     `db:"user_id"`
+	ClientID   *int64     `db:"client_id"`
+	ClientName *string    `db:"client_name"`
+	Scopes     []string   `db:"scopes"`
+	CreatedAt  *time.Time `db:"created_at"`
+	UpdatedAt  *time.Time `db:"updated_at"`
+}
+
+func NewOAuthConsent() *OAuthConsent {
+	return &OAuthConsent{}
+}
+
+func (c *OAuthConsent) ToEntity() entity.OAuthConsent {
+	return entity.BuilderOAuthConsent().
+		WithID(util.ToInt64(c.ID)).
+		WithUserID(util.ToInt64(c.UserID)).
+		WithClientID(util.ToInt64(c.ClientID)).
+		WithClientName(util.ToString(c.ClientName)).
+		WithScopes(c.Scopes).
+		WithCreatedAt(util.ToTime(c.CreatedAt)).
+		WithUpdatedAt(util.ToTime(c.UpdatedAt)).
+		Build()
+}
+
+func (c *OAuthConsent) ToModel(consent entity.OAuthConsent) *OAuthConsent {
+	dateNow := time.Now().UTC()
+	return &OAuthConsent{
+		UserID:    util.ToInt64Pointer(consent.UserID()),
+		ClientID:  util.ToInt64Pointer(consent.ClientID()),
+		Scopes:    nonNilStrings(consent.Scopes()),
+		CreatedAt: util.ToTimePointer(dateNow),
+		UpdatedAt: util.ToTimePointer(dateNow),
+	}
+}
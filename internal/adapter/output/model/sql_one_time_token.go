@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type OneTimeToken struct {
+	ID        *int64     `db:"id"`
+	UserID    *int64     `db:"user_id"`
+	Purpose   *string    `db:"purpose"`
+	TokenHash *string    `db:"token_hash"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt *time.Time `db:"created_at"`
+}
+
+func NewOneTimeToken() *OneTimeToken {
+	return &OneTimeToken{}
+}
+
+func (o *OneTimeToken) ToEntity() entity.OneTimeToken {
+	return entity.BuilderOneTimeToken().
+		WithID(util.ToInt64(o.ID)).
+		WithUserID(util.ToInt64(o.UserID)).
+		WithPurpose(entity.TokenPurpose(util.ToString(o.Purpose))).
+		WithTokenHash(util.ToString(o.TokenHash)).
+		WithExpiresAt(util.ToTime(o.ExpiresAt)).
+		WithUsedAt(o.UsedAt).
+		WithCreatedAt(util.ToTime(o.CreatedAt)).
+		Build()
+}
+
+func (o *OneTimeToken) ToModel(token entity.OneTimeToken) *OneTimeToken {
+	dateNow := time.Now().UTC()
+	return &OneTimeToken{
+		UserID:    util.ToInt64Pointer(token.UserID()),
+		Purpose:   util.ToStringPointer(string(token.Purpose())),
+		TokenHash: util.ToStringPointer(token.TokenHash()),
+		ExpiresAt: util.ToTimePointer(token.ExpiresAt().UTC()),
+		CreatedAt: util.ToTimePointer(dateNow),
+	}
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type RefreshToken struct {
+	ID        *int64     `db:"id"`
+	UserID    *int64     `db:"user_id"`
+	FamilyID  *string    `db:"family_id"`
+	TokenHash *string    `db:"token_hash"`
+	ExpiresAt *time.Time `db:"expires_at"`
+	RotatedAt *time.Time `db:"rotated_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt *time.Time `db:"created_at"`
+}
+
+func NewRefreshToken() *RefreshToken {
+	return &RefreshToken{}
+}
+
+func (r *RefreshToken) ToEntity() entity.RefreshToken {
+	return entity.BuilderRefreshToken().
+		WithID(util.ToInt64(r.ID)).
+		WithUserID(util.ToInt64(r.UserID)).
+		WithFamilyID(util.ToString(r.FamilyID)).
+		WithTokenHash(util.ToString(r.TokenHash)).
+		WithExpiresAt(util.ToTime(r.ExpiresAt)).
+		WithRotatedAt(r.RotatedAt).
+		WithRevokedAt(r.RevokedAt).
+		WithCreatedAt(util.ToTime(r.CreatedAt)).
+		Build()
+}
+
+func (r *RefreshToken) ToModel(token entity.RefreshToken) *RefreshToken {
+	dateNow := time.Now().UTC()
+	return &RefreshToken{
+		UserID:    util.ToInt64Pointer(token.UserID()),
+		FamilyID:  util.ToStringPointer(token.FamilyID()),
+		TokenHash: util.ToStringPointer(token.TokenHash()),
+		ExpiresAt: util.ToTimePointer(token.ExpiresAt().UTC()),
+		CreatedAt: util.ToTimePointer(dateNow),
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type Session struct {
+	ID         *int64     `db:"id"`
+	UserID     *int64     `db:"user_id"`
+	FamilyID   *string    `db:"family_id"`
+	DeviceName *string    `db:"device_name"`
+	UserAgent  *string    `db:"user_agent"`
+	IPAddress  *string    `db:"ip_address"`
+	CreatedAt  *time.Time `db:"created_at"`
+	LastSeenAt *time.Time `db:"last_seen_at"`
+}
+
+func NewSession() *Session {
+	return &Session{}
+}
+
+func (s *Session) ToEntity() entity.Session {
+	return entity.BuilderSession().
+		WithID(util.ToInt64(s.ID)).
+		WithUserID(util.ToInt64(s.UserID)).
+		WithFamilyID(util.ToString(s.FamilyID)).
+		WithDeviceName(util.ToString(s.DeviceName)).
+		WithUserAgent(util.ToString(s.UserAgent)).
+		WithIPAddress(util.ToString(s.IPAddress)).
+		WithCreatedAt(util.ToTime(s.CreatedAt)).
+		WithLastSeenAt(util.ToTime(s.LastSeenAt)).
+		Build()
+}
+
+func (s *Session) ToModel(session entity.Session) *Session {
+	dateNow := time.Now().UTC()
+	return &Session{
+		UserID:     util.ToInt64Pointer(session.UserID()),
+		FamilyID:   util.ToStringPointer(session.FamilyID()),
+		DeviceName: util.ToStringPointer(session.DeviceName()),
+		UserAgent:  util.ToStringPointer(session.UserAgent()),
+		IPAddress:  util.ToStringPointer(session.IPAddress()),
+		CreatedAt:  util.ToTimePointer(dateNow),
+		LastSeenAt: util.ToTimePointer(dateNow),
+	}
+}
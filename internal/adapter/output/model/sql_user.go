@@ -8,15 +8,19 @@ import (
 )
 
 type User struct {
-	ID        *int64     `db:"id"`
-	PublicID  *string    `db:"public_id"`
-	Email     *string    `db:"email"`
-	Password  *string    `db:"password"`
-	Name      *string    `db:"name"`
-	Role      *string    `db:"role"`
-	CreatedAt *time.Time `db:"created_at"`
-	UpdatedAt *time.Time `db:"updated_at"`
-	DeletedAt *time.Time `db:"deleted_at"`
+	ID              *int64     `db:"id"`
+	PublicID        *string    `db:"public_id"`
+	Email           *string    `db:"email"`
+	EmailVerifiedAt *time.Time `db:"email_verified_at"`
+	Password        *string    `db:"password"`
+	Name            *string    `db:"name"`
+	Role            *string    `db:"role"`
+	AvatarURL       *string    `db:"avatar_url"`
+	Phone           *string    `db:"phone"`
+	PhoneVerifiedAt *time.Time `db:"phone_verified_at"`
+	CreatedAt       *time.Time `db:"created_at"`
+	UpdatedAt       *time.Time `db:"updated_at"`
+	DeletedAt       *time.Time `db:"deleted_at"`
 }
 
 func NewUser() *User {
@@ -32,9 +36,13 @@ func (u *User) ToEntity() entity.User {
 		WithID(util.ToInt64(u.ID)).
 		WithPublicID(util.ToString(u.PublicID)).
 		WithEmail(util.ToString(u.Email)).
+		WithEmailVerifiedAt(u.EmailVerifiedAt).
 		WithPassword(util.ToString(u.Password)).
 		WithName(util.ToString(u.Name)).
 		WithRole(roleType).
+		WithAvatarURL(util.ToString(u.AvatarURL)).
+		WithPhone(util.ToString(u.Phone)).
+		WithPhoneVerifiedAt(u.PhoneVerifiedAt).
 		WithCreateAT(util.ToTime(u.CreatedAt)).
 		WithUpdateAT(util.ToTime(u.UpdatedAt)).
 		WithDeletedAt(u.DeletedAt).
@@ -44,12 +52,21 @@ func (u *User) ToEntity() entity.User {
 func (u *User) ToModel(user entity.User) *User {
 	dateNow := time.Now().UTC()
 	return &User{
-		PublicID:  util.ToStringPointer(user.PublicID()),
-		Email:     util.ToStringPointer(user.Email()),
-		Password:  util.ToStringPointer(user.PasswordHash()),
-		Name:      util.ToStringPointer(user.Name()),
-		Role:      util.ToStringPointer(user.Role()),
-		CreatedAt: util.ToTimePointer(dateNow),
-		UpdatedAt: util.ToTimePointer(dateNow),
+		PublicID:        util.ToStringPointer(user.PublicID()),
+		Email:           util.ToStringPointer(user.Email()),
+		EmailVerifiedAt: user.EmailVerifiedAt(),
+		Password:        util.ToStringPointer(user.PasswordHash()),
+		Name:            util.ToStringPointer(user.Name()),
+		Role:            util.ToStringPointer(user.Role()),
+		AvatarURL:       toNullableString(user.AvatarURL()),
+		CreatedAt:       util.ToTimePointer(dateNow),
+		UpdatedAt:       util.ToTimePointer(dateNow),
 	}
 }
+
+func toNullableString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
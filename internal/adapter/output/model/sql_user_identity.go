@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type UserIdentity struct {
+	ID          *int64     `db:"id"`
+	UserID      *int64     `db:"user_id"`
+	Provider    *string    `db:"provider"`
+	Subject     *string    `db:"subject"`
+	Email       *string    `db:"email"`
+	CreatedAt   *time.Time `db:"created_at"`
+	LastLoginAt *time.Time `db:"last_login_at"`
+}
+
+func NewUserIdentity() *UserIdentity {
+	return &UserIdentity{}
+}
+
+func (u *UserIdentity) ToEntity() entity.UserIdentity {
+	return entity.BuilderUserIdentity().
+		WithID(util.ToInt64(u.ID)).
+		WithUserID(util.ToInt64(u.UserID)).
+		WithProvider(util.ToString(u.Provider)).
+		WithSubject(util.ToString(u.Subject)).
+		WithEmail(util.ToString(u.Email)).
+		WithCreatedAt(util.ToTime(u.CreatedAt)).
+		WithLastLoginAt(util.ToTime(u.LastLoginAt)).
+		Build()
+}
+
+func (u *UserIdentity) ToModel(identity entity.UserIdentity) *UserIdentity {
+	dateNow := time.Now().UTC()
+	return &UserIdentity{
+		UserID:      util.ToInt64Pointer(identity.UserID()),
+		Provider:    util.ToStringPointer(identity.Provider()),
+		Subject:     util.ToStringPointer(identity.Subject()),
+		Email:       toNullableString(identity.Email()),
+		CreatedAt:   util.ToTimePointer(dateNow),
+		LastLoginAt: util.ToTimePointer(dateNow),
+	}
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type UserMFA struct {
+	UserID       *int64     `db:"user_id"`
+	TOTPSecret   *string    `db:"totp_secret"`
+	ConfirmedAt  *time.Time `db:"confirmed_at"`
+	LastUsedStep *int64     `db:"last_used_step"`
+	CreatedAt    *time.Time `db:"created_at"`
+	UpdatedAt    *time.Time `db:"updated_at"`
+}
+
+func NewUserMFA() *UserMFA {
+	return &UserMFA{}
+}
+
+func (m *UserMFA) ToEntity() entity.UserMFA {
+	return entity.BuilderUserMFA().
+		WithUserID(util.ToInt64(m.UserID)).
+		WithTOTPSecret(util.ToString(m.TOTPSecret)).
+		WithConfirmedAt(m.ConfirmedAt).
+		WithLastUsedStep(util.ToInt64(m.LastUsedStep)).
+		WithCreatedAt(util.ToTime(m.CreatedAt)).
+		WithUpdatedAt(util.ToTime(m.UpdatedAt)).
+		Build()
+}
+
+func (m *UserMFA) ToModel(mfa entity.UserMFA) *UserMFA {
+	dateNow := time.Now().UTC()
+	return &UserMFA{
+		UserID:       util.ToInt64Pointer(mfa.UserID()),
+		TOTPSecret:   util.ToStringPointer(mfa.TOTPSecret()),
+		ConfirmedAt:  mfa.ConfirmedAt(),
+		LastUsedStep: util.ToInt64Pointer(mfa.LastUsedStep()),
+		CreatedAt:    util.ToTimePointer(dateNow),
+		UpdatedAt:    util.ToTimePointer(dateNow),
+	}
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type WebAuthnCredential struct {
+	ID             *int64     `db:"id"`
+	UserID         *int64     `db:"user_id"`
+	CredentialID   *string    `db:"credential_id"`
+	PublicKey      []byte     `db:"public_key"`
+	Algorithm      *int64     `db:"algorithm"`
+	SignCount      *int64     `db:"sign_count"`
+	Transports     []string   `db:"transports"`
+	AAGUID         *string    `db:"aaguid"`
+	BackupEligible bool       `db:"backup_eligible"`
+	BackedUp       bool       `db:"backed_up"`
+	Name           *string    `db:"name"`
+	CreatedAt      *time.Time `db:"created_at"`
+	LastUsedAt     *time.Time `db:"last_used_at"`
+}
+
+func NewWebAuthnCredential() *WebAuthnCredential {
+	return &WebAuthnCredential{}
+}
+
+func (w *WebAuthnCredential) ToEntity() entity.WebAuthnCredential {
+	return entity.BuilderWebAuthnCredential().
+		WithID(util.ToInt64(w.ID)).
+		WithUserID(util.ToInt64(w.UserID)).
+		WithCredentialID(util.ToString(w.CredentialID)).
+		WithPublicKey(w.PublicKey).
+		WithAlgorithm(util.ToInt64(w.Algorithm)).
+		WithSignCount(uint32(util.ToInt64(w.SignCount))).
+		WithTransports(w.Transports).
+		WithAAGUID(util.ToString(w.AAGUID)).
+		WithBackupEligible(w.BackupEligible).
+		WithBackedUp(w.BackedUp).
+		WithName(util.ToString(w.Name)).
+		WithCreatedAt(util.ToTime(w.CreatedAt)).
+		WithLastUsedAt(w.LastUsedAt).
+		Build()
+}
+
+func (w *WebAuthnCredential) ToModel(credential entity.WebAuthnCredential) *WebAuthnCredential {
+	dateNow := time.Now().UTC()
+	return &WebAuthnCredential{
+		UserID:         util.ToInt64Pointer(credential.UserID()),
+		CredentialID:   util.ToStringPointer(credential.CredentialID()),
+		PublicKey:      credential.PublicKey(),
+		Algorithm:      util.ToInt64Pointer(credential.Algorithm()),
+		SignCount:      util.ToInt64Pointer(int64(credential.SignCount())),
+		Transports:     nonNilStrings(credential.Transports()),
+		AAGUID:         util.ToStringPointer(credential.AAGUID()),
+		BackupEligible: credential.BackupEligible(),
+		BackedUp:       credential.BackedUp(),
+		Name:           util.ToStringPointer(credential.Name()),
+		CreatedAt:      util.ToTimePointer(dateNow),
+	}
+}
@@ -0,0 +1,65 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	requestTimeout   = 10 * time.Second
+	maxResponseBytes = 1 << 20
+)
+
+func newHTTPClient() *http.Client {
+	return &http.Client{Timeout: requestTimeout}
+}
+
+func getJSON(ctx context.Context, client *http.Client, endpoint string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	return doJSON(client, req, out)
+}
+
+func postForm(ctx context.Context, client *http.Client, endpoint string, form url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return doJSON(client, req, out)
+}
+
+func doJSON(client *http.Client, req *http.Request, out any) error {
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		// the query may carry secrets, keep it out of the error
+		return fmt.Errorf("%s %s://%s%s responded %d: %s", req.Method, req.URL.Scheme, req.URL.Host, req.URL.Path, resp.StatusCode, truncate(body, 200))
+	}
+	return json.Unmarshal(body, out)
+}
+
+func truncate(body []byte, limit int) string {
+	if len(body) > limit {
+		return string(body[:limit])
+	}
+	return string(body)
+}
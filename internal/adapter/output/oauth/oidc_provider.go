@@ -0,0 +1,211 @@
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+var oidcScopes = []string{"openid", "email", "profile"}
+
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	IDToken     string `json:"id_token"`
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+}
+
+// OIDCProvider signs users in with any OpenID Connect issuer. Endpoints are
+// discovered from the issuer and its signing keys are cached, being fetched
+// again whenever an id_token carries an unknown kid.
+type OIDCProvider struct {
+	name         string
+	issuer       string
+	clientID     string
+	clientSecret string
+	client       *http.Client
+	mu           sync.Mutex
+	discovery    *oidcDiscovery
+	keys         map[string]crypto.PublicKey
+}
+
+func NewOIDCProvider(name, issuer, clientID, clientSecret string) *OIDCProvider {
+	return &OIDCProvider{
+		name:         name,
+		issuer:       strings.TrimSuffix(issuer, "/"),
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		client:       newHTTPClient(),
+	}
+}
+
+func (p *OIDCProvider) AuthorizationURL(ctx context.Context, login vo.OAuthLoginState) (string, *errors.Error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", errors.ErrorOAuthProviderRequest(err)
+	}
+
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.clientID},
+		"redirect_uri":          {login.RedirectURI},
+		"scope":                 {strings.Join(oidcScopes, " ")},
+		"state":                 {login.State},
+		"nonce":                 {login.Nonce},
+		"code_challenge":        {vo.PKCEChallenge(login.CodeVerifier)},
+		"code_challenge_method": {vo.PKCEMethodS256},
+	}
+	return appendQuery(discovery.AuthorizationEndpoint, query), nil
+}
+
+func (p *OIDCProvider) Exchange(ctx context.Context, code string, login vo.OAuthLoginState) (*vo.ExternalIdentity, *errors.Error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, errors.ErrorOAuthProviderRequest(err)
+	}
+
+	var token oidcTokenResponse
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {login.RedirectURI},
+		"client_id":     {p.clientID},
+		"client_secret": {p.clientSecret},
+		"code_verifier": {login.CodeVerifier},
+	}
+	if err := postForm(ctx, p.client, discovery.TokenEndpoint, form, &token); err != nil {
+		return nil, errors.ErrorOAuthCodeExchange(err)
+	}
+	if token.IDToken == "" {
+		return nil, errors.ErrorInvalidIDToken(fmt.Errorf("token response of %s has no id_token", p.name))
+	}
+
+	claims := &idTokenClaims{}
+	_, err = jwt.ParseWithClaims(token.IDToken, claims,
+		func(t *jwt.Token) (any, error) {
+			kid, _ := t.Header["kid"].(string)
+			return p.key(ctx, discovery.JWKSURI, kid)
+		},
+		jwt.WithIssuer(discovery.Issuer),
+		jwt.WithAudience(p.clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods([]string{security.AlgorithmRS256, security.AlgorithmES256, security.AlgorithmEdDSA}),
+	)
+	if err != nil {
+		return nil, errors.ErrorInvalidIDToken(err)
+	}
+	if claims.Nonce != login.Nonce {
+		return nil, errors.ErrorInvalidIDToken(fmt.Errorf("id_token nonce mismatch"))
+	}
+	if claims.Subject == "" {
+		return nil, errors.ErrorInvalidIDToken(fmt.Errorf("id_token has no subject"))
+	}
+
+	return &vo.ExternalIdentity{
+		Provider:      p.name,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.Email != "" && isTrue(claims.EmailVerified),
+		Name:          claims.Name,
+		AvatarURL:     claims.Picture,
+	}, nil
+}
+
+func (p *OIDCProvider) discover(ctx context.Context) (*oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return p.discovery, nil
+	}
+
+	var discovery oidcDiscovery
+	if err := getJSON(ctx, p.client, p.issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != p.issuer {
+		return nil, fmt.Errorf("discovery issuer %q does not match %q", discovery.Issuer, p.issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document of %s is incomplete", p.issuer)
+	}
+
+	p.discovery = &discovery
+	return p.discovery, nil
+}
+
+func (p *OIDCProvider) key(ctx context.Context, jwksURI, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.keys[kid]; ok {
+		return key, nil
+	}
+
+	var set vo.JSONWebKeySet
+	if err := getJSON(ctx, p.client, jwksURI, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		if key, err := security.PublicKeyFromJWK(jwk); err == nil {
+			keys[jwk.KeyID] = key
+		}
+	}
+	p.keys = keys
+
+	key, ok := p.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("no signing key with kid %q", kid)
+	}
+	return key, nil
+}
+
+// isTrue accepts both booleans and the "true" string some issuers send.
+func isTrue(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		parsed, _ := strconv.ParseBool(v)
+		return parsed
+	default:
+		return false
+	}
+}
+
+func appendQuery(endpoint string, query url.Values) string {
+	if strings.Contains(endpoint, "?") {
+		return endpoint + "&" + query.Encode()
+	}
+	return endpoint + "?" + query.Encode()
+}
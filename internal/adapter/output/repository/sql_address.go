@@ -0,0 +1,259 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+const addressColumns = `id, public_id, user_id, label, street, number, complement, neighborhood, city, state,
+	zip_code, is_default_shipping, is_default_billing, created_at, updated_at`
+
+type Address struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.Address
+}
+
+func NewAddressRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *Address {
+	return &Address{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (a *Address) CreateAddress(ctx context.Context, address entity.Address) (*entity.Address, *errors.Error) {
+	start := time.Now()
+	ctx, span := a.tracer.Start(ctx, "AddressRepository.CreateAddress")
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "user_addresses", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	m := a.ToModel(address)
+
+	const query = `
+	INSERT INTO user_addresses (public_id, user_id, label, street, number, complement, neighborhood, city, state,
+		zip_code, is_default_shipping, is_default_billing, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	RETURNING ` + addressColumns
+
+	rows, err := a.list(ctx, query,
+		m.PublicID, m.UserID, m.Label, m.Street, m.Number, m.Complement, m.Neighborhood, m.City, m.State,
+		m.ZipCode, m.IsDefaultShipping, m.IsDefaultBilling, m.CreatedAt, m.UpdatedAt)
+	if err == nil && len(rows) == 0 {
+		err = pgx.ErrNoRows
+	}
+	if err != nil {
+		return nil, errors.CreateAddressError(err)
+	}
+
+	return &rows[0], nil
+}
+
+func (a *Address) ListAddressesByUserID(ctx context.Context, userID int64) ([]entity.Address, *errors.Error) {
+	ctx, span := a.tracer.Start(ctx, "AddressRepository.ListAddressesByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "user_addresses", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT ` + addressColumns + `
+	FROM user_addresses
+	WHERE user_id = $1
+	ORDER BY created_at, id`
+
+	addresses, err := a.list(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorListAddresses(err)
+	}
+
+	return addresses, nil
+}
+
+func (a *Address) FindAddressByPublicID(ctx context.Context, userID int64, publicID string) (*entity.Address, *errors.Error) {
+	ctx, span := a.tracer.Start(ctx, "AddressRepository.FindAddressByPublicID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "user_addresses", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT ` + addressColumns + `
+	FROM user_addresses
+	WHERE user_id = $1 AND public_id = $2`
+
+	addresses, err := a.list(ctx, query, userID, publicID)
+	if err != nil {
+		return nil, errors.ErrorFindAddressByPublicID(err)
+	}
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	return &addresses[0], nil
+}
+
+func (a *Address) UpdateAddress(ctx context.Context, address entity.Address) (*entity.Address, *errors.Error) {
+	ctx, span := a.tracer.Start(ctx, "AddressRepository.UpdateAddress")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "user_addresses", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	m := a.ToModel(address)
+
+	const query = `
+	UPDATE user_addresses
+	SET label = $3, street = $4, number = $5, complement = $6, neighborhood = $7, city = $8, state = $9,
+		zip_code = $10, is_default_shipping = $11, is_default_billing = $12, updated_at = $13
+	WHERE user_id = $1 AND public_id = $2
+	RETURNING ` + addressColumns
+
+	addresses, err := a.list(ctx, query,
+		m.UserID, m.PublicID, m.Label, m.Street, m.Number, m.Complement, m.Neighborhood, m.City, m.State,
+		m.ZipCode, m.IsDefaultShipping, m.IsDefaultBilling, m.UpdatedAt)
+	if err != nil {
+		return nil, errors.ErrorUpdateAddress(err)
+	}
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	return &addresses[0], nil
+}
+
+func (a *Address) DeleteAddress(ctx context.Context, userID int64, publicID string) (bool, *errors.Error) {
+	ctx, span := a.tracer.Start(ctx, "AddressRepository.DeleteAddress")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "user_addresses", "delete", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `DELETE FROM user_addresses WHERE user_id = $1 AND public_id = $2`
+
+	tag, err := a.resolveDB(ctx).Exec(ctx, query, userID, publicID)
+	if err != nil {
+		return false, errors.ErrorDeleteAddress(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// ClearDefaultAddresses unsets the requested default flags of the user so a
+// new default can be written without hitting the partial unique indexes.
+func (a *Address) ClearDefaultAddresses(ctx context.Context, userID int64, shipping, billing bool) *errors.Error {
+	if !shipping && !billing {
+		return nil
+	}
+
+	ctx, span := a.tracer.Start(ctx, "AddressRepository.ClearDefaultAddresses")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "user_addresses", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE user_addresses
+	SET is_default_shipping = CASE WHEN $2 THEN false ELSE is_default_shipping END,
+		is_default_billing = CASE WHEN $3 THEN false ELSE is_default_billing END
+	WHERE user_id = $1 AND (($2 AND is_default_shipping) OR ($3 AND is_default_billing))`
+
+	if _, err := a.resolveDB(ctx).Exec(ctx, query, userID, shipping, billing); err != nil {
+		return errors.ErrorClearDefaultAddresses(err)
+	}
+
+	return nil
+}
+
+// LockUserAddresses locks the row of the user until the transaction ends, so
+// concurrent writers of the same user's addresses run one after another and
+// each counts what the previous one committed.
+func (a *Address) LockUserAddresses(ctx context.Context, userID int64) *errors.Error {
+	ctx, span := a.tracer.Start(ctx, "AddressRepository.LockUserAddresses")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "users", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `SELECT id FROM users WHERE id = $1 FOR UPDATE`
+
+	if _, err := a.resolveDB(ctx).Exec(ctx, query, userID); err != nil {
+		return errors.ErrorLockUserAddresses(err)
+	}
+
+	return nil
+}
+
+func (a *Address) list(ctx context.Context, query string, args ...any) ([]entity.Address, error) {
+	rows, err := a.resolveDB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	addresses := make([]entity.Address, 0)
+	for rows.Next() {
+		var m model.Address
+		err := rows.Scan(
+			&m.ID,
+			&m.PublicID,
+			&m.UserID,
+			&m.Label,
+			&m.Street,
+			&m.Number,
+			&m.Complement,
+			&m.Neighborhood,
+			&m.City,
+			&m.State,
+			&m.ZipCode,
+			&m.IsDefaultShipping,
+			&m.IsDefaultBilling,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, m.ToEntity())
+	}
+
+	return addresses, rows.Err()
+}
+
+func (a *Address) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return a.DB
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+// dataExportColumns leaves the payload out; only downloads need it.
+const dataExportColumns = `id, public_id, user_id, format, status, NULL::bytea AS payload, failure_reason,
+	requested_at, completed_at, expires_at`
+
+type DataExport struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.DataExport
+}
+
+func NewDataExportRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *DataExport {
+	return &DataExport{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (d *DataExport) CreateDataExport(ctx context.Context, export entity.DataExport) (*entity.DataExport, *errors.Error) {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.CreateDataExport")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	m := d.ToModel(export)
+
+	const query = `
+	INSERT INTO data_exports (public_id, user_id, format, status, requested_at)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING ` + dataExportColumns
+
+	rows, err := d.list(ctx, query, m.PublicID, m.UserID, m.Format, m.Status, m.RequestedAt)
+	if err == nil && len(rows) == 0 {
+		err = pgx.ErrNoRows
+	}
+	if err != nil {
+		return nil, errors.ErrorCreateDataExport(err)
+	}
+
+	return &rows[0], nil
+}
+
+func (d *DataExport) ListDataExportsByUserID(ctx context.Context, userID int64) ([]entity.DataExport, *errors.Error) {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.ListDataExportsByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT ` + dataExportColumns + `
+	FROM data_exports
+	WHERE user_id = $1
+	ORDER BY requested_at DESC, id DESC`
+
+	exports, err := d.list(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorListDataExports(err)
+	}
+
+	return exports, nil
+}
+
+func (d *DataExport) FindDataExportByPublicID(ctx context.Context, userID int64, publicID string) (*entity.DataExport, *errors.Error) {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.FindDataExportByPublicID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT ` + dataExportColumns + `
+	FROM data_exports
+	WHERE user_id = $1 AND public_id::text = $2`
+
+	exports, err := d.list(ctx, query, userID, publicID)
+	if err != nil {
+		return nil, errors.ErrorFindDataExport(err)
+	}
+	if len(exports) == 0 {
+		return nil, nil
+	}
+
+	return &exports[0], nil
+}
+
+// FindDataExportWithPayload loads the bundle itself. Callers must have
+// authorized the access, e.g. through a signed download link.
+func (d *DataExport) FindDataExportWithPayload(ctx context.Context, publicID string) (*entity.DataExport, *errors.Error) {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.FindDataExportWithPayload")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, public_id, user_id, format, status, payload, failure_reason, requested_at, completed_at, expires_at
+	FROM data_exports
+	WHERE public_id::text = $1`
+
+	exports, err := d.list(ctx, query, publicID)
+	if err != nil {
+		return nil, errors.ErrorFindDataExport(err)
+	}
+	if len(exports) == 0 {
+		return nil, nil
+	}
+
+	return &exports[0], nil
+}
+
+// ClaimPendingDataExport moves the oldest pending export to processing. Exports
+// claimed before staleBefore are claimed again, as their worker is assumed to
+// have died. Rows claimed by other workers are skipped; nil means there is
+// nothing to do.
+func (d *DataExport) ClaimPendingDataExport(ctx context.Context, staleBefore time.Time) (*entity.DataExport, *errors.Error) {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.ClaimPendingDataExport")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE data_exports
+	SET status = 'processing', claimed_at = $2
+	WHERE id = (
+		SELECT id FROM data_exports
+		WHERE status = 'pending' OR (status = 'processing' AND claimed_at < $1)
+		ORDER BY requested_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED)
+	RETURNING ` + dataExportColumns
+
+	exports, err := d.list(ctx, query, staleBefore, time.Now().UTC())
+	if err != nil {
+		return nil, errors.ErrorClaimDataExport(err)
+	}
+	if len(exports) == 0 {
+		return nil, nil
+	}
+
+	return &exports[0], nil
+}
+
+func (d *DataExport) CompleteDataExport(ctx context.Context, id int64, payload []byte, expiresAt time.Time) *errors.Error {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.CompleteDataExport")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE data_exports
+	SET status = 'ready', payload = $2, completed_at = $3, expires_at = $4
+	WHERE id = $1`
+
+	if _, err := d.resolveDB(ctx).Exec(ctx, query, id, payload, time.Now().UTC(), expiresAt); err != nil {
+		return errors.ErrorUpdateDataExport(err)
+	}
+
+	return nil
+}
+
+func (d *DataExport) FailDataExport(ctx context.Context, id int64, reason string) *errors.Error {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.FailDataExport")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE data_exports
+	SET status = 'failed', failure_reason = $2, completed_at = $3
+	WHERE id = $1`
+
+	if _, err := d.resolveDB(ctx).Exec(ctx, query, id, reason, time.Now().UTC()); err != nil {
+		return errors.ErrorUpdateDataExport(err)
+	}
+
+	return nil
+}
+
+// ExpireDataExports drops the payload of every ready export past its expiry.
+func (d *DataExport) ExpireDataExports(ctx context.Context, now time.Time) (int64, *errors.Error) {
+	ctx, span := d.tracer.Start(ctx, "DataExportRepository.ExpireDataExports")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		d.metrics.ObserveInstructionDBDuration("postgres", "data_exports", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE data_exports
+	SET status = 'expired', payload = NULL
+	WHERE status = 'ready' AND expires_at <= $1`
+
+	tag, err := d.resolveDB(ctx).Exec(ctx, query, now)
+	if err != nil {
+		return 0, errors.ErrorUpdateDataExport(err)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func (d *DataExport) list(ctx context.Context, query string, args ...any) ([]entity.DataExport, error) {
+	rows, err := d.resolveDB(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	exports := make([]entity.DataExport, 0)
+	for rows.Next() {
+		var m model.DataExport
+		err := rows.Scan(
+			&m.ID,
+			&m.PublicID,
+			&m.UserID,
+			&m.Format,
+			&m.Status,
+			&m.Payload,
+			&m.FailureReason,
+			&m.RequestedAt,
+			&m.CompletedAt,
+			&m.ExpiresAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		exports = append(exports, m.ToEntity())
+	}
+
+	return exports, rows.Err()
+}
+
+func (d *DataExport) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return d.DB
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type MFARecoveryCode struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+}
+
+func NewMFARecoveryCodeRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *MFARecoveryCode {
+	return &MFARecoveryCode{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+// ReplaceRecoveryCodes swaps every code of the user, used or not, for the
+// given set in a single statement.
+func (m *MFARecoveryCode) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) *errors.Error {
+	ctx, span := m.tracer.Start(ctx, "MFARecoveryCodeRepository.ReplaceRecoveryCodes")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		m.metrics.ObserveInstructionDBDuration("postgres", "mfa_recovery_codes", "replace", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	WITH deleted AS (
+		DELETE FROM mfa_recovery_codes WHERE user_id = $1
+	)
+	INSERT INTO mfa_recovery_codes (user_id, code_hash, created_at)
+	SELECT $1, code_hash, $3
+	FROM unnest($2::text[]) AS code_hash`
+
+	if _, err := m.resolveDB(ctx).Exec(ctx, query, userID, codeHashes, time.Now().UTC()); err != nil {
+		return errors.ErrorSaveRecoveryCodes(err)
+	}
+
+	return nil
+}
+
+// ConsumeRecoveryCode marks an unused code of the user as used. It reports
+// false when the code is unknown or was already used.
+func (m *MFARecoveryCode) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string, usedAt time.Time) (bool, *errors.Error) {
+	ctx, span := m.tracer.Start(ctx, "MFARecoveryCodeRepository.ConsumeRecoveryCode")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		m.metrics.ObserveInstructionDBDuration("postgres", "mfa_recovery_codes", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE mfa_recovery_codes
+	SET used_at = $3
+	WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL`
+
+	tag, err := m.resolveDB(ctx).Exec(ctx, query, userID, codeHash, usedAt)
+	if err != nil {
+		return false, errors.ErrorConsumeRecoveryCode(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (m *MFARecoveryCode) DeleteRecoveryCodes(ctx context.Context, userID int64) *errors.Error {
+	ctx, span := m.tracer.Start(ctx, "MFARecoveryCodeRepository.DeleteRecoveryCodes")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		m.metrics.ObserveInstructionDBDuration("postgres", "mfa_recovery_codes", "delete", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `DELETE FROM mfa_recovery_codes WHERE user_id = $1`
+
+	if _, err := m.resolveDB(ctx).Exec(ctx, query, userID); err != nil {
+		return errors.ErrorSaveRecoveryCodes(err)
+	}
+
+	return nil
+}
+
+func (m *MFARecoveryCode) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return m.DB
+}
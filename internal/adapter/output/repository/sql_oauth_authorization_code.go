@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type AuthorizationCode struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.AuthorizationCode
+}
+
+func NewAuthorizationCodeRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *AuthorizationCode {
+	return &AuthorizationCode{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (a *AuthorizationCode) CreateAuthorizationCode(ctx context.Context, code entity.AuthorizationCode) *errors.Error {
+	ctx, span := a.tracer.Start(ctx, "AuthorizationCodeRepository.CreateAuthorizationCode")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "oauth_authorization_codes", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelCode := a.ToModel(code)
+
+	const query = `
+	INSERT INTO oauth_authorization_codes (code_hash, client_id, user_id, redirect_uri, scopes, code_challenge, nonce,
+		auth_time, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+
+	_, err := a.resolveDB(ctx).Exec(ctx, query,
+		modelCode.CodeHash,
+		modelCode.ClientID,
+		modelCode.UserID,
+		modelCode.RedirectURI,
+		modelCode.Scopes,
+		modelCode.CodeChallenge,
+		modelCode.Nonce,
+		modelCode.AuthTime,
+		modelCode.ExpiresAt,
+		modelCode.CreatedAt)
+	if err != nil {
+		return errors.ErrorCreateAuthorizationCode(err)
+	}
+
+	return nil
+}
+
+// ConsumeAuthorizationCode marks the code as used and returns it, or nil when
+// it is unknown, expired or was already redeemed. The single UPDATE makes
+// concurrent redemptions of one code succeed at most once.
+func (a *AuthorizationCode) ConsumeAuthorizationCode(ctx context.Context, codeHash string, now time.Time) (*entity.AuthorizationCode, *errors.Error) {
+	ctx, span := a.tracer.Start(ctx, "AuthorizationCodeRepository.ConsumeAuthorizationCode")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		a.metrics.ObserveInstructionDBDuration("postgres", "oauth_authorization_codes", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE oauth_authorization_codes
+	SET consumed_at = $2
+	WHERE code_hash = $1 AND consumed_at IS NULL AND expires_at > $2
+	RETURNING id, code_hash, client_id, user_id, redirect_uri, scopes, code_challenge, nonce, auth_time, expires_at,
+		consumed_at, created_at`
+
+	rows, err := a.resolveDB(ctx).Query(ctx, query, codeHash, now)
+	if err != nil {
+		return nil, errors.ErrorConsumeAuthorizationCode(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorConsumeAuthorizationCode(err)
+		}
+		return nil, nil
+	}
+
+	var model model.AuthorizationCode
+	err = rows.Scan(
+		&model.ID,
+		&model.CodeHash,
+		&model.ClientID,
+		&model.UserID,
+		&model.RedirectURI,
+		&model.Scopes,
+		&model.CodeChallenge,
+		&model.Nonce,
+		&model.AuthTime,
+		&model.ExpiresAt,
+		&model.ConsumedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return nil, errors.ErrorConsumeAuthorizationCode(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+func (a *AuthorizationCode) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return a.DB
+}
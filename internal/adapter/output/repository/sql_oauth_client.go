@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type OAuthClient struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.OAuthClient
+}
+
+func NewOAuthClientRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *OAuthClient {
+	return &OAuthClient{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (o *OAuthClient) CreateClient(ctx context.Context, client entity.OAuthClient) (*entity.OAuthClient, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OAuthClientRepository.CreateClient")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "oauth_clients", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelClient := o.ToModel(client)
+
+	const query = `
+	INSERT INTO oauth_clients (client_id, client_secret_hash, name, redirect_uris, scopes, grant_types, audiences,
+		created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	RETURNING id`
+
+	var id int64
+
+	err := o.resolveDB(ctx).QueryRow(ctx, query,
+		modelClient.ClientID,
+		modelClient.ClientSecretHash,
+		modelClient.Name,
+		modelClient.RedirectURIs,
+		modelClient.Scopes,
+		modelClient.GrantTypes,
+		modelClient.Audiences,
+		modelClient.CreatedAt,
+		modelClient.UpdatedAt).Scan(&id)
+	if err != nil {
+		return nil, errors.ErrorCreateOAuthClient(err)
+	}
+
+	client.AssignID(id)
+	client.AssignCreatedAt(util.ToTime(modelClient.CreatedAt))
+	client.AssignUpdatedAt(util.ToTime(modelClient.UpdatedAt))
+	return &client, nil
+}
+
+// FindClientByClientID returns nil when no client is registered under clientID.
+func (o *OAuthClient) FindClientByClientID(ctx context.Context, clientID string) (*entity.OAuthClient, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OAuthClientRepository.FindClientByClientID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "oauth_clients", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, client_id, client_secret_hash, name, redirect_uris, scopes, grant_types, audiences, disabled_at,
+		created_at, updated_at
+	FROM oauth_clients
+	WHERE client_id = $1`
+
+	rows, err := o.resolveDB(ctx).Query(ctx, query, clientID)
+	if err != nil {
+		return nil, errors.ErrorFindOAuthClient(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorFindOAuthClient(err)
+		}
+		return nil, nil
+	}
+
+	var model model.OAuthClient
+	err = rows.Scan(
+		&model.ID,
+		&model.ClientID,
+		&model.ClientSecretHash,
+		&model.Name,
+		&model.RedirectURIs,
+		&model.Scopes,
+		&model.GrantTypes,
+		&model.Audiences,
+		&model.DisabledAt,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, errors.ErrorFindOAuthClient(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+// UpdateClientSecret replaces the secret of an enabled client. It reports false
+// when no such client exists.
+func (o *OAuthClient) UpdateClientSecret(ctx context.Context, clientID, secretHash string, updatedAt time.Time) (bool, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OAuthClientRepository.UpdateClientSecret")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "oauth_clients", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE oauth_clients
+	SET client_secret_hash = $2, updated_at = $3
+	WHERE client_id = $1 AND disabled_at IS NULL`
+
+	tag, err := o.resolveDB(ctx).Exec(ctx, query, clientID, secretHash, updatedAt)
+	if err != nil {
+		return false, errors.ErrorUpdateOAuthClient(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// DisableClient switches a client off. It reports false when the client does
+// not exist or was already disabled.
+func (o *OAuthClient) DisableClient(ctx context.Context, clientID string, disabledAt time.Time) (bool, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OAuthClientRepository.DisableClient")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "oauth_clients", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE oauth_clients
+	SET disabled_at = $2, updated_at = $2
+	WHERE client_id = $1 AND disabled_at IS NULL`
+
+	tag, err := o.resolveDB(ctx).Exec(ctx, query, clientID, disabledAt)
+	if err != nil {
+		return false, errors.ErrorUpdateOAuthClient(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (o *OAuthClient) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return o.DB
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type OAuthConsent struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.OAuthConsent
+}
+
+func NewOAuthConsentRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *OAuthConsent {
+	return &OAuthConsent{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+// FindConsent returns nil when the user never authorized the client.
+func (o *OAuthConsent) FindConsent(ctx context.Context, userID, clientID int64) (*entity.OAuthConsent, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OAuthConsentRepository.FindConsent")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "oauth_consents", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, user_id, client_id, scopes, created_at, updated_at
+	FROM oauth_consents
+	WHERE user_id = $1 AND client_id = $2`
+
+	rows, err := o.resolveDB(ctx).Query(ctx, query, userID, clientID)
+	if err != nil {
+		return nil, errors.ErrorFindOAuthConsent(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorFindOAuthConsent(err)
+		}
+		return nil, nil
+	}
+
+	var model model.OAuthConsent
+	err = rows.Scan(
+		&model.ID,
+		&model.UserID,
+		&model.ClientID,
+		&model.Scopes,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, errors.ErrorFindOAuthConsent(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+// ListConsentsByUserID returns every consent of the user with the name of its
+// client, oldest first.
+func (o *OAuthConsent) ListConsentsByUserID(ctx context.Context, userID int64) ([]entity.OAuthConsent, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OAuthConsentRepository.ListConsentsByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "oauth_consents", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT oc.id, oc.user_id, oc.client_id, c.name, oc.scopes, oc.created_at, oc.updated_at
+	FROM oauth_consents oc
+	JOIN oauth_clients c ON c.id = oc.client_id
+	WHERE oc.user_id = $1
+	ORDER BY oc.created_at, oc.id`
+
+	rows, err := o.resolveDB(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorFindOAuthConsent(err)
+	}
+	defer rows.Close()
+
+	consents := make([]entity.OAuthConsent, 0)
+	for rows.Next() {
+		var model model.OAuthConsent
+		err := rows.Scan(
+			&model.ID,
+			&model.UserID,
+			&model.ClientID,
+			&model.ClientName,
+			&model.Scopes,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.ErrorFindOAuthConsent(err)
+		}
+		consents = append(consents, model.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.ErrorFindOAuthConsent(err)
+	}
+
+	return consents, nil
+}
+
+// SaveConsent records the scopes of consent, adding them to any the user
+// had already granted to the same client.
+func (o *OAuthConsent) SaveConsent(ctx context.Context, consent entity.OAuthConsent) *errors.Error {
+	ctx, span := o.tracer.Start(ctx, "OAuthConsentRepository.SaveConsent")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "oauth_consents", "upsert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelConsent := o.ToModel(consent)
+
+	const query = `
+	INSERT INTO oauth_consents (user_id, client_id, scopes, created_at, updated_at)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT (user_id, client_id) DO UPDATE
+	SET scopes = ARRAY(SELECT DISTINCT unnest(oauth_consents.scopes || EXCLUDED.scopes) ORDER BY 1),
+		updated_at = EXCLUDED.updated_at`
+
+	_, err := o.resolveDB(ctx).Exec(ctx, query,
+		modelConsent.UserID,
+		modelConsent.ClientID,
+		modelConsent.Scopes,
+		modelConsent.CreatedAt,
+		modelConsent.UpdatedAt)
+	if err != nil {
+		return errors.ErrorSaveOAuthConsent(err)
+	}
+
+	return nil
+}
+
+func (o *OAuthConsent) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return o.DB
+}
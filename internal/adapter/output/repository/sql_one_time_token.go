@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type OneTimeToken struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.OneTimeToken
+}
+
+func NewOneTimeTokenRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *OneTimeToken {
+	return &OneTimeToken{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (o *OneTimeToken) CreateOneTimeToken(ctx context.Context, token entity.OneTimeToken) (*entity.OneTimeToken, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OneTimeTokenRepository.CreateOneTimeToken")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "one_time_tokens", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelToken := o.ToModel(token)
+
+	const query = `
+	INSERT INTO one_time_tokens (user_id, purpose, token_hash, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id`
+
+	var id int64
+
+	err := o.resolveDB(ctx).QueryRow(ctx, query,
+		modelToken.UserID,
+		modelToken.Purpose,
+		modelToken.TokenHash,
+		modelToken.ExpiresAt,
+		modelToken.CreatedAt).Scan(&id)
+	if err != nil {
+		return nil, errors.ErrorCreateOneTimeToken(err)
+	}
+
+	created := entity.BuilderOneTimeToken().
+		WithID(id).
+		WithUserID(token.UserID()).
+		WithPurpose(token.Purpose()).
+		WithTokenHash(token.TokenHash()).
+		WithExpiresAt(token.ExpiresAt()).
+		WithCreatedAt(util.ToTime(modelToken.CreatedAt)).
+		Build()
+	return &created, nil
+}
+
+// ConsumeOneTimeToken marks the token as used and returns it, in a single
+// statement so a token can only be redeemed once. Unknown, used and expired
+// tokens return nil.
+func (o *OneTimeToken) ConsumeOneTimeToken(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, now time.Time) (*entity.OneTimeToken, *errors.Error) {
+	ctx, span := o.tracer.Start(ctx, "OneTimeTokenRepository.ConsumeOneTimeToken")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "one_time_tokens", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE one_time_tokens
+	SET used_at = $3
+	WHERE token_hash = $1
+	  AND purpose = $2
+	  AND used_at IS NULL
+	  AND expires_at > $3
+	RETURNING id, user_id, purpose, token_hash, expires_at, used_at, created_at`
+
+	rows, err := o.resolveDB(ctx).Query(ctx, query, tokenHash, string(purpose), now)
+	if err != nil {
+		return nil, errors.ErrorConsumeOneTimeToken(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorConsumeOneTimeToken(err)
+		}
+		return nil, nil
+	}
+
+	var model model.OneTimeToken
+	err = rows.Scan(
+		&model.ID,
+		&model.UserID,
+		&model.Purpose,
+		&model.TokenHash,
+		&model.ExpiresAt,
+		&model.UsedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return nil, errors.ErrorConsumeOneTimeToken(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+// InvalidateOneTimeTokens burns every unused token of the user for purpose,
+// so only the most recently issued one can be redeemed.
+func (o *OneTimeToken) InvalidateOneTimeTokens(ctx context.Context, userID int64, purpose entity.TokenPurpose) *errors.Error {
+	ctx, span := o.tracer.Start(ctx, "OneTimeTokenRepository.InvalidateOneTimeTokens")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		o.metrics.ObserveInstructionDBDuration("postgres", "one_time_tokens", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE one_time_tokens
+	SET used_at = $3
+	WHERE user_id = $1 AND purpose = $2 AND used_at IS NULL`
+
+	if _, err := o.resolveDB(ctx).Exec(ctx, query, userID, string(purpose), time.Now().UTC()); err != nil {
+		return errors.ErrorInvalidateOneTimeTokens(err)
+	}
+
+	return nil
+}
+
+func (o *OneTimeToken) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return o.DB
+}
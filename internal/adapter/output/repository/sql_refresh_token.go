@@ -0,0 +1,260 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type RefreshToken struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.RefreshToken
+}
+
+func NewRefreshTokenRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *RefreshToken {
+	return &RefreshToken{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (r *RefreshToken) CreateRefreshToken(ctx context.Context, token entity.RefreshToken) (*entity.RefreshToken, *errors.Error) {
+	start := time.Now()
+	ctx, span := r.tracer.Start(ctx, "RefreshTokenRepository.CreateRefreshToken")
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "refresh_tokens", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelToken := r.ToModel(token)
+
+	const query = `
+	INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, created_at)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING id`
+
+	var id int64
+
+	err := r.resolveDB(ctx).QueryRow(ctx, query,
+		modelToken.UserID,
+		modelToken.FamilyID,
+		modelToken.TokenHash,
+		modelToken.ExpiresAt,
+		modelToken.CreatedAt).Scan(&id)
+	if err != nil {
+		return nil, errors.CreateRefreshTokenError(err)
+	}
+
+	token.AssignID(id)
+	token.AssignCreatedAt(util.ToTime(modelToken.CreatedAt))
+	return &token, nil
+}
+
+// FindRefreshTokenByHash locks the row so concurrent rotations of the same
+// token serialize inside the caller's transaction.
+func (r *RefreshToken) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, *errors.Error) {
+	ctx, span := r.tracer.Start(ctx, "RefreshTokenRepository.FindRefreshTokenByHash")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "refresh_tokens", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, user_id, family_id, token_hash, expires_at, rotated_at, revoked_at, created_at
+	FROM refresh_tokens
+	WHERE token_hash = $1
+	FOR UPDATE`
+
+	var model model.RefreshToken
+
+	rows, err := r.resolveDB(ctx).Query(ctx, query, tokenHash)
+	if err != nil {
+		return nil, errors.ErrorFindRefreshTokenByHash(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, nil
+	}
+	err = rows.Scan(
+		&model.ID,
+		&model.UserID,
+		&model.FamilyID,
+		&model.TokenHash,
+		&model.ExpiresAt,
+		&model.RotatedAt,
+		&model.RevokedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return nil, errors.ErrorFindRefreshTokenByHash(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+func (r *RefreshToken) MarkRefreshTokenRotated(ctx context.Context, id int64) *errors.Error {
+	ctx, span := r.tracer.Start(ctx, "RefreshTokenRepository.MarkRefreshTokenRotated")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "refresh_tokens", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE refresh_tokens
+	SET rotated_at = $2
+	WHERE id = $1 AND rotated_at IS NULL`
+
+	if _, err := r.resolveDB(ctx).Exec(ctx, query, id, time.Now().UTC()); err != nil {
+		return errors.ErrorMarkRefreshTokenRotated(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshToken) RevokeRefreshTokenFamily(ctx context.Context, familyID string) *errors.Error {
+	ctx, span := r.tracer.Start(ctx, "RefreshTokenRepository.RevokeRefreshTokenFamily")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "refresh_tokens", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE refresh_tokens
+	SET revoked_at = $2
+	WHERE family_id = $1 AND revoked_at IS NULL`
+
+	if _, err := r.resolveDB(ctx).Exec(ctx, query, familyID, time.Now().UTC()); err != nil {
+		return errors.ErrorRevokeRefreshTokenFamily(err)
+	}
+
+	return nil
+}
+
+// RevokeUserRefreshTokens revokes every active token of the user, keeping the
+// family given in exceptFamilyID (if any) alive. The family is compared as a
+// uuid, like the session queries; NULLIF keeps the cast from failing on an
+// empty id.
+func (r *RefreshToken) RevokeUserRefreshTokens(ctx context.Context, publicID, exceptFamilyID string) *errors.Error {
+	ctx, span := r.tracer.Start(ctx, "RefreshTokenRepository.RevokeUserRefreshTokens")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "refresh_tokens", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE refresh_tokens
+	SET revoked_at = $3
+	WHERE user_id = (SELECT id FROM users WHERE public_id = $1)
+	  AND revoked_at IS NULL
+	  AND ($2::text = '' OR family_id <> NULLIF($2::text, '')::uuid)`
+
+	if _, err := r.resolveDB(ctx).Exec(ctx, query, publicID, exceptFamilyID, time.Now().UTC()); err != nil {
+		return errors.ErrorRevokeUserRefreshTokens(err)
+	}
+
+	return nil
+}
+
+func (r *RefreshToken) ListRefreshTokensByUserID(ctx context.Context, userID int64) ([]entity.RefreshToken, *errors.Error) {
+	ctx, span := r.tracer.Start(ctx, "RefreshTokenRepository.ListRefreshTokensByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "refresh_tokens", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, user_id, family_id, token_hash, expires_at, rotated_at, revoked_at, created_at
+	FROM refresh_tokens
+	WHERE user_id = $1
+	ORDER BY created_at, id`
+
+	rows, err := r.resolveDB(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorListRefreshTokens(err)
+	}
+	defer rows.Close()
+
+	tokens := make([]entity.RefreshToken, 0)
+	for rows.Next() {
+		var model model.RefreshToken
+		err = rows.Scan(
+			&model.ID,
+			&model.UserID,
+			&model.FamilyID,
+			&model.TokenHash,
+			&model.ExpiresAt,
+			&model.RotatedAt,
+			&model.RevokedAt,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.ErrorListRefreshTokens(err)
+		}
+		tokens = append(tokens, model.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.ErrorListRefreshTokens(err)
+	}
+
+	return tokens, nil
+}
+
+// FindFamilyStartedAt returns when the sign-in that started the family took
+// place, or nil when the family is unknown.
+func (r *RefreshToken) FindFamilyStartedAt(ctx context.Context, familyID string) (*time.Time, *errors.Error) {
+	ctx, span := r.tracer.Start(ctx, "RefreshTokenRepository.FindFamilyStartedAt")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "refresh_tokens", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT MIN(created_at)
+	FROM refresh_tokens
+	WHERE family_id = $1`
+
+	var startedAt *time.Time
+	if err := r.resolveDB(ctx).QueryRow(ctx, query, familyID).Scan(&startedAt); err != nil {
+		return nil, errors.ErrorFindRefreshTokenFamily(err)
+	}
+
+	return startedAt, nil
+}
+
+func (r *RefreshToken) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return r.DB
+}
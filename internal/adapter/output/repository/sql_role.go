@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type Role struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+}
+
+func NewRoleRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *Role {
+	return &Role{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (r *Role) FindPermissionsByRole(ctx context.Context, role entity.RoleTypes) ([]entity.Permission, *errors.Error) {
+	ctx, span := r.tracer.Start(ctx, "RoleRepository.FindPermissionsByRole")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		r.metrics.ObserveInstructionDBDuration("postgres", "role_permissions", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT p.name
+	FROM role_permissions rp
+	JOIN roles r ON r.id = rp.role_id
+	JOIN permissions p ON p.id = rp.permission_id
+	WHERE r.name = $1
+	ORDER BY p.name`
+
+	rows, err := r.resolveDB(ctx).Query(ctx, query, string(role))
+	if err != nil {
+		return nil, errors.ErrorFindRolePermissions(err)
+	}
+	defer rows.Close()
+
+	permissions := make([]entity.Permission, 0)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, errors.ErrorFindRolePermissions(err)
+		}
+		permissions = append(permissions, entity.Permission(name))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.ErrorFindRolePermissions(err)
+	}
+
+	return permissions, nil
+}
+
+func (r *Role) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return r.DB
+}
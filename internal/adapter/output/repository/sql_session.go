@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type Session struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.Session
+}
+
+func NewSessionRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *Session {
+	return &Session{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+const sessionColumns = `s.id, s.user_id, s.family_id, s.device_name, s.user_agent, s.ip_address, s.created_at, s.last_seen_at`
+
+func (s *Session) CreateSession(ctx context.Context, session entity.Session) (*entity.Session, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "SessionRepository.CreateSession")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		s.metrics.ObserveInstructionDBDuration("postgres", "sessions", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelSession := s.ToModel(session)
+
+	const query = `
+	INSERT INTO sessions (user_id, family_id, device_name, user_agent, ip_address, created_at, last_seen_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	RETURNING id`
+
+	var id int64
+
+	err := s.resolveDB(ctx).QueryRow(ctx, query,
+		modelSession.UserID,
+		modelSession.FamilyID,
+		modelSession.DeviceName,
+		modelSession.UserAgent,
+		modelSession.IPAddress,
+		modelSession.CreatedAt,
+		modelSession.LastSeenAt).Scan(&id)
+	if err != nil {
+		return nil, errors.ErrorCreateSession(err)
+	}
+
+	modelSession.ID = &id
+	created := modelSession.ToEntity()
+	return &created, nil
+}
+
+// FindSessionByFamilyID returns nil when no session started the family.
+func (s *Session) FindSessionByFamilyID(ctx context.Context, familyID string) (*entity.Session, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "SessionRepository.FindSessionByFamilyID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		s.metrics.ObserveInstructionDBDuration("postgres", "sessions", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	query := `
+	SELECT ` + sessionColumns + `
+	FROM sessions s
+	WHERE s.family_id = $1::uuid`
+
+	rows, err := s.resolveDB(ctx).Query(ctx, query, familyID)
+	if err != nil {
+		return nil, errors.ErrorFindSession(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorFindSession(err)
+		}
+		return nil, nil
+	}
+
+	var model model.Session
+	if err := scanSession(rows, &model); err != nil {
+		return nil, errors.ErrorFindSession(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+// ListActiveSessionsByUserID returns the sessions whose family still holds a
+// refresh token that was neither rotated, revoked nor left to expire, most
+// recently seen first.
+func (s *Session) ListActiveSessionsByUserID(ctx context.Context, userID int64) ([]entity.Session, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "SessionRepository.ListActiveSessionsByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		s.metrics.ObserveInstructionDBDuration("postgres", "sessions", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	query := `
+	SELECT ` + sessionColumns + `
+	FROM sessions s
+	WHERE s.user_id = $1
+	  AND EXISTS (
+		SELECT 1 FROM refresh_tokens rt
+		WHERE rt.family_id = s.family_id
+		  AND rt.rotated_at IS NULL
+		  AND rt.revoked_at IS NULL
+		  AND rt.expires_at > $2)
+	ORDER BY s.last_seen_at DESC, s.id DESC`
+
+	rows, err := s.resolveDB(ctx).Query(ctx, query, userID, time.Now().UTC())
+	if err != nil {
+		return nil, errors.ErrorFindSession(err)
+	}
+	defer rows.Close()
+
+	sessions := make([]entity.Session, 0)
+	for rows.Next() {
+		var model model.Session
+		if err := scanSession(rows, &model); err != nil {
+			return nil, errors.ErrorFindSession(err)
+		}
+		sessions = append(sessions, model.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.ErrorFindSession(err)
+	}
+
+	return sessions, nil
+}
+
+// ListSessionsByUserID returns every session of the user, ended ones
+// included, oldest first.
+func (s *Session) ListSessionsByUserID(ctx context.Context, userID int64) ([]entity.Session, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "SessionRepository.ListSessionsByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		s.metrics.ObserveInstructionDBDuration("postgres", "sessions", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	query := `
+	SELECT ` + sessionColumns + `
+	FROM sessions s
+	WHERE s.user_id = $1
+	ORDER BY s.created_at, s.id`
+
+	rows, err := s.resolveDB(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorFindSession(err)
+	}
+	defer rows.Close()
+
+	sessions := make([]entity.Session, 0)
+	for rows.Next() {
+		var model model.Session
+		if err := scanSession(rows, &model); err != nil {
+			return nil, errors.ErrorFindSession(err)
+		}
+		sessions = append(sessions, model.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.ErrorFindSession(err)
+	}
+
+	return sessions, nil
+}
+
+// TouchSession records activity of the session. An empty ipAddress keeps the
+// last known address.
+func (s *Session) TouchSession(ctx context.Context, familyID, ipAddress string, seenAt time.Time) *errors.Error {
+	ctx, span := s.tracer.Start(ctx, "SessionRepository.TouchSession")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		s.metrics.ObserveInstructionDBDuration("postgres", "sessions", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE sessions
+	SET last_seen_at = GREATEST(last_seen_at, $3),
+	    ip_address = COALESCE(NULLIF($2, ''), ip_address)
+	WHERE family_id = $1::uuid`
+
+	if _, err := s.resolveDB(ctx).Exec(ctx, query, familyID, ipAddress, seenAt.UTC()); err != nil {
+		return errors.ErrorTouchSession(err)
+	}
+
+	return nil
+}
+
+func scanSession(rows interface{ Scan(dest ...any) error }, model *model.Session) error {
+	return rows.Scan(
+		&model.ID,
+		&model.UserID,
+		&model.FamilyID,
+		&model.DeviceName,
+		&model.UserAgent,
+		&model.IPAddress,
+		&model.CreatedAt,
+		&model.LastSeenAt,
+	)
+}
+
+func (s *Session) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return s.DB
+}
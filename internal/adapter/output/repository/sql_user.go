@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgconn"
@@ -10,6 +12,7 @@ import (
 	"github.com/andreis3/auth-ms/internal/domain/entity"
 	"github.com/andreis3/auth-ms/internal/domain/errors"
 	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
 	"github.com/andreis3/auth-ms/internal/infra/db"
 	"github.com/andreis3/auth-ms/internal/util"
 )
@@ -42,8 +45,8 @@ func (u *User) CreateUser(ctx context.Context, user entity.User) (*entity.User,
 	modelUser := u.ToModel(user)
 
 	const query = `
-	INSERT INTO users (public_id, email, password_hash, name, role, created_at, updated_at) 
-	VALUES ($1, $2, $3, $4, $5, $6, $7) 
+	INSERT INTO users (public_id, email, password_hash, name, role, avatar_url, email_verified_at, created_at, updated_at) 
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) 
 	RETURNING id`
 
 	var id int64
@@ -56,6 +59,8 @@ func (u *User) CreateUser(ctx context.Context, user entity.User) (*entity.User,
 		modelUser.Password,
 		modelUser.Name,
 		modelUser.Role,
+		modelUser.AvatarURL,
+		modelUser.EmailVerifiedAt,
 		modelUser.CreatedAt,
 		modelUser.UpdatedAt).Scan(&id)
 
@@ -74,55 +79,512 @@ func (u *User) CreateUser(ctx context.Context, user entity.User) (*entity.User,
 }
 
 func (u *User) FindUserByEmail(ctx context.Context, email string) (*entity.User, *errors.Error) {
-	ctx, span := u.tracer.Start(ctx, "CustomerRepository.FindCustomerByEmail")
+	ctx, span := u.tracer.Start(ctx, "UserRepository.FindUserByEmail")
 	start := time.Now()
 
 	defer func() {
 		end := time.Since(start)
-		u.metrics.ObserveInstructionDBDuration("postgres", "customers", "select", float64(end.Milliseconds()))
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "select", float64(end.Milliseconds()))
 		span.End()
 	}()
 
 	const query = `
-	SELECT id, public_id, email, password_hash, name, role, created_at, updated_at, deleted_at
+	SELECT id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at
 	FROM users
-	WHERE email = $1`
+	WHERE email = $1 AND deleted_at IS NULL`
 
+	user, err := u.findOne(ctx, query, email)
+	if err != nil {
+		return nil, errors.ErrorFindUserByEmail(err)
+	}
+
+	return user, nil
+}
+
+func (u *User) FindUserByID(ctx context.Context, id int64) (*entity.User, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.FindUserByID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at
+	FROM users
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	user, err := u.findOne(ctx, query, id)
+	if err != nil {
+		return nil, errors.ErrorFindUserByID(err)
+	}
+
+	return user, nil
+}
+
+func (u *User) FindUserByPublicID(ctx context.Context, publicID string) (*entity.User, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.FindUserByPublicID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at
+	FROM users
+	WHERE public_id = $1 AND deleted_at IS NULL`
+
+	user, err := u.findOne(ctx, query, publicID)
+	if err != nil {
+		return nil, errors.ErrorFindUserByPublicID(err)
+	}
+
+	return user, nil
+}
+
+// FindDeletedUserByEmail returns a soft deleted user that was not purged yet.
+func (u *User) FindDeletedUserByEmail(ctx context.Context, email string) (*entity.User, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.FindDeletedUserByEmail")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at
+	FROM users
+	WHERE email = $1 AND deleted_at IS NOT NULL AND purged_at IS NULL`
+
+	user, err := u.findOne(ctx, query, email)
+	if err != nil {
+		return nil, errors.ErrorFindDeletedUserByEmail(err)
+	}
+
+	return user, nil
+}
+
+// UpdateUser persists the profile fields of the user and refreshes updated_at.
+// It returns nil when the user no longer exists.
+func (u *User) UpdateUser(ctx context.Context, user entity.User) (*entity.User, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.UpdateUser")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelUser := u.ToModel(user)
+
+	const query = `
+	UPDATE users
+	SET name = $2, avatar_url = $3, updated_at = $4
+	WHERE public_id = $1 AND deleted_at IS NULL
+	RETURNING id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at`
+
+	updated, err := u.findOne(ctx, query,
+		modelUser.PublicID,
+		modelUser.Name,
+		modelUser.AvatarURL,
+		modelUser.UpdatedAt)
+	if err != nil {
+		return nil, errors.ErrorUpdateUser(err)
+	}
+
+	return updated, nil
+}
+
+func (u *User) UpdateUserPassword(ctx context.Context, id int64, passwordHash string, updatedAt time.Time) (bool, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.UpdateUserPassword")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE users
+	SET password_hash = $2, updated_at = $3
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	db := u.resolveDB(ctx)
+	tag, err := db.Exec(ctx, query, id, passwordHash, updatedAt)
+	if err != nil {
+		return false, errors.ErrorUpdateUserPassword(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// MarkEmailVerified records that the user proved to own email. It reports false
+// when the user no longer exists or its e-mail changed since the link was sent.
+func (u *User) MarkEmailVerified(ctx context.Context, id int64, email string, verifiedAt time.Time) (bool, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.MarkEmailVerified")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE users
+	SET email_verified_at = COALESCE(email_verified_at, $3), updated_at = $3
+	WHERE id = $1 AND email = $2 AND deleted_at IS NULL`
+
+	db := u.resolveDB(ctx)
+	tag, err := db.Exec(ctx, query, id, email, verifiedAt)
+	if err != nil {
+		return false, errors.ErrorMarkEmailVerified(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// UpdateUserPhone stores a phone the user proved to own. It reports false when
+// the user no longer exists.
+func (u *User) UpdateUserPhone(ctx context.Context, id int64, phone string, verifiedAt time.Time) (bool, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.UpdateUserPhone")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE users
+	SET phone = $2, phone_verified_at = $3, updated_at = $3
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	db := u.resolveDB(ctx)
+	tag, err := db.Exec(ctx, query, id, phone, verifiedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return false, errors.ErrorPhoneAlreadyInUse(err)
+		}
+		return false, errors.ErrorUpdateUserPhone(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// FindUserByVerifiedPhone returns the active user owning the verified phone.
+func (u *User) FindUserByVerifiedPhone(ctx context.Context, phone string) (*entity.User, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.FindUserByVerifiedPhone")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at
+	FROM users
+	WHERE phone = $1 AND phone_verified_at IS NOT NULL AND deleted_at IS NULL`
+
+	user, err := u.findOne(ctx, query, phone)
+	if err != nil {
+		return nil, errors.ErrorFindUserByVerifiedPhone(err)
+	}
+
+	return user, nil
+}
+
+// SoftDeleteUser marks the user as deleted. It reports false when the user
+// does not exist or is already deleted.
+func (u *User) SoftDeleteUser(ctx context.Context, id int64, deletedAt time.Time) (bool, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.SoftDeleteUser")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE users
+	SET deleted_at = $2, updated_at = $2
+	WHERE id = $1 AND deleted_at IS NULL`
+
+	db := u.resolveDB(ctx)
+	tag, err := db.Exec(ctx, query, id, deletedAt)
+	if err != nil {
+		return false, errors.ErrorSoftDeleteUser(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RestoreUser clears deleted_at of a user that was not purged yet. It returns
+// nil when there is nothing to restore.
+func (u *User) RestoreUser(ctx context.Context, id int64) (*entity.User, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.RestoreUser")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE users
+	SET deleted_at = NULL, updated_at = $2
+	WHERE id = $1 AND deleted_at IS NOT NULL AND purged_at IS NULL
+	RETURNING id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at`
+
+	restored, err := u.findOne(ctx, query, id, time.Now().UTC())
+	if err != nil {
+		return nil, errors.ErrorRestoreUser(err)
+	}
+
+	return restored, nil
+}
+
+// PurgeDeletedUsers irreversibly anonymizes up to limit users deleted before
+// deletedBefore and drops everything else held about them: addresses, linked
+// identities, OAuth consents and codes, sessions and their tokens, MFA and
+// passkey enrollments and data exports. The row itself is kept so foreign keys
+// and audit references stay valid, which is why ON DELETE CASCADE does not
+// clean these up.
+func (u *User) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time, limit int) (int64, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.PurgeDeletedUsers")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	WITH purged AS (
+		UPDATE users
+		SET email = 'purged+' || public_id || '@invalid',
+			name = '',
+			password_hash = '',
+			avatar_url = NULL,
+			phone = NULL,
+			phone_verified_at = NULL,
+			purged_at = $2,
+			updated_at = $2
+		WHERE id IN (
+			SELECT id FROM users
+			WHERE deleted_at < $1 AND purged_at IS NULL
+			ORDER BY deleted_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED)
+		RETURNING id
+	), addresses AS (
+		DELETE FROM user_addresses WHERE user_id IN (SELECT id FROM purged)
+	), identities AS (
+		DELETE FROM user_identities WHERE user_id IN (SELECT id FROM purged)
+	), consents AS (
+		DELETE FROM oauth_consents WHERE user_id IN (SELECT id FROM purged)
+	), authorization_codes AS (
+		DELETE FROM oauth_authorization_codes WHERE user_id IN (SELECT id FROM purged)
+	), sessions AS (
+		DELETE FROM sessions WHERE user_id IN (SELECT id FROM purged)
+	), refresh_tokens AS (
+		DELETE FROM refresh_tokens WHERE user_id IN (SELECT id FROM purged)
+	), one_time_tokens AS (
+		DELETE FROM one_time_tokens WHERE user_id IN (SELECT id FROM purged)
+	), mfa AS (
+		DELETE FROM user_mfa WHERE user_id IN (SELECT id FROM purged)
+	), recovery_codes AS (
+		DELETE FROM mfa_recovery_codes WHERE user_id IN (SELECT id FROM purged)
+	), passkeys AS (
+		DELETE FROM webauthn_credentials WHERE user_id IN (SELECT id FROM purged)
+	), exports AS (
+		DELETE FROM data_exports WHERE user_id IN (SELECT id FROM purged)
+	)
+	SELECT count(*) FROM purged`
+
+	var purged int64
+	db := u.resolveDB(ctx)
+	if err := db.QueryRow(ctx, query, deletedBefore, time.Now().UTC(), limit).Scan(&purged); err != nil {
+		return 0, errors.ErrorPurgeDeletedUsers(err)
+	}
+
+	return purged, nil
+}
+
+// SearchUsers returns up to search.Limit+1 users in scan order (see
+// UserSearch.Ascending) so the caller can tell whether another page exists.
+// Pagination is keyset based on (sort column, id).
+func (u *User) SearchUsers(ctx context.Context, search vo.UserSearch) ([]entity.User, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserRepository.SearchUsers")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "users", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	var (
+		conditions []string
+		args       []any
+	)
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if search.Role != "" {
+		conditions = append(conditions, "role = "+arg(search.Role))
+	}
+	if search.CreatedFrom != nil {
+		conditions = append(conditions, "created_at >= "+arg(*search.CreatedFrom))
+	}
+	if search.CreatedTo != nil {
+		conditions = append(conditions, "created_at < "+arg(*search.CreatedTo))
+	}
+	if search.Deleted != nil {
+		if *search.Deleted {
+			conditions = append(conditions, "deleted_at IS NOT NULL")
+		} else {
+			conditions = append(conditions, "deleted_at IS NULL")
+		}
+	}
+	if search.EmailPrefix != "" {
+		conditions = append(conditions,
+			`lower(email) LIKE `+arg(escapeLike(strings.ToLower(search.EmailPrefix))+"%")+` ESCAPE '\'`)
+	}
+	if search.Name != "" {
+		// both predicates are served by the gin_trgm_ops index on name
+		conditions = append(conditions, fmt.Sprintf(`(name ILIKE %s ESCAPE '\' OR name %% %s)`,
+			arg("%"+escapeLike(search.Name)+"%"), arg(search.Name)))
+	}
+
+	column, cast := "created_at", "timestamp"
+	if search.SortField == vo.UserSortEmail {
+		column, cast = "email", "text"
+	}
+	operator, direction := ">", "ASC"
+	if !search.Ascending() {
+		operator, direction = "<", "DESC"
+	}
+	if search.Cursor != nil {
+		conditions = append(conditions, fmt.Sprintf("(%s, id) %s (%s::%s, %s)",
+			column, operator, arg(search.Cursor.Value), cast, arg(search.Cursor.ID)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	query := fmt.Sprintf(`
+	SELECT id, public_id, email, email_verified_at, password_hash, name, role, avatar_url, phone, phone_verified_at, created_at, updated_at, deleted_at
+	FROM users
+	%s
+	ORDER BY %s %s, id %s
+	LIMIT %s`, where, column, direction, direction, arg(search.Limit+1))
+
+	users, err := u.list(ctx, query, args...)
+	if err != nil {
+		return nil, errors.ErrorSearchUsers(err)
+	}
+
+	return users, nil
+}
+
+// findOne runs a single-row user query and returns nil when nothing matches.
+func (u *User) findOne(ctx context.Context, query string, args ...any) (*entity.User, error) {
 	var model model.User
 	db := u.resolveDB(ctx)
 
-	rows, err := db.Query(ctx, query, email)
+	rows, err := db.Query(ctx, query, args...)
 	if err != nil {
-		return nil, errors.ErrorFindUserByEmail(err)
+		return nil, err
 	}
 	defer rows.Close()
 
 	if !rows.Next() {
-		return nil, nil
+		return nil, rows.Err()
 	}
 	err = rows.Scan(
 		&model.ID,
 		&model.PublicID,
 		&model.Email,
+		&model.EmailVerifiedAt,
 		&model.Password,
 		&model.Name,
 		&model.Role,
+		&model.AvatarURL,
+		&model.Phone,
+		&model.PhoneVerifiedAt,
 		&model.CreatedAt,
 		&model.UpdatedAt,
 		&model.DeletedAt,
 	)
 	if err != nil {
-		return nil, errors.ErrorFindUserByEmail(err)
+		return nil, err
 	}
 
 	result := model.ToEntity()
+	return &result, nil
+}
 
-	// TODO: create SetAttributes in interface otel
-	//span.SetAttributes(
-	//	attribute.Int64("customer_id", *modelCustomer.CustomerID),
-	//)
+func (u *User) list(ctx context.Context, query string, args ...any) ([]entity.User, error) {
+	db := u.resolveDB(ctx)
 
-	return &result, nil
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]entity.User, 0)
+	for rows.Next() {
+		var model model.User
+		err = rows.Scan(
+			&model.ID,
+			&model.PublicID,
+			&model.Email,
+			&model.EmailVerifiedAt,
+			&model.Password,
+			&model.Name,
+			&model.Role,
+			&model.AvatarURL,
+			&model.Phone,
+			&model.PhoneVerifiedAt,
+			&model.Phone,
+			&model.PhoneVerifiedAt,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+			&model.DeletedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, model.ToEntity())
+	}
+
+	return users, rows.Err()
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
 }
 
 func (u *User) resolveDB(ctx context.Context) adapter.Postgres {
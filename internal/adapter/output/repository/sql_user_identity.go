@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/util"
+)
+
+type UserIdentity struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.UserIdentity
+}
+
+func NewUserIdentityRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *UserIdentity {
+	return &UserIdentity{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+func (u *UserIdentity) CreateIdentity(ctx context.Context, identity entity.UserIdentity) (*entity.UserIdentity, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserIdentityRepository.CreateIdentity")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_identities", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelIdentity := u.ToModel(identity)
+
+	const query = `
+	INSERT INTO user_identities (user_id, provider, subject, email, created_at, last_login_at)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING id`
+
+	var id int64
+
+	err := u.resolveDB(ctx).QueryRow(ctx, query,
+		modelIdentity.UserID,
+		modelIdentity.Provider,
+		modelIdentity.Subject,
+		modelIdentity.Email,
+		modelIdentity.CreatedAt,
+		modelIdentity.LastLoginAt).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, errors.ErrorIdentityAlreadyLinked(err)
+		}
+		return nil, errors.ErrorCreateIdentity(err)
+	}
+
+	created := entity.BuilderUserIdentity().
+		WithID(id).
+		WithUserID(identity.UserID()).
+		WithProvider(identity.Provider()).
+		WithSubject(identity.Subject()).
+		WithEmail(identity.Email()).
+		WithCreatedAt(util.ToTime(modelIdentity.CreatedAt)).
+		WithLastLoginAt(util.ToTime(modelIdentity.LastLoginAt)).
+		Build()
+	return &created, nil
+}
+
+// FindIdentity returns the identity of subject at provider, or nil when it is
+// not linked to any user.
+func (u *UserIdentity) FindIdentity(ctx context.Context, provider, subject string) (*entity.UserIdentity, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserIdentityRepository.FindIdentity")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_identities", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, user_id, provider, subject, email, created_at, last_login_at
+	FROM user_identities
+	WHERE provider = $1 AND subject = $2`
+
+	rows, err := u.resolveDB(ctx).Query(ctx, query, provider, subject)
+	if err != nil {
+		return nil, errors.ErrorFindIdentity(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorFindIdentity(err)
+		}
+		return nil, nil
+	}
+
+	var model model.UserIdentity
+	err = rows.Scan(
+		&model.ID,
+		&model.UserID,
+		&model.Provider,
+		&model.Subject,
+		&model.Email,
+		&model.CreatedAt,
+		&model.LastLoginAt,
+	)
+	if err != nil {
+		return nil, errors.ErrorFindIdentity(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+func (u *UserIdentity) ListIdentitiesByUserID(ctx context.Context, userID int64) ([]entity.UserIdentity, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserIdentityRepository.ListIdentitiesByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_identities", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT id, user_id, provider, subject, email, created_at, last_login_at
+	FROM user_identities
+	WHERE user_id = $1
+	ORDER BY created_at, id`
+
+	rows, err := u.resolveDB(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorFindIdentity(err)
+	}
+	defer rows.Close()
+
+	identities := make([]entity.UserIdentity, 0)
+	for rows.Next() {
+		var model model.UserIdentity
+		err := rows.Scan(
+			&model.ID,
+			&model.UserID,
+			&model.Provider,
+			&model.Subject,
+			&model.Email,
+			&model.CreatedAt,
+			&model.LastLoginAt,
+		)
+		if err != nil {
+			return nil, errors.ErrorFindIdentity(err)
+		}
+		identities = append(identities, model.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.ErrorFindIdentity(err)
+	}
+
+	return identities, nil
+}
+
+// RecordIdentityLogin stamps the last sign-in through the identity and keeps
+// the e-mail reported by the provider current.
+func (u *UserIdentity) RecordIdentityLogin(ctx context.Context, id int64, email string, at time.Time) *errors.Error {
+	ctx, span := u.tracer.Start(ctx, "UserIdentityRepository.RecordIdentityLogin")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_identities", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE user_identities
+	SET last_login_at = $2, email = COALESCE(NULLIF($3, ''), email)
+	WHERE id = $1`
+
+	if _, err := u.resolveDB(ctx).Exec(ctx, query, id, at, email); err != nil {
+		return errors.ErrorRecordIdentityLogin(err)
+	}
+
+	return nil
+}
+
+func (u *UserIdentity) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return u.DB
+}
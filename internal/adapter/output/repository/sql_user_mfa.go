@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type UserMFA struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.UserMFA
+}
+
+func NewUserMFARepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *UserMFA {
+	return &UserMFA{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+// SaveUserMFA stores a new enrollment, replacing one that was never
+// confirmed. A confirmed enrollment is left untouched.
+func (u *UserMFA) SaveUserMFA(ctx context.Context, mfa entity.UserMFA) *errors.Error {
+	ctx, span := u.tracer.Start(ctx, "UserMFARepository.SaveUserMFA")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_mfa", "upsert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelMFA := u.ToModel(mfa)
+
+	const query = `
+	INSERT INTO user_mfa (user_id, totp_secret, last_used_step, created_at, updated_at)
+	VALUES ($1, $2, 0, $3, $4)
+	ON CONFLICT (user_id) DO UPDATE
+	SET totp_secret = EXCLUDED.totp_secret,
+		last_used_step = 0,
+		created_at = EXCLUDED.created_at,
+		updated_at = EXCLUDED.updated_at
+	WHERE user_mfa.confirmed_at IS NULL`
+
+	_, err := u.resolveDB(ctx).Exec(ctx, query,
+		modelMFA.UserID,
+		modelMFA.TOTPSecret,
+		modelMFA.CreatedAt,
+		modelMFA.UpdatedAt)
+	if err != nil {
+		return errors.ErrorSaveUserMFA(err)
+	}
+
+	return nil
+}
+
+// FindUserMFA returns nil when the user never enrolled.
+func (u *UserMFA) FindUserMFA(ctx context.Context, userID int64) (*entity.UserMFA, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserMFARepository.FindUserMFA")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_mfa", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT user_id, totp_secret, confirmed_at, last_used_step, created_at, updated_at
+	FROM user_mfa
+	WHERE user_id = $1`
+
+	rows, err := u.resolveDB(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorFindUserMFA(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorFindUserMFA(err)
+		}
+		return nil, nil
+	}
+
+	var model model.UserMFA
+	err = rows.Scan(
+		&model.UserID,
+		&model.TOTPSecret,
+		&model.ConfirmedAt,
+		&model.LastUsedStep,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return nil, errors.ErrorFindUserMFA(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+// ConfirmUserMFA activates a pending enrollment with the step of its first
+// valid code. It reports false when there is nothing pending to confirm.
+func (u *UserMFA) ConfirmUserMFA(ctx context.Context, userID, step int64, confirmedAt time.Time) (bool, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserMFARepository.ConfirmUserMFA")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_mfa", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE user_mfa
+	SET confirmed_at = $3, last_used_step = $2, updated_at = $3
+	WHERE user_id = $1 AND confirmed_at IS NULL`
+
+	tag, err := u.resolveDB(ctx).Exec(ctx, query, userID, step, confirmedAt)
+	if err != nil {
+		return false, errors.ErrorUpdateUserMFA(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// RecordTOTPStep accepts the step of a valid code only when it is later than
+// the last accepted one, which makes every code single use even under
+// concurrent sign-ins.
+func (u *UserMFA) RecordTOTPStep(ctx context.Context, userID, step int64) (bool, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserMFARepository.RecordTOTPStep")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_mfa", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE user_mfa
+	SET last_used_step = $2, updated_at = $3
+	WHERE user_id = $1 AND confirmed_at IS NOT NULL AND last_used_step < $2`
+
+	tag, err := u.resolveDB(ctx).Exec(ctx, query, userID, step, time.Now().UTC())
+	if err != nil {
+		return false, errors.ErrorUpdateUserMFA(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (u *UserMFA) DeleteUserMFA(ctx context.Context, userID int64) *errors.Error {
+	ctx, span := u.tracer.Start(ctx, "UserMFARepository.DeleteUserMFA")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_mfa", "delete", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `DELETE FROM user_mfa WHERE user_id = $1`
+
+	if _, err := u.resolveDB(ctx).Exec(ctx, query, userID); err != nil {
+		return errors.ErrorDeleteUserMFA(err)
+	}
+
+	return nil
+}
+
+func (u *UserMFA) HasConfirmedMFA(ctx context.Context, publicID string) (bool, *errors.Error) {
+	ctx, span := u.tracer.Start(ctx, "UserMFARepository.HasConfirmedMFA")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		u.metrics.ObserveInstructionDBDuration("postgres", "user_mfa", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	SELECT EXISTS (
+		SELECT 1
+		FROM user_mfa m
+		JOIN users u ON u.id = m.user_id
+		WHERE u.public_id = $1 AND m.confirmed_at IS NOT NULL
+	)`
+
+	var exists bool
+	if err := u.resolveDB(ctx).QueryRow(ctx, query, publicID).Scan(&exists); err != nil {
+		return false, errors.ErrorFindUserMFA(err)
+	}
+
+	return exists, nil
+}
+
+func (u *UserMFA) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return u.DB
+}
@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/model"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type WebAuthnCredential struct {
+	DB      adapter.Postgres
+	metrics adapter.Prometheus
+	tracer  adapter.Tracer
+	model.WebAuthnCredential
+}
+
+func NewWebAuthnCredentialRepository(db adapter.Postgres, metrics adapter.Prometheus, tracer adapter.Tracer) *WebAuthnCredential {
+	return &WebAuthnCredential{
+		DB:      db,
+		metrics: metrics,
+		tracer:  tracer,
+	}
+}
+
+const webAuthnCredentialColumns = `id, user_id, credential_id, public_key, algorithm, sign_count, transports, aaguid,
+	backup_eligible, backed_up, name, created_at, last_used_at`
+
+func (w *WebAuthnCredential) CreateCredential(ctx context.Context, credential entity.WebAuthnCredential) (*entity.WebAuthnCredential, *errors.Error) {
+	ctx, span := w.tracer.Start(ctx, "WebAuthnCredentialRepository.CreateCredential")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		w.metrics.ObserveInstructionDBDuration("postgres", "webauthn_credentials", "insert", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	modelCredential := w.ToModel(credential)
+
+	const query = `
+	INSERT INTO webauthn_credentials (user_id, credential_id, public_key, algorithm, sign_count, transports, aaguid,
+		backup_eligible, backed_up, name, created_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	RETURNING id`
+
+	var id int64
+
+	err := w.resolveDB(ctx).QueryRow(ctx, query,
+		modelCredential.UserID,
+		modelCredential.CredentialID,
+		modelCredential.PublicKey,
+		modelCredential.Algorithm,
+		modelCredential.SignCount,
+		modelCredential.Transports,
+		modelCredential.AAGUID,
+		modelCredential.BackupEligible,
+		modelCredential.BackedUp,
+		modelCredential.Name,
+		modelCredential.CreatedAt).Scan(&id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, errors.ErrorPasskeyAlreadyRegistered(err)
+		}
+		return nil, errors.ErrorCreatePasskey(err)
+	}
+
+	modelCredential.ID = &id
+	created := modelCredential.ToEntity()
+	return &created, nil
+}
+
+// FindCredentialByCredentialID returns nil when no user registered the credential.
+func (w *WebAuthnCredential) FindCredentialByCredentialID(ctx context.Context, credentialID string) (*entity.WebAuthnCredential, *errors.Error) {
+	ctx, span := w.tracer.Start(ctx, "WebAuthnCredentialRepository.FindCredentialByCredentialID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		w.metrics.ObserveInstructionDBDuration("postgres", "webauthn_credentials", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	query := `
+	SELECT ` + webAuthnCredentialColumns + `
+	FROM webauthn_credentials
+	WHERE credential_id = $1`
+
+	rows, err := w.resolveDB(ctx).Query(ctx, query, credentialID)
+	if err != nil {
+		return nil, errors.ErrorFindPasskey(err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, errors.ErrorFindPasskey(err)
+		}
+		return nil, nil
+	}
+
+	var model model.WebAuthnCredential
+	if err := scanWebAuthnCredential(rows, &model); err != nil {
+		return nil, errors.ErrorFindPasskey(err)
+	}
+
+	result := model.ToEntity()
+	return &result, nil
+}
+
+func (w *WebAuthnCredential) ListCredentialsByUserID(ctx context.Context, userID int64) ([]entity.WebAuthnCredential, *errors.Error) {
+	ctx, span := w.tracer.Start(ctx, "WebAuthnCredentialRepository.ListCredentialsByUserID")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		w.metrics.ObserveInstructionDBDuration("postgres", "webauthn_credentials", "select", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	query := `
+	SELECT ` + webAuthnCredentialColumns + `
+	FROM webauthn_credentials
+	WHERE user_id = $1
+	ORDER BY created_at, id`
+
+	rows, err := w.resolveDB(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, errors.ErrorFindPasskey(err)
+	}
+	defer rows.Close()
+
+	credentials := make([]entity.WebAuthnCredential, 0)
+	for rows.Next() {
+		var model model.WebAuthnCredential
+		if err := scanWebAuthnCredential(rows, &model); err != nil {
+			return nil, errors.ErrorFindPasskey(err)
+		}
+		credentials = append(credentials, model.ToEntity())
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.ErrorFindPasskey(err)
+	}
+
+	return credentials, nil
+}
+
+// RecordCredentialUse stores the counter reported on a sign-in. It reports
+// false when the counter did not move past the stored one, which happens
+// when two sign-ins of a cloned authenticator race each other.
+func (w *WebAuthnCredential) RecordCredentialUse(ctx context.Context, id int64, signCount uint32, backedUp bool, usedAt time.Time) (bool, *errors.Error) {
+	ctx, span := w.tracer.Start(ctx, "WebAuthnCredentialRepository.RecordCredentialUse")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		w.metrics.ObserveInstructionDBDuration("postgres", "webauthn_credentials", "update", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	UPDATE webauthn_credentials
+	SET sign_count = $2, backed_up = $3, last_used_at = $4
+	WHERE id = $1 AND (sign_count < $2 OR (sign_count = 0 AND $2 = 0))`
+
+	tag, err := w.resolveDB(ctx).Exec(ctx, query, id, int64(signCount), backedUp, usedAt)
+	if err != nil {
+		return false, errors.ErrorUpdatePasskey(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (w *WebAuthnCredential) DeleteCredential(ctx context.Context, userID int64, credentialID string) (bool, *errors.Error) {
+	ctx, span := w.tracer.Start(ctx, "WebAuthnCredentialRepository.DeleteCredential")
+	start := time.Now()
+
+	defer func() {
+		end := time.Since(start)
+		w.metrics.ObserveInstructionDBDuration("postgres", "webauthn_credentials", "delete", float64(end.Milliseconds()))
+		span.End()
+	}()
+
+	const query = `
+	DELETE FROM webauthn_credentials
+	WHERE user_id = $1 AND credential_id = $2`
+
+	tag, err := w.resolveDB(ctx).Exec(ctx, query, userID, credentialID)
+	if err != nil {
+		return false, errors.ErrorDeletePasskey(err)
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func scanWebAuthnCredential(rows interface{ Scan(dest ...any) error }, model *model.WebAuthnCredential) error {
+	return rows.Scan(
+		&model.ID,
+		&model.UserID,
+		&model.CredentialID,
+		&model.PublicKey,
+		&model.Algorithm,
+		&model.SignCount,
+		&model.Transports,
+		&model.AAGUID,
+		&model.BackupEligible,
+		&model.BackedUp,
+		&model.Name,
+		&model.CreatedAt,
+		&model.LastUsedAt,
+	)
+}
+
+func (w *WebAuthnCredential) resolveDB(ctx context.Context) adapter.Postgres {
+	if tx, ok := db.TxFromContext(ctx); ok {
+		return tx
+	}
+	return w.DB
+}
@@ -0,0 +1,129 @@
+package security
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// cborMaxDepth bounds the nesting accepted from authenticators, which never
+// send more than a few levels.
+const cborMaxDepth = 8
+
+var errCBORMalformed = errors.New("malformed CBOR")
+
+// decodeCBOR decodes the first item of data and returns what follows it. It
+// covers the subset of RFC 8949 WebAuthn relies on: integers, byte and text
+// strings, arrays, maps and the simple values, all with definite lengths.
+// Integers decode to int64 and maps to map[any]any.
+func decodeCBOR(data []byte) (any, []byte, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (any, []byte, error) {
+	if depth > cborMaxDepth || len(data) == 0 {
+		return nil, nil, errCBORMalformed
+	}
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+
+	if major == 7 {
+		switch info {
+		case 20:
+			return false, data[1:], nil
+		case 21:
+			return true, data[1:], nil
+		case 22, 23:
+			return nil, data[1:], nil
+		default:
+			return nil, nil, errCBORMalformed
+		}
+	}
+
+	arg, rest, err := cborArgument(info, data[1:])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, nil, errCBORMalformed
+		}
+		return int64(arg), rest, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, nil, errCBORMalformed
+		}
+		return -1 - int64(arg), rest, nil
+	case 2, 3:
+		if arg > uint64(len(rest)) {
+			return nil, nil, errCBORMalformed
+		}
+		value := rest[:arg]
+		if major == 3 {
+			return string(value), rest[arg:], nil
+		}
+		return append([]byte(nil), value...), rest[arg:], nil
+	case 4:
+		// Every item takes at least one byte, which bounds the allocation.
+		if arg > uint64(len(rest)) {
+			return nil, nil, errCBORMalformed
+		}
+		items := make([]any, 0, arg)
+		for range arg {
+			var item any
+			item, rest, err = decodeCBORItem(rest, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+		}
+		return items, rest, nil
+	case 5:
+		if arg > uint64(len(rest)) {
+			return nil, nil, errCBORMalformed
+		}
+		items := make(map[any]any, arg)
+		for range arg {
+			var key, value any
+			key, rest, err = decodeCBORItem(rest, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, nil, errCBORMalformed
+			}
+			value, rest, err = decodeCBORItem(rest, depth+1)
+			if err != nil {
+				return nil, nil, err
+			}
+			items[key] = value
+		}
+		return items, rest, nil
+	default:
+		// Tags are not used by WebAuthn.
+		return nil, nil, errCBORMalformed
+	}
+}
+
+// cborArgument reads the argument of an item head: the count, length or
+// value that info either holds or announces in the bytes that follow.
+func cborArgument(info byte, data []byte) (uint64, []byte, error) {
+	switch {
+	case info < 24:
+		return uint64(info), data, nil
+	case info == 24 && len(data) >= 1:
+		return uint64(data[0]), data[1:], nil
+	case info == 25 && len(data) >= 2:
+		return uint64(binary.BigEndian.Uint16(data)), data[2:], nil
+	case info == 26 && len(data) >= 4:
+		return uint64(binary.BigEndian.Uint32(data)), data[4:], nil
+	case info == 27 && len(data) >= 8:
+		return binary.BigEndian.Uint64(data), data[8:], nil
+	default:
+		return 0, nil, errCBORMalformed
+	}
+}
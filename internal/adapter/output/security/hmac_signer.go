@@ -0,0 +1,33 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"time"
+)
+
+// HMACSigner signs "<subject>.<unix expiry>" with HMAC-SHA256.
+type HMACSigner struct {
+	secret []byte
+}
+
+func NewHMACSigner(secret []byte) *HMACSigner {
+	return &HMACSigner{secret: secret}
+}
+
+func (s *HMACSigner) Sign(subject string, expiresAt time.Time) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(subject + "." + strconv.FormatInt(expiresAt.Unix(), 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature and that expiresAt has not passed.
+func (s *HMACSigner) Verify(subject string, expiresAt time.Time, signature string) bool {
+	if !time.Now().Before(expiresAt) {
+		return false
+	}
+	expected := s.Sign(subject, expiresAt)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
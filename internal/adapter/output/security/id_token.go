@@ -0,0 +1,69 @@
+package security
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type idTokenClaims struct {
+	Nonce         string           `json:"nonce,omitempty"`
+	AuthTime      *jwt.NumericDate `json:"auth_time,omitempty"`
+	Email         string           `json:"email,omitempty"`
+	EmailVerified *bool            `json:"email_verified,omitempty"`
+	Name          string           `json:"name,omitempty"`
+	Picture       string           `json:"picture,omitempty"`
+	jwt.RegisteredClaims
+}
+
+type IDTokenSigner struct {
+	keyring *Keyring
+	issuer  string
+	expiry  time.Duration
+}
+
+func NewIDTokenSigner(keyring *Keyring, issuer string, expiry time.Duration) *IDTokenSigner {
+	return &IDTokenSigner{
+		keyring: keyring,
+		issuer:  issuer,
+		expiry:  expiry,
+	}
+}
+
+func (s *IDTokenSigner) Sign(claims vo.IDTokenClaims) (string, *errors2.Error) {
+	key, err := s.keyring.signingKey()
+	if err != nil {
+		return "", errors2.ErrorGenerateToken(err)
+	}
+
+	now := time.Now().UTC()
+	idClaims := idTokenClaims{
+		Nonce:         claims.Nonce,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+		Name:          claims.Name,
+		Picture:       claims.Picture,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.issuer,
+			Subject:   claims.Subject,
+			Audience:  jwt.ClaimStrings{claims.ClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.expiry)),
+		},
+	}
+	if claims.AuthTime != nil {
+		idClaims.AuthTime = jwt.NewNumericDate(*claims.AuthTime)
+	}
+
+	token := jwt.NewWithClaims(key.method, idClaims)
+	token.Header["kid"] = key.kid
+
+	signed, err := token.SignedString(key.private)
+	if err != nil {
+		return "", errors2.ErrorGenerateToken(err)
+	}
+	return signed, nil
+}
@@ -0,0 +1,63 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// PublicKeyFromJWK rebuilds the public key published by an external issuer,
+// the inverse of the conversion used to serve our own JWKS.
+func PublicKeyFromJWK(jwk vo.JSONWebKey) (crypto.PublicKey, error) {
+	switch jwk.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() <= 1 {
+			return nil, errUnsupportedKey
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		if jwk.Curve != elliptic.P256().Params().Name {
+			return nil, errUnsupportedKey
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		// uncompressed point: 0x04 || X || Y
+		point := append(append([]byte{0x04}, x...), y...)
+		return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+	case "OKP":
+		if jwk.Curve != "Ed25519" {
+			return nil, errUnsupportedKey
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errUnsupportedKey
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, errUnsupportedKey
+	}
+}
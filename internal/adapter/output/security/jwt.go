@@ -0,0 +1,164 @@
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// accessTokenType is the typ header of access tokens (RFC 9068). id_tokens are
+// signed with the same keys, so it is what keeps them from being accepted as
+// bearer tokens.
+const accessTokenType = "at+jwt"
+
+type jwtClaims struct {
+	Role      string `json:"role"`
+	Email     string `json:"email"`
+	SessionID string `json:"sid,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	// IssuedAt shadows the registered iat to keep it to the millisecond, so a
+	// token issued in the same second as a revocation is told apart from it.
+	IssuedAt *millisecondDate `json:"iat,omitempty"`
+	jwt.RegisteredClaims
+}
+
+func (c jwtClaims) GetIssuedAt() (*jwt.NumericDate, error) {
+	if c.IssuedAt == nil {
+		return nil, nil
+	}
+	return &jwt.NumericDate{Time: c.IssuedAt.Time}, nil
+}
+
+// millisecondDate is a NumericDate with a three digit fraction, which RFC 7519
+// allows; the library rounds every NumericDate to whole seconds.
+type millisecondDate struct {
+	time.Time
+}
+
+func (d millisecondDate) MarshalJSON() ([]byte, error) {
+	millis := d.UnixMilli()
+	return fmt.Appendf(nil, "%d.%03d", millis/1000, millis%1000), nil
+}
+
+func (d *millisecondDate) UnmarshalJSON(b []byte) error {
+	var number json.Number
+	if err := json.Unmarshal(b, &number); err != nil {
+		return fmt.Errorf("could not parse NumericDate: %w", err)
+	}
+	seconds, err := number.Float64()
+	if err != nil {
+		return fmt.Errorf("could not convert NumericDate to float: %w", err)
+	}
+	d.Time = time.UnixMilli(int64(math.Round(seconds * 1000))).UTC()
+	return nil
+}
+
+type JWT struct {
+	keyring *Keyring
+	expiry  time.Duration
+}
+
+func NewJWT(keyring *Keyring, expiry time.Duration) *JWT {
+	return &JWT{
+		keyring: keyring,
+		expiry:  expiry,
+	}
+}
+
+func (j *JWT) Generate(claims vo.TokenClaims) (*vo.TokenClaims, *errors2.Error) {
+	key, err := j.keyring.signingKey()
+	if err != nil {
+		return nil, errors2.ErrorGenerateToken(err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	expiresAt := now.Add(j.expiry)
+	if claims.ID == "" {
+		claims.ID = uuid.NewString()
+	}
+
+	token := jwt.NewWithClaims(key.method, jwtClaims{
+		Role:      claims.Role,
+		Email:     claims.Email,
+		SessionID: claims.SessionID,
+		ClientID:  claims.ClientID,
+		Scope:     strings.Join(claims.Scopes, " "),
+		IssuedAt:  &millisecondDate{now},
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        claims.ID,
+			Subject:   claims.PublicID,
+			Audience:  claims.Audience,
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	})
+	token.Header["kid"] = key.kid
+	token.Header["typ"] = accessTokenType
+
+	signed, err := token.SignedString(key.private)
+	if err != nil {
+		return nil, errors2.ErrorGenerateToken(err)
+	}
+
+	claims.Token = signed
+	claims.IssuedAt = now
+	claims.ExpiresAt = expiresAt
+	return &claims, nil
+}
+
+func (j *JWT) Validate(token string) (*vo.TokenClaims, *errors2.Error) {
+	var claims jwtClaims
+	_, err := jwt.ParseWithClaims(token, &claims, j.verificationKey,
+		jwt.WithValidMethods(j.keyring.algorithms()), jwt.WithExpirationRequired(), jwt.WithIssuedAt())
+	if err != nil {
+		return nil, errors2.ErrorInvalidToken(err)
+	}
+
+	result := &vo.TokenClaims{
+		ID:        claims.ID,
+		PublicID:  claims.Subject,
+		Role:      claims.Role,
+		Email:     claims.Email,
+		SessionID: claims.SessionID,
+		ClientID:  claims.ClientID,
+		Scopes:    strings.Fields(claims.Scope),
+		Audience:  claims.Audience,
+		Token:     token,
+		ExpiresAt: claims.ExpiresAt.Time,
+	}
+	if claims.IssuedAt != nil {
+		result.IssuedAt = claims.IssuedAt.Time
+	}
+	return result, nil
+}
+
+func (j *JWT) JWKS() vo.JSONWebKeySet {
+	return j.keyring.JWKS()
+}
+
+// verificationKey refuses anything but access tokens, resolves the key named
+// by the token kid and refuses tokens whose alg does not match that key, so a
+// public key can never be used as an HMAC secret.
+func (j *JWT) verificationKey(token *jwt.Token) (any, error) {
+	typ, _ := token.Header["typ"].(string)
+	if !strings.EqualFold(typ, accessTokenType) && !strings.EqualFold(typ, "application/"+accessTokenType) {
+		return nil, fmt.Errorf("%w: typ %q is not an access token", jwt.ErrTokenMalformed, typ)
+	}
+	kid, _ := token.Header["kid"].(string)
+	key, ok := j.keyring.verificationKey(kid)
+	if !ok {
+		return nil, jwt.ErrTokenUnverifiable
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, jwt.ErrTokenSignatureInvalid
+	}
+	return key.public, nil
+}
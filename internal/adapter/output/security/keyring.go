@@ -0,0 +1,354 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+
+	secretKeyID = "default"
+	rsaKeyBits  = 2048
+)
+
+var (
+	errNoSigningKey         = errors.New("keyring has no signing key")
+	errUnsupportedKey       = errors.New("unsupported key type")
+	errUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+)
+
+type keyEntry struct {
+	kid       string
+	method    jwt.SigningMethod
+	private   any
+	public    any
+	retiresAt time.Time
+}
+
+func (e *keyEntry) retired(now time.Time) bool {
+	return !e.retiresAt.IsZero() && now.After(e.retiresAt)
+}
+
+// Keyring holds every key able to verify tokens, identified by kid, and
+// designates one of them for signing. Rotated keys keep verifying until the
+// tokens they signed have expired.
+type Keyring struct {
+	mu         sync.RWMutex
+	algorithm  string
+	keys       map[string]*keyEntry
+	signingKID string
+}
+
+func newKeyring(algorithm string) *Keyring {
+	return &Keyring{
+		algorithm: algorithm,
+		keys:      make(map[string]*keyEntry),
+	}
+}
+
+// NewSecretKeyring keeps the legacy HS256 behaviour. Symmetric keys are never
+// published in the JWKS.
+func NewSecretKeyring(secret string) *Keyring {
+	k := newKeyring(AlgorithmHS256)
+	k.keys[secretKeyID] = &keyEntry{
+		kid:     secretKeyID,
+		method:  jwt.SigningMethodHS256,
+		private: []byte(secret),
+		public:  []byte(secret),
+	}
+	k.signingKID = secretKeyID
+	return k
+}
+
+// NewGeneratedKeyring creates a keyring with a fresh in-memory key. Keys are
+// lost on restart and differ between replicas, so PEM files should be used
+// outside local environments.
+func NewGeneratedKeyring(algorithm string) (*Keyring, error) {
+	k := newKeyring(algorithm)
+	if _, err := k.Rotate(0); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// NewPEMKeyring loads every *.pem file of dir using the file name as kid.
+// Files holding only a public key are kept for verification. When signingKID
+// is empty the last private key in lexical order signs.
+func NewPEMKeyring(algorithm, dir, signingKID string) (*Keyring, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	k := newKeyring(algorithm)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		kid := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		entry, err := parsePEMKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("load key %s: %w", path, err)
+		}
+		k.keys[kid] = entry
+		if signingKID == "" && entry.private != nil {
+			k.signingKID = kid
+		}
+	}
+
+	if signingKID != "" {
+		k.signingKID = signingKID
+	}
+	entry, ok := k.keys[k.signingKID]
+	if !ok || entry.private == nil {
+		return nil, errNoSigningKey
+	}
+	if entry.method.Alg() != algorithm {
+		return nil, fmt.Errorf("signing key %s is %s, expected %s", entry.kid, entry.method.Alg(), algorithm)
+	}
+	return k, nil
+}
+
+// Rotate generates a new signing key. The previous one stays available for
+// verification during retireAfter, which should cover the access token
+// lifetime. Keys whose grace period has ended are dropped.
+func (k *Keyring) Rotate(retireAfter time.Duration) (string, error) {
+	if k.algorithm == AlgorithmHS256 {
+		return "", errUnsupportedAlgorithm
+	}
+
+	private, err := generateKey(k.algorithm)
+	if err != nil {
+		return "", err
+	}
+	entry, err := newKeyEntry("", private)
+	if err != nil {
+		return "", err
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := time.Now().UTC()
+	if current, ok := k.keys[k.signingKID]; ok {
+		current.retiresAt = now.Add(retireAfter)
+	}
+	for kid, key := range k.keys {
+		if key.retired(now) {
+			delete(k.keys, kid)
+		}
+	}
+	k.keys[entry.kid] = entry
+	k.signingKID = entry.kid
+	return entry.kid, nil
+}
+
+func (k *Keyring) signingKey() (*keyEntry, error) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	entry, ok := k.keys[k.signingKID]
+	if !ok {
+		return nil, errNoSigningKey
+	}
+	return entry, nil
+}
+
+func (k *Keyring) verificationKey(kid string) (*keyEntry, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	if kid == "" {
+		kid = k.signingKID
+	}
+	entry, ok := k.keys[kid]
+	if !ok || entry.retired(time.Now().UTC()) {
+		return nil, false
+	}
+	return entry, true
+}
+
+func (k *Keyring) algorithms() []string {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	seen := make(map[string]bool)
+	var algs []string
+	for _, entry := range k.keys {
+		if alg := entry.method.Alg(); !seen[alg] {
+			seen[alg] = true
+			algs = append(algs, alg)
+		}
+	}
+	return algs
+}
+
+// JWKS returns the public keys still accepted for verification.
+func (k *Keyring) JWKS() vo.JSONWebKeySet {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	now := time.Now().UTC()
+	set := vo.JSONWebKeySet{Keys: []vo.JSONWebKey{}}
+	for _, entry := range k.keys {
+		if entry.retired(now) {
+			continue
+		}
+		if jwk, ok := toJSONWebKey(entry); ok {
+			set.Keys = append(set.Keys, jwk)
+		}
+	}
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].KeyID < set.Keys[j].KeyID })
+	return set
+}
+
+func generateKey(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		return rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmES256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, private, err := ed25519.GenerateKey(rand.Reader)
+		return private, err
+	default:
+		return nil, errUnsupportedAlgorithm
+	}
+}
+
+func parsePEMKey(kid string, data []byte) (*keyEntry, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		public, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newPublicKeyEntry(kid, public)
+	case "RSA PRIVATE KEY":
+		private, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKeyEntry(kid, private)
+	case "EC PRIVATE KEY":
+		private, err := x509.ParseECPrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return newKeyEntry(kid, private)
+	case "PRIVATE KEY":
+		private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := private.(crypto.Signer)
+		if !ok {
+			return nil, errUnsupportedKey
+		}
+		return newKeyEntry(kid, signer)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+func newKeyEntry(kid string, private crypto.Signer) (*keyEntry, error) {
+	entry, err := newPublicKeyEntry(kid, private.Public())
+	if err != nil {
+		return nil, err
+	}
+	entry.private = private
+	return entry, nil
+}
+
+func newPublicKeyEntry(kid string, public crypto.PublicKey) (*keyEntry, error) {
+	var method jwt.SigningMethod
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS256
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return nil, errUnsupportedKey
+		}
+		method = jwt.SigningMethodES256
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+	default:
+		return nil, errUnsupportedKey
+	}
+
+	if kid == "" {
+		der, err := x509.MarshalPKIXPublicKey(public)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(der)
+		kid = base64.RawURLEncoding.EncodeToString(sum[:12])
+	}
+
+	return &keyEntry{kid: kid, method: method, public: public}, nil
+}
+
+func toJSONWebKey(entry *keyEntry) (vo.JSONWebKey, bool) {
+	jwk := vo.JSONWebKey{
+		KeyID:     entry.kid,
+		Algorithm: entry.method.Alg(),
+		Use:       "sig",
+	}
+
+	switch key := entry.public.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	case *ecdsa.PublicKey:
+		public, err := key.ECDH()
+		if err != nil {
+			return vo.JSONWebKey{}, false
+		}
+		// uncompressed point: 0x04 || X || Y
+		point := public.Bytes()[1:]
+		jwk.KeyType = "EC"
+		jwk.Curve = key.Curve.Params().Name
+		jwk.X = base64.RawURLEncoding.EncodeToString(point[:len(point)/2])
+		jwk.Y = base64.RawURLEncoding.EncodeToString(point[len(point)/2:])
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(key)
+	default:
+		return vo.JSONWebKey{}, false
+	}
+	return jwk, true
+}
@@ -0,0 +1,34 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+const opaqueTokenBytes = 32
+
+// OpaqueToken issues random tokens that are handed to clients as-is and
+// persisted only as their SHA-256 hash.
+type OpaqueToken struct{}
+
+func NewOpaqueToken() *OpaqueToken {
+	return &OpaqueToken{}
+}
+
+func (o *OpaqueToken) Generate() (string, string, *errors2.Error) {
+	buf := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", errors2.ErrorGenerateOpaqueToken(err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, o.Hash(token), nil
+}
+
+func (o *OpaqueToken) Hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
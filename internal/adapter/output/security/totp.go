@@ -0,0 +1,118 @@
+package security
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+const (
+	totpSecretBytes    = 20
+	totpDigits         = 6
+	totpPeriod         = 30
+	recoveryCodeLength = 10
+	// recoveryCodeAlphabet leaves out characters that are easily confused
+	// when read from paper: 0/o, 1/l/i.
+	recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+var (
+	totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+	totpModulo   = uint32(1_000_000)
+)
+
+// TOTP uses the parameters every authenticator app supports: SHA-1, six
+// digits and 30 second steps. skew is the number of steps accepted on each
+// side of the current one.
+type TOTP struct {
+	issuer string
+	skew   int
+}
+
+func NewTOTP(issuer string, skew int) *TOTP {
+	return &TOTP{
+		issuer: issuer,
+		skew:   skew,
+	}
+}
+
+func (t *TOTP) GenerateSecret() (string, *errors2.Error) {
+	buf := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors2.ErrorGenerateMFASecret(err)
+	}
+	return totpEncoding.EncodeToString(buf), nil
+}
+
+// ProvisioningURI builds the otpauth:// URI apps import, usually from a QR code.
+func (t *TOTP) ProvisioningURI(secret, account string) string {
+	params := url.Values{
+		"secret":    {secret},
+		"issuer":    {t.issuer},
+		"algorithm": {"SHA1"},
+		"digits":    {fmt.Sprint(totpDigits)},
+		"period":    {fmt.Sprint(totpPeriod)},
+	}
+	label := url.PathEscape(t.issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func (t *TOTP) Match(secret, code string, now time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / totpPeriod
+	for offset := -t.skew; offset <= t.skew; offset++ {
+		step := current + int64(offset)
+		if subtle.ConstantTimeCompare([]byte(hotp(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+func (t *TOTP) GenerateRecoveryCodes(n int) ([]string, *errors2.Error) {
+	limit := big.NewInt(int64(len(recoveryCodeAlphabet)))
+	codes := make([]string, 0, n)
+	for range n {
+		var code strings.Builder
+		for i := range recoveryCodeLength {
+			if i == recoveryCodeLength/2 {
+				code.WriteByte('-')
+			}
+			idx, err := rand.Int(rand.Reader, limit)
+			if err != nil {
+				return nil, errors2.ErrorGenerateMFASecret(err)
+			}
+			code.WriteByte(recoveryCodeAlphabet[idx.Int64()])
+		}
+		codes = append(codes, code.String())
+	}
+	return codes, nil
+}
+
+// hotp computes the HOTP value of RFC 4226 for counter.
+func hotp(key []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%totpModulo)
+}
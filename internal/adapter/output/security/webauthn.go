@@ -0,0 +1,280 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"slices"
+
+	errors2 "github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	webAuthnFlagUserPresent    = 0x01
+	webAuthnFlagUserVerified   = 0x04
+	webAuthnFlagBackupEligible = 0x08
+	webAuthnFlagBackedUp       = 0x10
+	webAuthnFlagAttestedData   = 0x40
+
+	webAuthnAuthDataLength = 37
+	webAuthnAAGUIDLength   = 16
+	// webAuthnMaxCredentialID is the limit of the specification, which keeps
+	// the base64url form within the column it is stored in.
+	webAuthnMaxCredentialID = 1023
+	minRSAKeyBits           = 2048
+)
+
+type webAuthnClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+type webAuthnAuthData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	aaguid       []byte
+	credentialID []byte
+	publicKey    []byte
+}
+
+// WebAuthn verifies passkey ceremonies for one relying party. User
+// verification is always required, so a passkey stands for both factors.
+// Registrations ask for "none" attestation: the attestation statement is not
+// checked and the authenticator model is recorded as reported.
+type WebAuthn struct {
+	rpIDHash [32]byte
+	origins  []string
+}
+
+func NewWebAuthn(rpID string, origins []string) *WebAuthn {
+	return &WebAuthn{
+		rpIDHash: sha256.Sum256([]byte(rpID)),
+		origins:  origins,
+	}
+}
+
+func (w *WebAuthn) Challenge(clientDataJSON []byte) (string, *errors2.Error) {
+	var clientData webAuthnClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil || clientData.Challenge == "" {
+		return "", errors2.ErrorInvalidPasskeyChallenge()
+	}
+	return clientData.Challenge, nil
+}
+
+func (w *WebAuthn) VerifyRegistration(challenge string, attestation vo.WebAuthnAttestation) (*vo.WebAuthnCredentialData, *errors2.Error) {
+	if reason := w.verifyClientData(attestation.ClientDataJSON, "webauthn.create", challenge); reason != "" {
+		return nil, errors2.ErrorInvalidPasskeyAttestation(reason)
+	}
+
+	object, rest, err := decodeCBOR(attestation.AttestationObject)
+	fields, ok := object.(map[any]any)
+	if err != nil || !ok || len(rest) != 0 {
+		return nil, errors2.ErrorInvalidPasskeyAttestation("malformed attestation object")
+	}
+	rawAuthData, ok := fields["authData"].([]byte)
+	if !ok {
+		return nil, errors2.ErrorInvalidPasskeyAttestation("missing authenticator data")
+	}
+
+	authData, reason := w.parseAuthData(rawAuthData)
+	if reason != "" {
+		return nil, errors2.ErrorInvalidPasskeyAttestation(reason)
+	}
+	if authData.flags&webAuthnFlagAttestedData == 0 {
+		return nil, errors2.ErrorInvalidPasskeyAttestation("missing attested credential data")
+	}
+	if len(authData.credentialID) > webAuthnMaxCredentialID {
+		return nil, errors2.ErrorInvalidPasskeyAttestation("credential id is too long")
+	}
+
+	algorithm, _, reason := parseCOSEKey(authData.publicKey)
+	if reason != "" {
+		return nil, errors2.ErrorInvalidPasskeyAttestation(reason)
+	}
+
+	return &vo.WebAuthnCredentialData{
+		CredentialID:   authData.credentialID,
+		PublicKey:      authData.publicKey,
+		Algorithm:      algorithm,
+		SignCount:      authData.signCount,
+		AAGUID:         formatAAGUID(authData.aaguid),
+		BackupEligible: authData.flags&webAuthnFlagBackupEligible != 0,
+		BackedUp:       authData.flags&webAuthnFlagBackedUp != 0,
+	}, nil
+}
+
+func (w *WebAuthn) VerifyAssertion(challenge string, assertion vo.WebAuthnAssertion, publicKey []byte) (*vo.WebAuthnAssertionResult, *errors2.Error) {
+	if reason := w.verifyClientData(assertion.ClientDataJSON, "webauthn.get", challenge); reason != "" {
+		return nil, errors2.ErrorInvalidPasskeyAssertion(reason)
+	}
+
+	authData, reason := w.parseAuthData(assertion.AuthenticatorData)
+	if reason != "" {
+		return nil, errors2.ErrorInvalidPasskeyAssertion(reason)
+	}
+
+	algorithm, key, reason := parseCOSEKey(publicKey)
+	if reason != "" {
+		return nil, errors2.ErrorInvalidPasskeyAssertion(reason)
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signed := slices.Concat(assertion.AuthenticatorData, clientDataHash[:])
+	if !verifySignature(algorithm, key, signed, assertion.Signature) {
+		return nil, errors2.ErrorInvalidPasskeyAssertion("signature does not match")
+	}
+
+	return &vo.WebAuthnAssertionResult{
+		SignCount: authData.signCount,
+		BackedUp:  authData.flags&webAuthnFlagBackedUp != 0,
+	}, nil
+}
+
+// verifyClientData returns why the client data does not belong to the
+// expected ceremony, or an empty string when it does.
+func (w *WebAuthn) verifyClientData(clientDataJSON []byte, ceremony, challenge string) string {
+	var clientData webAuthnClientData
+	if err := json.Unmarshal(clientDataJSON, &clientData); err != nil {
+		return "malformed client data"
+	}
+	switch {
+	case clientData.Type != ceremony:
+		return "unexpected ceremony type " + clientData.Type
+	case subtle.ConstantTimeCompare([]byte(clientData.Challenge), []byte(challenge)) != 1:
+		return "challenge does not match"
+	case clientData.CrossOrigin:
+		return "cross-origin ceremonies are not allowed"
+	case !slices.Contains(w.origins, clientData.Origin):
+		return "origin " + clientData.Origin + " is not allowed"
+	}
+	return ""
+}
+
+// parseAuthData checks the relying party and flags of the authenticator data
+// and reads the attested credential it carries, if any.
+func (w *WebAuthn) parseAuthData(data []byte) (*webAuthnAuthData, string) {
+	if len(data) < webAuthnAuthDataLength {
+		return nil, "authenticator data is too short"
+	}
+	authData := &webAuthnAuthData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	switch {
+	case subtle.ConstantTimeCompare(authData.rpIDHash, w.rpIDHash[:]) != 1:
+		return nil, "relying party does not match"
+	case authData.flags&webAuthnFlagUserPresent == 0:
+		return nil, "user was not present"
+	case authData.flags&webAuthnFlagUserVerified == 0:
+		return nil, "user was not verified"
+	case authData.flags&webAuthnFlagBackedUp != 0 && authData.flags&webAuthnFlagBackupEligible == 0:
+		return nil, "backed up credential is not backup eligible"
+	}
+	if authData.flags&webAuthnFlagAttestedData == 0 {
+		return authData, ""
+	}
+
+	rest := data[webAuthnAuthDataLength:]
+	if len(rest) < webAuthnAAGUIDLength+2 {
+		return nil, "attested credential data is too short"
+	}
+	authData.aaguid = rest[:webAuthnAAGUIDLength]
+	idLength := int(binary.BigEndian.Uint16(rest[webAuthnAAGUIDLength:]))
+	rest = rest[webAuthnAAGUIDLength+2:]
+	if idLength == 0 || len(rest) < idLength {
+		return nil, "malformed credential id"
+	}
+	authData.credentialID = rest[:idLength]
+	rest = rest[idLength:]
+
+	_, extensions, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, "malformed credential public key"
+	}
+	authData.publicKey = rest[:len(rest)-len(extensions)]
+	return authData, ""
+}
+
+// parseCOSEKey decodes a COSE_Key of one of the accepted algorithms.
+func parseCOSEKey(data []byte) (int64, crypto.PublicKey, string) {
+	decoded, rest, err := decodeCBOR(data)
+	key, ok := decoded.(map[any]any)
+	if err != nil || !ok || len(rest) != 0 {
+		return 0, nil, "malformed credential public key"
+	}
+	keyType, _ := key[int64(1)].(int64)
+	algorithm, _ := key[int64(3)].(int64)
+	curve, _ := key[int64(-1)].(int64)
+
+	switch {
+	case algorithm == vo.COSEAlgorithmES256 && keyType == 2 && curve == 1:
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if len(x) != 32 || len(y) != 32 {
+			return 0, nil, "malformed P-256 public key"
+		}
+		point := slices.Concat([]byte{0x04}, x, y)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return 0, nil, "P-256 public key is not on the curve"
+		}
+		return algorithm, &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, ""
+	case algorithm == vo.COSEAlgorithmEdDSA && keyType == 1 && curve == 6:
+		x, _ := key[int64(-2)].([]byte)
+		if len(x) != ed25519.PublicKeySize {
+			return 0, nil, "malformed Ed25519 public key"
+		}
+		return algorithm, ed25519.PublicKey(x), ""
+	case algorithm == vo.COSEAlgorithmRS256 && keyType == 3:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		modulus := new(big.Int).SetBytes(n)
+		exponent := new(big.Int).SetBytes(e)
+		if modulus.BitLen() < minRSAKeyBits || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+			return 0, nil, "malformed RSA public key"
+		}
+		return algorithm, &rsa.PublicKey{N: modulus, E: int(exponent.Int64())}, ""
+	default:
+		return 0, nil, "unsupported credential algorithm"
+	}
+}
+
+func verifySignature(algorithm int64, key crypto.PublicKey, signed, signature []byte) bool {
+	digest := sha256.Sum256(signed)
+	switch algorithm {
+	case vo.COSEAlgorithmES256:
+		return ecdsa.VerifyASN1(key.(*ecdsa.PublicKey), digest[:], signature)
+	case vo.COSEAlgorithmEdDSA:
+		return ed25519.Verify(key.(ed25519.PublicKey), signed, signature)
+	case vo.COSEAlgorithmRS256:
+		return rsa.VerifyPKCS1v15(key.(*rsa.PublicKey), crypto.SHA256, digest[:], signature) == nil
+	}
+	return false
+}
+
+// formatAAGUID renders the authenticator model in the UUID form used by the
+// metadata service.
+func formatAAGUID(aaguid []byte) string {
+	if len(aaguid) != webAuthnAAGUIDLength {
+		return ""
+	}
+	h := hex.EncodeToString(aaguid)
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:]
+}
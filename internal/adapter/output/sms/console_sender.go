@@ -0,0 +1,28 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+// ConsoleSender prints messages instead of delivering them. Meant for local
+// development until a real SMS provider is wired.
+type ConsoleSender struct {
+	out io.Writer
+}
+
+func NewConsoleSender() *ConsoleSender {
+	return &ConsoleSender{out: os.Stdout}
+}
+
+func (s *ConsoleSender) Send(_ context.Context, to, message string) *errors.Error {
+	if _, err := fmt.Fprintf(s.out, "[sms] %s to=%s %s\n", time.Now().UTC().Format(time.RFC3339), to, message); err != nil {
+		return errors.ErrorSendSms(err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+// FileSender appends every message as one line to path.
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Send(_ context.Context, to, message string) *errors.Error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o750); err != nil {
+		return errors.ErrorSendSms(err)
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.ErrorSendSms(err)
+	}
+	defer file.Close()
+
+	if _, err := fmt.Fprintf(file, "%s to=%s %s\n", time.Now().UTC().Format(time.RFC3339), to, message); err != nil {
+		return errors.ErrorSendSms(err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type AuthorizeOAuthClient struct {
+	unitOfWork                  adapter.UnitOfWork
+	clientRepository            port.OAuthClientRepository
+	consentRepository           port.OAuthConsentRepository
+	authorizationCodeRepository port.AuthorizationCodeRepository
+	refreshTokenRepository      port.RefreshTokenRepository
+	userService                 service.UserService
+	opaqueToken                 adapter.OpaqueToken
+	codeTTL                     time.Duration
+	log                         adapter.Logger
+	tracer                      adapter.Tracer
+}
+
+func NewAuthorizeOAuthClient(
+	unitOfWork adapter.UnitOfWork,
+	clientRepository port.OAuthClientRepository,
+	consentRepository port.OAuthConsentRepository,
+	authorizationCodeRepository port.AuthorizationCodeRepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	userService service.UserService,
+	opaqueToken adapter.OpaqueToken,
+	codeTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *AuthorizeOAuthClient {
+	return &AuthorizeOAuthClient{
+		unitOfWork:                  unitOfWork,
+		clientRepository:            clientRepository,
+		consentRepository:           consentRepository,
+		authorizationCodeRepository: authorizationCodeRepository,
+		refreshTokenRepository:      refreshTokenRepository,
+		userService:                 userService,
+		opaqueToken:                 opaqueToken,
+		codeTTL:                     codeTTL,
+		log:                         log,
+		tracer:                      tracer,
+	}
+}
+
+// Execute records the decision of the signed-in user on an authorization
+// request and returns where the browser goes next: back to the client with
+// an authorization code, or with the error of the request.
+func (c *AuthorizeOAuthClient) Execute(ctx context.Context, input dto.AuthorizeOAuthClientInput) (*dto.OAuthRedirectOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "AuthorizeOAuthClient.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	if principal, ok := vo.PrincipalFromContext(ctx); ok && principal.IsDelegated() {
+		err := errors.ErrorOAuthDelegatedConsent()
+		span.RecordError(err)
+		c.log.WarnJSON("OAuth authorization with a client token rejected",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": principal.ClientID,
+			})
+		return nil, err
+	}
+
+	request := input.OAuthAuthorizationRequest
+	client, scopes, err := validateAuthorizationRequest(ctx, c.clientRepository, request)
+	if err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Invalid OAuth authorization request",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": request.ClientID,
+				"error":     err.Error(),
+			})
+		if client == nil {
+			return nil, err
+		}
+		return authorizationErrorRedirect(request, err), nil
+	}
+
+	if input.Approve != nil && !*input.Approve {
+		c.log.InfoJSON("OAuth authorization denied by user",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": client.ClientID(),
+			})
+		return authorizationErrorRedirect(request, errors.ErrorOAuthAccessDenied()), nil
+	}
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if input.Approve == nil {
+		consent, err := c.consentRepository.FindConsent(ctx, user.ID(), client.ID())
+		if err != nil {
+			span.RecordError(err)
+			c.log.ErrorJSON("Error finding OAuth consent",
+				map[string]any{
+					"trace_id":  traceID,
+					"client_id": client.ClientID(),
+					"error":     err.Error(),
+				})
+			return nil, err
+		}
+		if consent == nil || !consent.Covers(scopes) {
+			consentErr := errors.ErrorOAuthConsentRequired()
+			span.RecordError(consentErr)
+			return nil, consentErr
+		}
+	}
+
+	var authTime *time.Time
+	if slices.Contains(scopes, entity.ScopeOpenID) {
+		authTime, err = c.authenticatedAt(ctx)
+		if err != nil {
+			span.RecordError(err)
+			c.log.ErrorJSON("Error finding when the user signed in",
+				map[string]any{
+					"trace_id": traceID,
+					"error":    err.Error(),
+				})
+			return nil, err
+		}
+	}
+
+	code, codeHash, err := c.opaqueToken.Generate()
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error generating authorization code",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		if input.Approve != nil {
+			consent := entity.BuilderOAuthConsent().
+				WithUserID(user.ID()).
+				WithClientID(client.ID()).
+				WithScopes(scopes).
+				Build()
+			if err := c.consentRepository.SaveConsent(ctx, consent); err != nil {
+				return err
+			}
+		}
+		authorizationCode := entity.BuilderAuthorizationCode().
+			WithCodeHash(codeHash).
+			WithClientID(client.ID()).
+			WithUserID(user.ID()).
+			WithRedirectURI(request.RedirectURI).
+			WithScopes(scopes).
+			WithCodeChallenge(request.CodeChallenge).
+			WithNonce(request.Nonce).
+			WithAuthTime(authTime).
+			WithExpiresAt(now.Add(c.codeTTL)).
+			Build()
+		return c.authorizationCodeRepository.CreateAuthorizationCode(ctx, authorizationCode)
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing authorization code",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": client.ClientID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("OAuth client authorized",
+		map[string]any{
+			"trace_id":  traceID,
+			"client_id": client.ClientID(),
+			"public_id": user.PublicID(),
+		})
+
+	params := url.Values{"code": {code}}
+	if request.State != "" {
+		params.Set("state", request.State)
+	}
+	return &dto.OAuthRedirectOutput{RedirectTo: withQuery(request.RedirectURI, params)}, nil
+}
+
+// authenticatedAt returns when the user signed in to the session behind the
+// request, reported as auth_time in the id_token. It is nil when the access
+// token carries no session.
+func (c *AuthorizeOAuthClient) authenticatedAt(ctx context.Context) (*time.Time, *errors.Error) {
+	principal, ok := vo.PrincipalFromContext(ctx)
+	if !ok || principal.SessionID == "" {
+		return nil, nil
+	}
+	return c.refreshTokenRepository.FindFamilyStartedAt(ctx, principal.SessionID)
+}
@@ -0,0 +1,164 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+const errPasswordConfirmMismatch = "must match password"
+
+type ChangePassword struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	refreshTokenRepository port.RefreshTokenRepository
+	userService            service.UserService
+	denylist               adapter.TokenDenylist
+	loginThrottle          adapter.LoginThrottle
+	bcrypt                 adapter.Bcrypt
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewChangePassword(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	userService service.UserService,
+	denylist adapter.TokenDenylist,
+	loginThrottle adapter.LoginThrottle,
+	bcrypt adapter.Bcrypt,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ChangePassword {
+	return &ChangePassword{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userService:            userService,
+		denylist:               denylist,
+		loginThrottle:          loginThrottle,
+		bcrypt:                 bcrypt,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute replaces the password of the authenticated user and ends every other
+// session. The session of the request stays signed in.
+func (c *ChangePassword) Execute(ctx context.Context, input dto.ChangePasswordInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "ChangePassword.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	c.log.InfoJSON("Changing password",
+		map[string]any{
+			"trace_id": traceID,
+			"body": logger.RedactStruct[dto.ChangePasswordInput](input, "current_password", "password",
+				"password_confirm"),
+		})
+
+	newPassword := vo.NewPassword(input.Password)
+	if isValid := validateNewPassword(&newPassword, input.PasswordConfirm); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "password")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Password validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return validationErr
+	}
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := checkLoginThrottle(ctx, c.loginThrottle, user.Email()); err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Password change throttled",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	if !c.bcrypt.CompareHash(input.CurrentPassword, user.PasswordHash()) {
+		err := recordLoginFailure(ctx, c.loginThrottle, user.Email(), errors.ErrorIncorrectCurrentPassword())
+		span.RecordError(err)
+		c.log.WarnJSON("Incorrect current password",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return err
+	}
+
+	if err := c.loginThrottle.RecordSuccess(ctx, user.Email(), vo.ClientIPFromContext(ctx)); err != nil {
+		c.log.ErrorJSON("Error clearing failed logins",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+	}
+
+	hashedPassword, err := c.bcrypt.Hash(newPassword.String())
+	if err != nil {
+		span.RecordError(err)
+		c.log.CriticalJSON("Error hashing password",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+
+	principal, _ := vo.PrincipalFromContext(ctx)
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		updated, err := c.userRepository.UpdateUserPassword(ctx, user.ID(), hashedPassword, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return errors.ErrorUserNotFound(user.PublicID())
+		}
+		if err := c.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.PublicID(), principal.SessionID); err != nil {
+			return err
+		}
+		// last step, so a denylist failure rolls the password change back
+		return c.denylist.RevokeUserTokens(ctx, user.PublicID(), principal.SessionID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error changing password",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	return nil
+}
+
+// validateNewPassword applies the password policy and checks the confirmation.
+func validateNewPassword(password *vo.Password, passwordConfirm string) *validator.Validator {
+	isValid := password.Validate()
+	isValid.Assert(password.String() == strings.TrimSpace(passwordConfirm), "password_confirm", errPasswordConfirmMismatch)
+	return isValid
+}
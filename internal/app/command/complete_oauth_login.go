@@ -0,0 +1,288 @@
+package command
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type CompleteOAuthLogin struct {
+	unitOfWork           adapter.UnitOfWork
+	userRepository       port.UserRepository
+	identityRepository   port.UserIdentityRepository
+	authTokenService     service.AuthTokenService
+	mfaService           service.MFAService
+	providers            map[string]adapter.IdentityProvider
+	cache                adapter.Cache
+	opaqueToken          adapter.OpaqueToken
+	requireVerifiedEmail bool
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+	utils                adapter.Utils
+}
+
+func NewCompleteOAuthLogin(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	identityRepository port.UserIdentityRepository,
+	authTokenService service.AuthTokenService,
+	mfaService service.MFAService,
+	providers map[string]adapter.IdentityProvider,
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	requireVerifiedEmail bool,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+	utils adapter.Utils,
+) *CompleteOAuthLogin {
+	return &CompleteOAuthLogin{
+		unitOfWork:           unitOfWork,
+		userRepository:       userRepository,
+		identityRepository:   identityRepository,
+		authTokenService:     authTokenService,
+		mfaService:           mfaService,
+		providers:            providers,
+		cache:                cache,
+		opaqueToken:          opaqueToken,
+		requireVerifiedEmail: requireVerifiedEmail,
+		log:                  log,
+		tracer:               tracer,
+		utils:                utils,
+	}
+}
+
+// Execute handles the provider callback. The state must match the binding
+// kept by the browser that started the login, which stops login CSRF, and is
+// consumed before anything else so a callback can never be replayed; the
+// external account is then resolved to a local user, which is created on
+// first sign-in.
+func (c *CompleteOAuthLogin) Execute(ctx context.Context, input dto.CompleteOAuthLoginInput) (*dto.LoginAuthUserOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "CompleteOAuthLogin.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	login, err := c.consumeState(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Invalid OAuth state",
+			map[string]any{
+				"trace_id": traceID,
+				"provider": input.Provider,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	if input.Error != "" {
+		deniedErr := errors.ErrorOAuthDenied(input.Error)
+		span.RecordError(deniedErr)
+		c.log.WarnJSON("OAuth login denied by provider",
+			map[string]any{
+				"trace_id": traceID,
+				"provider": input.Provider,
+				"reason":   input.Error,
+			})
+		return nil, deniedErr
+	}
+
+	provider, ok := c.providers[input.Provider]
+	if !ok {
+		providerErr := errors.ErrorUnknownOAuthProvider(input.Provider)
+		span.RecordError(providerErr)
+		return nil, providerErr
+	}
+
+	external, err := provider.Exchange(ctx, input.Code, *login)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error exchanging OAuth code",
+			map[string]any{
+				"trace_id": traceID,
+				"provider": input.Provider,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	user, err := c.resolveUser(ctx, external)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error resolving OAuth identity",
+			map[string]any{
+				"trace_id": traceID,
+				"provider": input.Provider,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	if c.requireVerifiedEmail && !user.IsEmailVerified() {
+		verifyErr := errors.ErrorEmailNotVerified()
+		span.RecordError(verifyErr)
+		c.log.WarnJSON("Login of unverified e-mail rejected",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return nil, verifyErr
+	}
+
+	challenge, err := c.mfaService.StartChallenge(ctx, user)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error starting MFA challenge",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if challenge != nil {
+		c.log.InfoJSON("MFA challenge started",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return mapper.ToMFAChallengeOutput(challenge), nil
+	}
+
+	tokens, err := c.authTokenService.IssueTokens(ctx, user, "")
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing auth tokens",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("OAuth login completed",
+		map[string]any{
+			"trace_id":  traceID,
+			"provider":  input.Provider,
+			"public_id": user.PublicID(),
+		})
+	return mapper.ToLoginAuthUserOutput(tokens), nil
+}
+
+func (c *CompleteOAuthLogin) consumeState(ctx context.Context, input dto.CompleteOAuthLoginInput) (*vo.OAuthLoginState, *errors.Error) {
+	if input.State == "" {
+		return nil, errors.ErrorInvalidOAuthState()
+	}
+	stateHash := c.opaqueToken.Hash(input.State)
+	if subtle.ConstantTimeCompare([]byte(input.StateBinding), []byte(stateHash)) != 1 {
+		return nil, errors.ErrorInvalidOAuthState()
+	}
+
+	key := oauthStatePrefix + stateHash
+	var login vo.OAuthLoginState
+	found, err := c.cache.Get(ctx, key, &login)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errors.ErrorInvalidOAuthState()
+	}
+	if err := c.cache.Delete(ctx, key); err != nil {
+		return nil, err
+	}
+	if login.Provider != input.Provider || login.State != input.State {
+		return nil, errors.ErrorInvalidOAuthState()
+	}
+	return &login, nil
+}
+
+// resolveUser finds the user linked to external. Unlinked identities are
+// linked to the account holding the same e-mail only when both the provider
+// and the account have verified it; otherwise a new account is created.
+func (c *CompleteOAuthLogin) resolveUser(ctx context.Context, external *vo.ExternalIdentity) (*entity.User, *errors.Error) {
+	var user *entity.User
+	now := time.Now().UTC()
+
+	err := c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		identity, err := c.identityRepository.FindIdentity(ctx, external.Provider, external.Subject)
+		if err != nil {
+			return err
+		}
+		if identity != nil {
+			user, err = c.userRepository.FindUserByID(ctx, identity.UserID())
+			if err != nil {
+				return err
+			}
+			if user == nil {
+				return errors.ErrorInvalidCredentials()
+			}
+			return c.identityRepository.RecordIdentityLogin(ctx, identity.ID(), external.Email, now)
+		}
+
+		if external.Email == "" {
+			return errors.ErrorOAuthEmailRequired(external.Provider)
+		}
+
+		user, err = c.userRepository.FindUserByEmail(ctx, external.Email)
+		if err != nil {
+			return err
+		}
+		if user != nil && (!external.EmailVerified || !user.IsEmailVerified()) {
+			return errors.ErrorOAuthAccountConflict(external.Provider)
+		}
+		if user == nil {
+			user, err = c.createUser(ctx, external, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = c.identityRepository.CreateIdentity(ctx, entity.BuilderUserIdentity().
+			WithUserID(user.ID()).
+			WithProvider(external.Provider).
+			WithSubject(external.Subject).
+			WithEmail(external.Email).
+			WithCreatedAt(now).
+			WithLastLoginAt(now).
+			Build())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (c *CompleteOAuthLogin) createUser(ctx context.Context, external *vo.ExternalIdentity, now time.Time) (*entity.User, *errors.Error) {
+	name := strings.TrimSpace(external.Name)
+	if name == "" {
+		name, _, _ = strings.Cut(external.Email, "@")
+	}
+
+	// without a password hash the account can only sign in through providers
+	builder := entity.BuilderUser().
+		WithEmail(external.Email).
+		WithName(name).
+		WithAvatarURL(external.AvatarURL)
+	if external.EmailVerified {
+		builder.WithEmailVerifiedAt(&now)
+	}
+	user := builder.Build()
+	user.AssignPublicID(c.utils.UUID())
+	user.AssignRole(entity.RoleUser)
+
+	if isValid := user.ValidateProfile(); isValid.HasErrors() {
+		return nil, errors.InvalidEntity(isValid, "user")
+	}
+
+	return c.userRepository.CreateUser(ctx, user)
+}
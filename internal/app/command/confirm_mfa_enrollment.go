@@ -0,0 +1,138 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type ConfirmMFAEnrollment struct {
+	unitOfWork             adapter.UnitOfWork
+	userMFARepository      port.UserMFARepository
+	refreshTokenRepository port.RefreshTokenRepository
+	userService            service.UserService
+	mfaService             service.MFAService
+	denylist               adapter.TokenDenylist
+	totp                   adapter.TOTP
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewConfirmMFAEnrollment(
+	unitOfWork adapter.UnitOfWork,
+	userMFARepository port.UserMFARepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	userService service.UserService,
+	mfaService service.MFAService,
+	denylist adapter.TokenDenylist,
+	totp adapter.TOTP,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ConfirmMFAEnrollment {
+	return &ConfirmMFAEnrollment{
+		unitOfWork:             unitOfWork,
+		userMFARepository:      userMFARepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userService:            userService,
+		mfaService:             mfaService,
+		denylist:               denylist,
+		totp:                   totp,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute turns MFA on once input.Code proves the authenticator holds the
+// secret of StartMFAEnrollment, and returns the recovery codes of the user.
+// Every other session is ended, since none of them passed the second factor.
+func (c *ConfirmMFAEnrollment) Execute(ctx context.Context, input dto.MFACodeInput) (*dto.MFARecoveryCodesOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "ConfirmMFAEnrollment.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	mfa, err := c.userMFARepository.FindUserMFA(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding MFA enrollment",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if mfa == nil {
+		notStartedErr := errors.ErrorMFAEnrollmentNotStarted()
+		span.RecordError(notStartedErr)
+		return nil, notStartedErr
+	}
+	if mfa.IsConfirmed() {
+		enabledErr := errors.ErrorMFAAlreadyEnabled()
+		span.RecordError(enabledErr)
+		return nil, enabledErr
+	}
+
+	now := time.Now().UTC()
+	step, ok := c.totp.Match(mfa.TOTPSecret(), input.Code, now)
+	if !ok {
+		codeErr := errors.ErrorInvalidMFACode()
+		span.RecordError(codeErr)
+		c.log.WarnJSON("Invalid MFA enrollment code",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return nil, codeErr
+	}
+
+	var codes []string
+	principal, _ := vo.PrincipalFromContext(ctx)
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		confirmed, err := c.userMFARepository.ConfirmUserMFA(ctx, user.ID(), step, now)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return errors.ErrorMFAAlreadyEnabled()
+		}
+		codes, err = c.mfaService.IssueRecoveryCodes(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		if err := c.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.PublicID(), principal.SessionID); err != nil {
+			return err
+		}
+		// last step, so a denylist failure rolls the enrollment back
+		return c.denylist.RevokeUserTokens(ctx, user.PublicID(), principal.SessionID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error confirming MFA enrollment",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("MFA enabled",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	return &dto.MFARecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
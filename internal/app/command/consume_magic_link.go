@@ -0,0 +1,157 @@
+package command
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+type ConsumeMagicLink struct {
+	userRepository         port.UserRepository
+	oneTimeTokenRepository port.OneTimeTokenRepository
+	authTokenService       service.AuthTokenService
+	mfaService             service.MFAService
+	opaqueToken            adapter.OpaqueToken
+	signer                 adapter.Signer
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewConsumeMagicLink(
+	userRepository port.UserRepository,
+	oneTimeTokenRepository port.OneTimeTokenRepository,
+	authTokenService service.AuthTokenService,
+	mfaService service.MFAService,
+	opaqueToken adapter.OpaqueToken,
+	signer adapter.Signer,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ConsumeMagicLink {
+	return &ConsumeMagicLink{
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		authTokenService:       authTokenService,
+		mfaService:             mfaService,
+		opaqueToken:            opaqueToken,
+		signer:                 signer,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute signs in the owner of a magic link. The signature is checked
+// before the token is consumed, so a link presented without the nonce of the
+// browser that requested it is not burned; once checked, the token is
+// consumed and cannot be used again. Opening the link proves control of the
+// mailbox, which verifies the e-mail address of the account. Accounts with
+// MFA still get a challenge, as with a password login.
+func (c *ConsumeMagicLink) Execute(ctx context.Context, input dto.ConsumeMagicLinkInput) (*dto.LoginAuthUserOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "ConsumeMagicLink.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+	c.log.InfoJSON("Consuming magic link",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     logger.RedactStruct[dto.ConsumeMagicLinkInput](input, "token", "signature", "nonce"),
+		})
+
+	user, err := c.redeem(ctx, input)
+	if err != nil {
+		span.RecordError(err)
+		fields := map[string]any{
+			"trace_id": traceID,
+			"error":    err.Error(),
+		}
+		if err.Code == errors.ErrInternal {
+			c.log.ErrorJSON("Error consuming magic link", fields)
+		} else {
+			c.log.WarnJSON("Invalid magic link", fields)
+		}
+		return nil, err
+	}
+
+	challenge, err := c.mfaService.StartChallenge(ctx, user)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error starting MFA challenge",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if challenge != nil {
+		c.log.InfoJSON("MFA challenge started",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return mapper.ToMFAChallengeOutput(challenge), nil
+	}
+
+	tokens, err := c.authTokenService.IssueTokens(ctx, user, "")
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing auth tokens",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("Magic link login completed",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+	return mapper.ToLoginAuthUserOutput(tokens), nil
+}
+
+func (c *ConsumeMagicLink) redeem(ctx context.Context, input dto.ConsumeMagicLinkInput) (*entity.User, *errors.Error) {
+	expires, parseErr := strconv.ParseInt(input.Expires, 10, 64)
+	if input.Token == "" || input.Nonce == "" || parseErr != nil {
+		return nil, errors.ErrorInvalidMagicLink()
+	}
+	tokenHash := c.opaqueToken.Hash(input.Token)
+	subject := magicLinkSignedSubject(tokenHash, c.opaqueToken.Hash(input.Nonce))
+	if !c.signer.Verify(subject, time.Unix(expires, 0), input.Signature) {
+		return nil, errors.ErrorInvalidMagicLink()
+	}
+
+	now := time.Now().UTC()
+	token, err := c.oneTimeTokenRepository.ConsumeOneTimeToken(ctx, entity.TokenPurposeMagicLink, tokenHash, now)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		return nil, errors.ErrorInvalidMagicLink()
+	}
+
+	user, err := c.userRepository.FindUserByID(ctx, token.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errors.ErrorInvalidMagicLink()
+	}
+
+	if !user.IsEmailVerified() {
+		if _, err := c.userRepository.MarkEmailVerified(ctx, user.ID(), user.Email(), now); err != nil {
+			return nil, err
+		}
+		user.WithEmailVerifiedAt(&now)
+	}
+	return user, nil
+}
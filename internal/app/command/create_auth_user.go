@@ -14,29 +14,32 @@ import (
 )
 
 type CreateAuthUser struct {
-	userRepository port.UserRepository
-	userService    service.UserService
-	bcrypt         adapter.Bcrypt
-	log            adapter.Logger
-	tracer         adapter.Tracer
-	utils          adapter.Utils
+	userRepository    port.UserRepository
+	userService       service.UserService
+	emailVerification service.EmailVerificationService
+	bcrypt            adapter.Bcrypt
+	log               adapter.Logger
+	tracer            adapter.Tracer
+	utils             adapter.Utils
 }
 
 func NewCreateAuthUser(
 	userRepository port.UserRepository,
 	userService service.UserService,
+	emailVerification service.EmailVerificationService,
 	bcrypt adapter.Bcrypt,
 	log adapter.Logger,
 	tracer adapter.Tracer,
 	utils adapter.Utils,
 ) *CreateAuthUser {
 	return &CreateAuthUser{
-		userRepository: userRepository,
-		userService:    userService,
-		bcrypt:         bcrypt,
-		log:            log,
-		tracer:         tracer,
-		utils:          utils,
+		userRepository:    userRepository,
+		userService:       userService,
+		emailVerification: emailVerification,
+		bcrypt:            bcrypt,
+		log:               log,
+		tracer:            tracer,
+		utils:             utils,
 	}
 }
 
@@ -105,6 +108,11 @@ func (c *CreateAuthUser) Execute(ctx context.Context, input dto.CreateAuthUserIn
 		return nil, err
 	}
 
+	// a lost e-mail is not worth failing the signup: the user can ask for a resend
+	if err := c.emailVerification.SendVerification(ctx, createUser); err != nil {
+		span.RecordError(err)
+	}
+
 	return mapper.ToCreateAuthUserOutput(createUser), nil
 
 }
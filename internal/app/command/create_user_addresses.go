@@ -0,0 +1,172 @@
+package command
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+const (
+	MaxAddressesPerUser = 10
+
+	errAddressesRequired     = "at least one address is required"
+	errDuplicatedDefaultShip = "only one address can be the default shipping address"
+	errDuplicatedDefaultBill = "only one address can be the default billing address"
+)
+
+type CreateUserAddresses struct {
+	unitOfWork        adapter.UnitOfWork
+	addressRepository port.AddressRepository
+	userService       service.UserService
+	log               adapter.Logger
+	tracer            adapter.Tracer
+	utils             adapter.Utils
+}
+
+func NewCreateUserAddresses(
+	unitOfWork adapter.UnitOfWork,
+	addressRepository port.AddressRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+	utils adapter.Utils,
+) *CreateUserAddresses {
+	return &CreateUserAddresses{
+		unitOfWork:        unitOfWork,
+		addressRepository: addressRepository,
+		userService:       userService,
+		log:               log,
+		tracer:            tracer,
+		utils:             utils,
+	}
+}
+
+// Execute creates every address of the request or none of them. Validation
+// errors are keyed by request index (addresses[n].field).
+func (c *CreateUserAddresses) Execute(ctx context.Context, input dto.CreateUserAddressesInput) (*dto.UserAddressesOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "CreateUserAddresses.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	c.log.InfoJSON("Creating user addresses",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+			"count":     len(input.Addresses),
+		})
+
+	addresses, isValid := c.buildAddresses(user.ID(), input.Addresses)
+	if isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "address")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Address validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return nil, validationErr
+	}
+
+	created := make([]entity.Address, 0, len(addresses))
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		// concurrent requests would otherwise both count below the limit
+		if err := c.addressRepository.LockUserAddresses(ctx, user.ID()); err != nil {
+			return err
+		}
+		existing, err := c.addressRepository.ListAddressesByUserID(ctx, user.ID())
+		if err != nil {
+			return err
+		}
+		if len(existing)+len(addresses) > MaxAddressesPerUser {
+			return errors.ErrorAddressLimitReached(MaxAddressesPerUser)
+		}
+
+		// the first address a user registers becomes both defaults
+		if len(existing) == 0 {
+			if !hasDefault(addresses, (*entity.Address).IsDefaultShipping) {
+				addresses[0].AssignDefaultShipping(true)
+			}
+			if !hasDefault(addresses, (*entity.Address).IsDefaultBilling) {
+				addresses[0].AssignDefaultBilling(true)
+			}
+		}
+
+		if err := c.addressRepository.ClearDefaultAddresses(ctx, user.ID(),
+			hasDefault(addresses, (*entity.Address).IsDefaultShipping),
+			hasDefault(addresses, (*entity.Address).IsDefaultBilling)); err != nil {
+			return err
+		}
+
+		for _, address := range addresses {
+			saved, err := c.addressRepository.CreateAddress(ctx, address)
+			if err != nil {
+				return err
+			}
+			created = append(created, *saved)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error creating user addresses",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	return mapper.ToUserAddressesOutput(created), nil
+}
+
+func (c *CreateUserAddresses) buildAddresses(userID int64, inputs []dto.AddressInput) ([]entity.Address, *validator.Validator) {
+	isValid := validator.New()
+	isValid.Assert(len(inputs) > 0, "addresses", errAddressesRequired)
+
+	addresses := make([]entity.Address, 0, len(inputs))
+	shipping, billing := 0, 0
+	for i, input := range inputs {
+		prefix := fmt.Sprintf("addresses[%d].", i)
+
+		address := mapper.ToAddress(input)
+		address.AssignPublicID(c.utils.UUID())
+		address.AssignUserID(userID)
+		isValid.MergeWithPrefix(prefix, address.Validate())
+
+		if input.IsDefaultShipping {
+			shipping++
+			isValid.Assert(shipping == 1, prefix+"is_default_shipping", errDuplicatedDefaultShip)
+		}
+		if input.IsDefaultBilling {
+			billing++
+			isValid.Assert(billing == 1, prefix+"is_default_billing", errDuplicatedDefaultBill)
+		}
+
+		addresses = append(addresses, address)
+	}
+
+	return addresses, isValid
+}
+
+func hasDefault(addresses []entity.Address, isDefault func(*entity.Address) bool) bool {
+	for i := range addresses {
+		if isDefault(&addresses[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type DeleteCurrentUser struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	refreshTokenRepository port.RefreshTokenRepository
+	userService            service.UserService
+	denylist               adapter.TokenDenylist
+	gracePeriod            time.Duration
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewDeleteCurrentUser(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	userService service.UserService,
+	denylist adapter.TokenDenylist,
+	gracePeriod time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *DeleteCurrentUser {
+	return &DeleteCurrentUser{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userService:            userService,
+		denylist:               denylist,
+		gracePeriod:            gracePeriod,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute soft deletes the authenticated user and ends all of their sessions.
+// The account can be restored until the grace period ends, then it is purged.
+func (c *DeleteCurrentUser) Execute(ctx context.Context) (*dto.DeleteCurrentUserOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "DeleteCurrentUser.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	c.log.InfoJSON("Deleting current user",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	deletedAt := time.Now().UTC()
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		deleted, err := c.userRepository.SoftDeleteUser(ctx, user.ID(), deletedAt)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return errors.ErrorUserNotFound(user.PublicID())
+		}
+		return c.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.PublicID(), "")
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error deleting current user",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	// the account is already deleted; a denylist failure only delays access
+	// token invalidation until expiry, as deleted users no longer resolve
+	if err := c.denylist.RevokeUserTokens(ctx, user.PublicID(), ""); err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Error revoking access tokens of deleted user",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+	}
+
+	return mapper.ToDeleteCurrentUserOutput(deletedAt, c.gracePeriod), nil
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type DeletePasskey struct {
+	credentialRepository port.WebAuthnCredentialRepository
+	userService          service.UserService
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewDeletePasskey(
+	credentialRepository port.WebAuthnCredentialRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *DeletePasskey {
+	return &DeletePasskey{
+		credentialRepository: credentialRepository,
+		userService:          userService,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+func (c *DeletePasskey) Execute(ctx context.Context, input dto.DeletePasskeyInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "DeletePasskey.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.log.InfoJSON("Deleting passkey",
+		map[string]any{
+			"trace_id":      traceID,
+			"public_id":     user.PublicID(),
+			"credential_id": input.CredentialID,
+		})
+
+	deleted, err := c.credentialRepository.DeleteCredential(ctx, user.ID(), input.CredentialID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error deleting passkey",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+	if !deleted {
+		notFoundErr := errors.ErrorPasskeyNotFound(input.CredentialID)
+		span.RecordError(notFoundErr)
+		return notFoundErr
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type DeleteUserAddress struct {
+	addressRepository port.AddressRepository
+	userService       service.UserService
+	log               adapter.Logger
+	tracer            adapter.Tracer
+}
+
+func NewDeleteUserAddress(
+	addressRepository port.AddressRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *DeleteUserAddress {
+	return &DeleteUserAddress{
+		addressRepository: addressRepository,
+		userService:       userService,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+func (c *DeleteUserAddress) Execute(ctx context.Context, input dto.DeleteUserAddressInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "DeleteUserAddress.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.log.InfoJSON("Deleting user address",
+		map[string]any{
+			"trace_id":   traceID,
+			"public_id":  user.PublicID(),
+			"address_id": input.AddressID,
+		})
+
+	deleted, err := c.addressRepository.DeleteAddress(ctx, user.ID(), input.AddressID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error deleting user address",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+	if !deleted {
+		notFoundErr := errors.ErrorAddressNotFound(input.AddressID)
+		span.RecordError(notFoundErr)
+		return notFoundErr
+	}
+
+	return nil
+}
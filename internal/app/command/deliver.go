@@ -0,0 +1,27 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+// deliveryTimeout bounds the background work of one delivery, so a stalled
+// relay or database cannot pile up goroutines.
+const deliveryTimeout = time.Minute
+
+// deliverInBackground runs send detached from the request, within
+// deliveryTimeout, so the response does not wait for the relay. Failures are
+// only logged, under failureMessage with fields.
+func deliverInBackground(ctx context.Context, log adapter.Logger, failureMessage string, fields map[string]any, send func(ctx context.Context) *errors.Error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deliveryTimeout)
+	go func() {
+		defer cancel()
+		if err := send(ctx); err != nil {
+			fields["error"] = err.Error()
+			log.ErrorJSON(failureMessage, fields)
+		}
+	}()
+}
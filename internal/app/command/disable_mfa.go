@@ -0,0 +1,101 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type DisableMFA struct {
+	unitOfWork             adapter.UnitOfWork
+	userMFARepository      port.UserMFARepository
+	recoveryCodeRepository port.MFARecoveryCodeRepository
+	userService            service.UserService
+	mfaService             service.MFAService
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewDisableMFA(
+	unitOfWork adapter.UnitOfWork,
+	userMFARepository port.UserMFARepository,
+	recoveryCodeRepository port.MFARecoveryCodeRepository,
+	userService service.UserService,
+	mfaService service.MFAService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *DisableMFA {
+	return &DisableMFA{
+		unitOfWork:             unitOfWork,
+		userMFARepository:      userMFARepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		userService:            userService,
+		mfaService:             mfaService,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute removes the MFA enrollment and the recovery codes of the
+// authenticated user. input.Code must be a current second factor.
+func (c *DisableMFA) Execute(ctx context.Context, input dto.MFACodeInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "DisableMFA.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	if err := verifyMFACode(ctx, c.mfaService, user.ID(), input.Code); err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("MFA code rejected",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	if err := removeMFA(ctx, c.unitOfWork, c.userMFARepository, c.recoveryCodeRepository, user.ID()); err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error disabling MFA",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	c.log.InfoJSON("MFA disabled",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	return nil
+}
+
+// removeMFA deletes the enrollment and the recovery codes of the user together.
+func removeMFA(
+	ctx context.Context,
+	unitOfWork adapter.UnitOfWork,
+	userMFARepository port.UserMFARepository,
+	recoveryCodeRepository port.MFARecoveryCodeRepository,
+	userID int64,
+) *errors.Error {
+	return unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		if err := recoveryCodeRepository.DeleteRecoveryCodes(ctx, userID); err != nil {
+			return err
+		}
+		return userMFARepository.DeleteUserMFA(ctx, userID)
+	})
+}
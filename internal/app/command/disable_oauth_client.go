@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type DisableOAuthClient struct {
+	unitOfWork       adapter.UnitOfWork
+	clientRepository port.OAuthClientRepository
+	denylist         adapter.TokenDenylist
+	log              adapter.Logger
+	tracer           adapter.Tracer
+}
+
+func NewDisableOAuthClient(
+	unitOfWork adapter.UnitOfWork,
+	clientRepository port.OAuthClientRepository,
+	denylist adapter.TokenDenylist,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *DisableOAuthClient {
+	return &DisableOAuthClient{
+		unitOfWork:       unitOfWork,
+		clientRepository: clientRepository,
+		denylist:         denylist,
+		log:              log,
+		tracer:           tracer,
+	}
+}
+
+// Execute switches a client off: it can no longer authenticate, and tokens it
+// issued for itself are revoked.
+func (c *DisableOAuthClient) Execute(ctx context.Context, input dto.DisableOAuthClientInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "DisableOAuthClient.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	err := c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		disabled, err := c.clientRepository.DisableClient(ctx, input.ClientID, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if !disabled {
+			return errors.ErrorOAuthClientNotFound(input.ClientID)
+		}
+		return c.denylist.RevokeUserTokens(ctx, input.ClientID, "")
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error disabling OAuth client",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": input.ClientID,
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	c.log.InfoJSON("OAuth client disabled",
+		map[string]any{
+			"trace_id":  traceID,
+			"client_id": input.ClientID,
+		})
+	return nil
+}
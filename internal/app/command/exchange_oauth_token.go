@@ -0,0 +1,228 @@
+package command
+
+import (
+	"context"
+	"crypto/subtle"
+	"slices"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type ExchangeOAuthToken struct {
+	clientRepository            port.OAuthClientRepository
+	authorizationCodeRepository port.AuthorizationCodeRepository
+	userRepository              port.UserRepository
+	jwt                         adapter.JWT
+	serviceJWT                  adapter.JWT
+	idTokenSigner               adapter.IDTokenSigner
+	opaqueToken                 adapter.OpaqueToken
+	log                         adapter.Logger
+	tracer                      adapter.Tracer
+}
+
+// NewExchangeOAuthToken takes two signers: jwt for tokens acting for a user and
+// serviceJWT, with a shorter lifetime, for client_credentials tokens.
+// idTokenSigner signs the id_token of codes granted with the openid scope.
+func NewExchangeOAuthToken(
+	clientRepository port.OAuthClientRepository,
+	authorizationCodeRepository port.AuthorizationCodeRepository,
+	userRepository port.UserRepository,
+	jwt adapter.JWT,
+	serviceJWT adapter.JWT,
+	idTokenSigner adapter.IDTokenSigner,
+	opaqueToken adapter.OpaqueToken,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ExchangeOAuthToken {
+	return &ExchangeOAuthToken{
+		clientRepository:            clientRepository,
+		authorizationCodeRepository: authorizationCodeRepository,
+		userRepository:              userRepository,
+		jwt:                         jwt,
+		serviceJWT:                  serviceJWT,
+		idTokenSigner:               idTokenSigner,
+		opaqueToken:                 opaqueToken,
+		log:                         log,
+		tracer:                      tracer,
+	}
+}
+
+// Execute serves the token endpoint: an authorization code is redeemed for a
+// token acting for the user who granted it, while client_credentials issues a
+// token whose subject is the client itself.
+func (c *ExchangeOAuthToken) Execute(ctx context.Context, input dto.OAuthTokenInput) (*dto.OAuthTokenOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "ExchangeOAuthToken.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	now := time.Now().UTC()
+	access, idToken, err := c.issue(ctx, input, now)
+	if err != nil {
+		span.RecordError(err)
+		fields := map[string]any{
+			"trace_id":   traceID,
+			"client_id":  input.ClientID,
+			"grant_type": input.GrantType,
+			"error":      err.Error(),
+		}
+		if err.Code == errors.ErrInternal {
+			c.log.ErrorJSON("Error issuing OAuth access token", fields)
+		} else {
+			c.log.WarnJSON("OAuth token request rejected", fields)
+		}
+		return nil, err
+	}
+
+	c.log.InfoJSON("OAuth access token issued",
+		map[string]any{
+			"trace_id":   traceID,
+			"client_id":  input.ClientID,
+			"grant_type": input.GrantType,
+			"subject":    access.PublicID,
+		})
+	return mapper.ToOAuthTokenOutput(access, idToken, now), nil
+}
+
+// issue returns the access token and, for OpenID Connect requests, the
+// id_token.
+func (c *ExchangeOAuthToken) issue(ctx context.Context, input dto.OAuthTokenInput, now time.Time) (*vo.TokenClaims, string, *errors.Error) {
+	if input.GrantType != entity.GrantTypeAuthorizationCode && input.GrantType != entity.GrantTypeClientCredentials {
+		return nil, "", errors.ErrorOAuthUnsupportedGrantType(input.GrantType)
+	}
+
+	client, err := c.authenticateClient(ctx, input.ClientID, input.ClientSecret)
+	if err != nil {
+		return nil, "", err
+	}
+	if !client.AllowsGrantType(input.GrantType) {
+		return nil, "", errors.ErrorOAuthUnauthorizedClient(input.GrantType)
+	}
+
+	if input.GrantType == entity.GrantTypeClientCredentials {
+		access, err := c.issueServiceToken(client, input)
+		return access, "", err
+	}
+	return c.redeemAuthorizationCode(ctx, client, input, now)
+}
+
+// redeemAuthorizationCode consumes the code before checking it, so a code
+// presented with a wrong verifier cannot be tried again.
+func (c *ExchangeOAuthToken) redeemAuthorizationCode(
+	ctx context.Context,
+	client *entity.OAuthClient,
+	input dto.OAuthTokenInput,
+	now time.Time,
+) (*vo.TokenClaims, string, *errors.Error) {
+	if input.Code == "" {
+		return nil, "", errors.ErrorOAuthInvalidRequest("code is required.")
+	}
+	if !vo.IsCodeVerifier(input.CodeVerifier) {
+		return nil, "", errors.ErrorOAuthInvalidRequest("A valid PKCE code_verifier is required.")
+	}
+
+	code, err := c.authorizationCodeRepository.ConsumeAuthorizationCode(ctx, c.opaqueToken.Hash(input.Code), now)
+	if err != nil {
+		return nil, "", err
+	}
+	switch {
+	case code == nil:
+		return nil, "", errors.ErrorOAuthInvalidGrant("authorization code is unknown, expired or used")
+	case code.ClientID() != client.ID():
+		return nil, "", errors.ErrorOAuthInvalidGrant("authorization code was issued to another client")
+	case code.RedirectURI() != input.RedirectURI:
+		return nil, "", errors.ErrorOAuthInvalidGrant("redirect_uri does not match the authorization request")
+	case subtle.ConstantTimeCompare([]byte(vo.PKCEChallenge(input.CodeVerifier)), []byte(code.CodeChallenge())) != 1:
+		return nil, "", errors.ErrorOAuthInvalidGrant("code_verifier does not match the code challenge")
+	}
+
+	user, err := c.userRepository.FindUserByID(ctx, code.UserID())
+	if err != nil {
+		return nil, "", err
+	}
+	if user == nil {
+		return nil, "", errors.ErrorOAuthInvalidGrant("user no longer exists")
+	}
+
+	access, err := c.jwt.Generate(vo.TokenClaims{
+		PublicID: user.PublicID(),
+		Role:     user.Role(),
+		Email:    user.Email(),
+		Scopes:   code.Scopes(),
+		ClientID: client.ClientID(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if !slices.Contains(code.Scopes(), entity.ScopeOpenID) {
+		return access, "", nil
+	}
+
+	idToken, err := c.idTokenSigner.Sign(vo.IDTokenClaims{
+		UserInfo: mapper.ToUserInfo(user, code.Scopes()),
+		ClientID: client.ClientID(),
+		Nonce:    code.Nonce(),
+		AuthTime: code.AuthTime(),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return access, idToken, nil
+}
+
+// issueServiceToken grants the requested scopes and audiences, or all those
+// registered for the client when none are requested.
+func (c *ExchangeOAuthToken) issueServiceToken(client *entity.OAuthClient, input dto.OAuthTokenInput) (*vo.TokenClaims, *errors.Error) {
+	scopes := parseScopes(input.Scope)
+	if len(scopes) == 0 {
+		scopes = client.Scopes()
+	}
+	for _, scope := range scopes {
+		if !client.AllowsScopes([]string{scope}) {
+			return nil, errors.ErrorOAuthInvalidScope(scope)
+		}
+	}
+
+	audiences := parseScopes(input.Audience)
+	if len(audiences) == 0 {
+		audiences = client.Audiences()
+	}
+	for _, audience := range audiences {
+		if !client.AllowsAudiences([]string{audience}) {
+			return nil, errors.ErrorOAuthInvalidTarget(audience)
+		}
+	}
+
+	return c.serviceJWT.Generate(vo.TokenClaims{
+		PublicID: client.ClientID(),
+		ClientID: client.ClientID(),
+		Scopes:   scopes,
+		Audience: audiences,
+	})
+}
+
+// authenticateClient resolves the client of a token request. Confidential
+// clients must present their secret; public clients are bound by PKCE alone.
+func (c *ExchangeOAuthToken) authenticateClient(ctx context.Context, clientID, secret string) (*entity.OAuthClient, *errors.Error) {
+	if clientID == "" {
+		return nil, errors.ErrorOAuthInvalidClient()
+	}
+	client, err := c.clientRepository.FindClientByClientID(ctx, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil || client.IsDisabled() {
+		return nil, errors.ErrorOAuthInvalidClient()
+	}
+	if client.IsConfidential() &&
+		subtle.ConstantTimeCompare([]byte(c.opaqueToken.Hash(secret)), []byte(client.SecretHash())) != 1 {
+		return nil, errors.ErrorOAuthInvalidClient()
+	}
+	return client, nil
+}
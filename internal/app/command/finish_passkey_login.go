@@ -0,0 +1,167 @@
+package command
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type FinishPasskeyLogin struct {
+	userRepository       port.UserRepository
+	credentialRepository port.WebAuthnCredentialRepository
+	authTokenService     service.AuthTokenService
+	webAuthn             adapter.WebAuthn
+	cache                adapter.Cache
+	opaqueToken          adapter.OpaqueToken
+	requireVerifiedEmail bool
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewFinishPasskeyLogin(
+	userRepository port.UserRepository,
+	credentialRepository port.WebAuthnCredentialRepository,
+	authTokenService service.AuthTokenService,
+	webAuthn adapter.WebAuthn,
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	requireVerifiedEmail bool,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *FinishPasskeyLogin {
+	return &FinishPasskeyLogin{
+		userRepository:       userRepository,
+		credentialRepository: credentialRepository,
+		authTokenService:     authTokenService,
+		webAuthn:             webAuthn,
+		cache:                cache,
+		opaqueToken:          opaqueToken,
+		requireVerifiedEmail: requireVerifiedEmail,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+// Execute signs the user in with a passkey assertion and issues the same
+// tokens as a password login. No TOTP challenge follows: the authenticator
+// already verified the user, which makes the passkey a second factor on its
+// own.
+func (c *FinishPasskeyLogin) Execute(ctx context.Context, input dto.FinishPasskeyLoginInput) (*dto.LoginAuthUserOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "FinishPasskeyLogin.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.authenticate(ctx, input.Credential)
+	if err != nil {
+		span.RecordError(err)
+		fields := map[string]any{
+			"trace_id":      traceID,
+			"credential_id": input.Credential.ID,
+			"error":         err.Error(),
+		}
+		if err.Code == errors.ErrInternal {
+			c.log.ErrorJSON("Error verifying passkey login", fields)
+		} else {
+			c.log.WarnJSON("Passkey login rejected", fields)
+		}
+		return nil, err
+	}
+
+	if c.requireVerifiedEmail && !user.IsEmailVerified() {
+		verifyErr := errors.ErrorEmailNotVerified()
+		span.RecordError(verifyErr)
+		c.log.WarnJSON("Login of unverified e-mail rejected",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return nil, verifyErr
+	}
+
+	tokens, err := c.authTokenService.IssueTokens(ctx, user, "")
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing auth tokens",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("Passkey login completed",
+		map[string]any{
+			"trace_id":      traceID,
+			"public_id":     user.PublicID(),
+			"credential_id": input.Credential.ID,
+		})
+	return mapper.ToLoginAuthUserOutput(tokens), nil
+}
+
+// authenticate resolves the owner of the passkey and verifies the assertion
+// against its public key. A signature counter that did not move forward
+// means the authenticator may have been cloned, and the sign-in is refused.
+func (c *FinishPasskeyLogin) authenticate(ctx context.Context, credential dto.PasskeyAssertionCredential) (*entity.User, *errors.Error) {
+	clientDataJSON, ok := decodeWebAuthnField(credential.Response.ClientDataJSON)
+	if !ok || credential.Type != passkeyCredentialType {
+		return nil, errors.ErrorInvalidPasskeyAssertion("malformed credential")
+	}
+	authenticatorData, okData := decodeWebAuthnField(credential.Response.AuthenticatorData)
+	signature, okSignature := decodeWebAuthnField(credential.Response.Signature)
+	userHandle, okHandle := decodeWebAuthnField(credential.Response.UserHandle)
+	if !okData || !okSignature || !okHandle {
+		return nil, errors.ErrorInvalidPasskeyAssertion("malformed credential")
+	}
+
+	challenge, _, err := consumePasskeyCeremony(ctx, c.cache, c.opaqueToken, c.webAuthn,
+		clientDataJSON, vo.WebAuthnCeremonyLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := c.credentialRepository.FindCredentialByCredentialID(ctx, credential.ID)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil {
+		return nil, errors.ErrorUnknownPasskey()
+	}
+	user, err := c.userRepository.FindUserByID(ctx, stored.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || subtle.ConstantTimeCompare(userHandle, []byte(user.PublicID())) != 1 {
+		return nil, errors.ErrorUnknownPasskey()
+	}
+
+	result, err := c.webAuthn.VerifyAssertion(challenge, vo.WebAuthnAssertion{
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authenticatorData,
+		Signature:         signature,
+	}, stored.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	if stored.IsCloneSuspected(result.SignCount) {
+		return nil, errors.ErrorPasskeyCloneSuspected()
+	}
+
+	recorded, err := c.credentialRepository.RecordCredentialUse(ctx, stored.ID(), result.SignCount, result.BackedUp, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if !recorded {
+		return nil, errors.ErrorPasskeyCloneSuspected()
+	}
+	return user, nil
+}
@@ -0,0 +1,152 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type FinishPasskeyRegistration struct {
+	credentialRepository port.WebAuthnCredentialRepository
+	userService          service.UserService
+	webAuthn             adapter.WebAuthn
+	cache                adapter.Cache
+	opaqueToken          adapter.OpaqueToken
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewFinishPasskeyRegistration(
+	credentialRepository port.WebAuthnCredentialRepository,
+	userService service.UserService,
+	webAuthn adapter.WebAuthn,
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *FinishPasskeyRegistration {
+	return &FinishPasskeyRegistration{
+		credentialRepository: credentialRepository,
+		userService:          userService,
+		webAuthn:             webAuthn,
+		cache:                cache,
+		opaqueToken:          opaqueToken,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+func (c *FinishPasskeyRegistration) Execute(ctx context.Context, input dto.FinishPasskeyRegistrationInput) (*dto.PasskeyOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "FinishPasskeyRegistration.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		name = defaultPasskeyName
+	}
+	if utf8.RuneCountInString(name) > maxPasskeyNameLength {
+		nameErr := errors.ErrorInvalidPasskeyName(maxPasskeyNameLength)
+		span.RecordError(nameErr)
+		return nil, nameErr
+	}
+
+	data, err := c.verify(ctx, user, input.Credential)
+	if err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Passkey registration rejected",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	credential, err := c.credentialRepository.CreateCredential(ctx, entity.BuilderWebAuthnCredential().
+		WithUserID(user.ID()).
+		WithCredentialID(encodeWebAuthnField(data.CredentialID)).
+		WithPublicKey(data.PublicKey).
+		WithAlgorithm(data.Algorithm).
+		WithSignCount(data.SignCount).
+		WithTransports(knownPasskeyTransports(input.Credential.Response.Transports)).
+		WithAAGUID(data.AAGUID).
+		WithBackupEligible(data.BackupEligible).
+		WithBackedUp(data.BackedUp).
+		WithName(name).
+		WithCreatedAt(time.Now().UTC()).
+		Build())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error saving passkey",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("Passkey registered",
+		map[string]any{
+			"trace_id":      traceID,
+			"public_id":     user.PublicID(),
+			"credential_id": credential.CredentialID(),
+		})
+	output := mapper.ToPasskeyOutput(credential)
+	return &output, nil
+}
+
+// verify checks that the attestation answers a registration the same user
+// started and returns the credential it attests.
+func (c *FinishPasskeyRegistration) verify(
+	ctx context.Context,
+	user *entity.User,
+	credential dto.PasskeyAttestationCredential,
+) (*vo.WebAuthnCredentialData, *errors.Error) {
+	clientDataJSON, ok := decodeWebAuthnField(credential.Response.ClientDataJSON)
+	if !ok || credential.Type != passkeyCredentialType {
+		return nil, errors.ErrorInvalidPasskeyAttestation("malformed credential")
+	}
+	attestationObject, ok := decodeWebAuthnField(credential.Response.AttestationObject)
+	if !ok {
+		return nil, errors.ErrorInvalidPasskeyAttestation("malformed credential")
+	}
+
+	challenge, session, err := consumePasskeyCeremony(ctx, c.cache, c.opaqueToken, c.webAuthn,
+		clientDataJSON, vo.WebAuthnCeremonyRegistration)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != user.ID() {
+		return nil, errors.ErrorInvalidPasskeyChallenge()
+	}
+
+	data, err := c.webAuthn.VerifyRegistration(challenge, vo.WebAuthnAttestation{
+		ClientDataJSON:    clientDataJSON,
+		AttestationObject: attestationObject,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if credential.ID != "" && credential.ID != encodeWebAuthnField(data.CredentialID) {
+		return nil, errors.ErrorInvalidPasskeyAttestation("credential id does not match the attested one")
+	}
+	return data, nil
+}
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const passwordResetSubject = "Reset your password"
+
+type ForgotPassword struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	oneTimeTokenRepository port.OneTimeTokenRepository
+	opaqueToken            adapter.OpaqueToken
+	mailer                 adapter.Mailer
+	resetURL               string
+	resetTTL               time.Duration
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewForgotPassword(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	oneTimeTokenRepository port.OneTimeTokenRepository,
+	opaqueToken adapter.OpaqueToken,
+	mailer adapter.Mailer,
+	resetURL string,
+	resetTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ForgotPassword {
+	return &ForgotPassword{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		opaqueToken:            opaqueToken,
+		mailer:                 mailer,
+		resetURL:               resetURL,
+		resetTTL:               resetTTL,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute e-mails a reset link to the account of input.Email. The outcome is
+// the same whether or not the account exists, so the endpoint cannot be used
+// to discover registered e-mails; the token is issued and e-mailed in the
+// background and failures are only logged.
+func (c *ForgotPassword) Execute(ctx context.Context, input dto.ForgotPasswordInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "ForgotPassword.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userRepository.FindUserByEmail(ctx, input.Email)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by email",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+	if user == nil {
+		c.log.InfoJSON("Password reset requested for unknown e-mail",
+			map[string]any{
+				"trace_id": traceID,
+			})
+		return nil
+	}
+
+	c.log.InfoJSON("Issuing password reset token",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	// issuing the token happens in the background as well, so a known
+	// account is answered as quickly as an unknown one
+	deliverInBackground(ctx, c.log, "Error sending password reset e-mail",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		},
+		func(ctx context.Context) *errors.Error {
+			token, _, err := issuePasswordResetToken(ctx, c.unitOfWork, c.oneTimeTokenRepository, c.opaqueToken, user.ID(), c.resetTTL)
+			if err != nil {
+				return err
+			}
+			return c.mailer.Send(ctx, c.resetMessage(user.Email(), token))
+		})
+
+	return nil
+}
+
+func (c *ForgotPassword) resetMessage(email, token string) vo.MailMessage {
+	link := c.resetURL + "?token=" + url.QueryEscape(token)
+	return vo.MailMessage{
+		To:      email,
+		Subject: passwordResetSubject,
+		Body: fmt.Sprintf("We received a request to reset your password.\n\n"+
+			"Open the link below within %s to choose a new one:\n%s\n\n"+
+			"If you did not ask for this, you can ignore this e-mail.", c.resetTTL, link),
+	}
+}
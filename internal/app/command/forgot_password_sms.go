@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type ForgotPasswordSms struct {
+	userRepository port.UserRepository
+	otpStore       adapter.OTPStore
+	smsSender      adapter.SmsSender
+	otpTTL         time.Duration
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewForgotPasswordSms(
+	userRepository port.UserRepository,
+	otpStore adapter.OTPStore,
+	smsSender adapter.SmsSender,
+	otpTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ForgotPasswordSms {
+	return &ForgotPasswordSms{
+		userRepository: userRepository,
+		otpStore:       otpStore,
+		smsSender:      smsSender,
+		otpTTL:         otpTTL,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+// Execute texts a reset code to input.Phone when it is the verified phone of
+// an account. As with ForgotPassword, the outcome does not reveal whether such
+// an account exists, the code is issued and sent in the background and
+// failures are only logged.
+func (c *ForgotPasswordSms) Execute(ctx context.Context, input dto.ForgotPasswordSmsInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "ForgotPasswordSms.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	phone := vo.NewPhone(input.Phone)
+	if isValid := phone.Validate(); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "phone")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Phone validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return validationErr
+	}
+
+	user, err := c.userRepository.FindUserByVerifiedPhone(ctx, phone.String())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by phone",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+	if user == nil {
+		c.log.InfoJSON("Password reset requested for unknown phone",
+			map[string]any{
+				"trace_id": traceID,
+			})
+		return nil
+	}
+
+	c.log.InfoJSON("Issuing password reset code",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	// the code is issued in the background too, so a known phone is answered
+	// as quickly as an unknown one
+	deliverInBackground(ctx, c.log, "Error sending password reset code",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		},
+		func(ctx context.Context) *errors.Error {
+			code, err := c.otpStore.Issue(ctx, passwordResetCodeKey(phone.String()))
+			if err != nil {
+				return err
+			}
+			message := fmt.Sprintf("Your password reset code is %s. It expires in %s.", code, c.otpTTL)
+			return c.smsSender.Send(ctx, phone.String(), message)
+		})
+
+	return nil
+}
+
+func passwordResetCodeKey(phone string) string {
+	return "password_reset:" + phone
+}
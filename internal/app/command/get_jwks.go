@@ -0,0 +1,27 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type GetJWKS struct {
+	jwt    adapter.JWT
+	tracer adapter.Tracer
+}
+
+func NewGetJWKS(jwt adapter.JWT, tracer adapter.Tracer) *GetJWKS {
+	return &GetJWKS{
+		jwt:    jwt,
+		tracer: tracer,
+	}
+}
+
+func (c *GetJWKS) Execute(ctx context.Context) vo.JSONWebKeySet {
+	_, span := c.tracer.Start(ctx, "GetJWKS.Execute")
+	defer span.End()
+
+	return c.jwt.JWKS()
+}
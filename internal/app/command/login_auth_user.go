@@ -0,0 +1,147 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+type LoginAuthUser struct {
+	userRepository       port.UserRepository
+	authTokenService     service.AuthTokenService
+	mfaService           service.MFAService
+	loginThrottle        adapter.LoginThrottle
+	bcrypt               adapter.Bcrypt
+	requireVerifiedEmail bool
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewLoginAuthUser(
+	userRepository port.UserRepository,
+	authTokenService service.AuthTokenService,
+	mfaService service.MFAService,
+	loginThrottle adapter.LoginThrottle,
+	bcrypt adapter.Bcrypt,
+	requireVerifiedEmail bool,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *LoginAuthUser {
+	return &LoginAuthUser{
+		userRepository:       userRepository,
+		authTokenService:     authTokenService,
+		mfaService:           mfaService,
+		loginThrottle:        loginThrottle,
+		bcrypt:               bcrypt,
+		requireVerifiedEmail: requireVerifiedEmail,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+func (c *LoginAuthUser) Execute(ctx context.Context, input dto.LoginAuthUserInput) (*dto.LoginAuthUserOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "LoginAuthUser.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+	c.log.InfoJSON("Authenticating user",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     logger.RedactStruct[dto.LoginAuthUserInput](input, "password"),
+		})
+
+	if err := checkLoginThrottle(ctx, c.loginThrottle, input.Email); err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Login throttled",
+			map[string]any{
+				"trace_id": traceID,
+				"email":    input.Email,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	user, err := c.userRepository.FindUserByEmail(ctx, input.Email)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by email",
+			map[string]any{
+				"trace_id": traceID,
+				"email":    input.Email,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	if !checkPassword(c.bcrypt, user, input.Password) {
+		credentialsErr := recordLoginFailure(ctx, c.loginThrottle, input.Email, errors.ErrorInvalidCredentials())
+		span.RecordError(credentialsErr)
+		c.log.WarnJSON("Invalid credentials",
+			map[string]any{
+				"trace_id": traceID,
+				"email":    input.Email,
+			})
+		return nil, credentialsErr
+	}
+
+	if err := c.loginThrottle.RecordSuccess(ctx, input.Email, vo.ClientIPFromContext(ctx)); err != nil {
+		c.log.ErrorJSON("Error clearing failed logins",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+	}
+
+	// checked after the password so the answer does not reveal unverified accounts
+	if c.requireVerifiedEmail && !user.IsEmailVerified() {
+		verifyErr := errors.ErrorEmailNotVerified()
+		span.RecordError(verifyErr)
+		c.log.WarnJSON("Login of unverified e-mail rejected",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return nil, verifyErr
+	}
+
+	challenge, err := c.mfaService.StartChallenge(ctx, user)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error starting MFA challenge",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if challenge != nil {
+		c.log.InfoJSON("MFA challenge started",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return mapper.ToMFAChallengeOutput(challenge), nil
+	}
+
+	tokens, err := c.authTokenService.IssueTokens(ctx, user, "")
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing auth tokens",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	return mapper.ToLoginAuthUserOutput(tokens), nil
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// dummyPasswordHash stands in for accounts without a password, so refusing an
+// unknown e-mail costs the same bcrypt round as refusing a wrong password.
+const dummyPasswordHash = "$2a$05$aO0hbpYv10yW3wSp4GbXcOw62Z7lwey8Y5jBOzCOdZPutJ0wbshcG"
+
+// checkPassword reports whether password is the one of user, taking as long
+// when user is nil or has no password so response times do not reveal which
+// e-mails are registered.
+func checkPassword(bcrypt adapter.Bcrypt, user *entity.User, password string) bool {
+	if user == nil || user.PasswordHash() == "" {
+		bcrypt.CompareHash(password, dummyPasswordHash)
+		return false
+	}
+	return bcrypt.CompareHash(password, user.PasswordHash())
+}
+
+// checkLoginThrottle refuses a password check while the account is locked
+// for the caller by earlier failures.
+func checkLoginThrottle(ctx context.Context, throttle adapter.LoginThrottle, account string) *errors.Error {
+	wait, err := throttle.Check(ctx, account, vo.ClientIPFromContext(ctx))
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		return errors.ErrorAccountLocked(wait)
+	}
+	return nil
+}
+
+// recordLoginFailure counts a wrong password and returns credentialsErr,
+// telling the caller how long to wait before the next attempt.
+func recordLoginFailure(ctx context.Context, throttle adapter.LoginThrottle, account string, credentialsErr *errors.Error) *errors.Error {
+	wait, err := throttle.RecordFailure(ctx, account, vo.ClientIPFromContext(ctx))
+	if err != nil {
+		return err
+	}
+	if wait > 0 {
+		credentialsErr.WithRetryAfter(wait)
+	}
+	return credentialsErr
+}
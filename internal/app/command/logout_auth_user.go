@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type LogoutAuthUser struct {
+	refreshTokenRepository port.RefreshTokenRepository
+	authTokenService       service.AuthTokenService
+	denylist               adapter.TokenDenylist
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewLogoutAuthUser(
+	refreshTokenRepository port.RefreshTokenRepository,
+	authTokenService service.AuthTokenService,
+	denylist adapter.TokenDenylist,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *LogoutAuthUser {
+	return &LogoutAuthUser{
+		refreshTokenRepository: refreshTokenRepository,
+		authTokenService:       authTokenService,
+		denylist:               denylist,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute ends the session bound to the presented access token or, when
+// AllSessions is set, every session of the user.
+func (c *LogoutAuthUser) Execute(ctx context.Context, input dto.LogoutAuthUserInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "LogoutAuthUser.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	claims, err := c.authTokenService.VerifyAccessToken(ctx, input.AccessToken)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.log.InfoJSON("Logging out user",
+		map[string]any{
+			"trace_id":     traceID,
+			"public_id":    claims.PublicID,
+			"session_id":   claims.SessionID,
+			"all_sessions": input.AllSessions,
+		})
+
+	if input.AllSessions {
+		err = c.revokeAllSessions(ctx, claims.PublicID)
+	} else {
+		err = c.revokeSession(ctx, claims.SessionID)
+	}
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error revoking sessions",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": claims.PublicID,
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	if err := c.denylist.RevokeToken(ctx, claims.ID, claims.ExpiresAt); err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error revoking access token",
+			map[string]any{
+				"trace_id": traceID,
+				"jti":      claims.ID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+
+	return nil
+}
+
+func (c *LogoutAuthUser) revokeSession(ctx context.Context, sessionID string) *errors.Error {
+	if sessionID == "" {
+		return nil
+	}
+	if err := c.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, sessionID); err != nil {
+		return err
+	}
+	return c.denylist.RevokeSession(ctx, sessionID)
+}
+
+func (c *LogoutAuthUser) revokeAllSessions(ctx context.Context, publicID string) *errors.Error {
+	if err := c.refreshTokenRepository.RevokeUserRefreshTokens(ctx, publicID, ""); err != nil {
+		return err
+	}
+	return c.denylist.RevokeUserTokens(ctx, publicID, "")
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	// pkceChallengeLength is the length of a base64url encoded SHA-256 digest.
+	pkceChallengeLength = 43
+	// maxNonceLength matches the column the nonce is kept in until the code
+	// is redeemed.
+	maxNonceLength = 255
+)
+
+// validateAuthorizationRequest resolves the client of request and checks its
+// parameters, returning the scopes to grant. The client is returned with the
+// error once the redirect URI is known to be registered: only then may the
+// error be reported to the client through it.
+func validateAuthorizationRequest(
+	ctx context.Context,
+	clientRepository port.OAuthClientRepository,
+	request dto.OAuthAuthorizationRequest,
+) (*entity.OAuthClient, []string, *errors.Error) {
+	if request.ClientID == "" {
+		return nil, nil, errors.ErrorOAuthInvalidClient()
+	}
+	client, err := clientRepository.FindClientByClientID(ctx, request.ClientID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if client == nil || client.IsDisabled() {
+		return nil, nil, errors.ErrorOAuthInvalidClient()
+	}
+	if !client.AllowsRedirectURI(request.RedirectURI) {
+		return nil, nil, errors.ErrorOAuthInvalidRedirectURI()
+	}
+
+	if request.ResponseType != "code" {
+		return client, nil, errors.ErrorOAuthUnsupportedResponseType(request.ResponseType)
+	}
+	if !client.AllowsGrantType(entity.GrantTypeAuthorizationCode) {
+		return client, nil, errors.ErrorOAuthUnauthorizedClient(entity.GrantTypeAuthorizationCode)
+	}
+	if len(request.CodeChallenge) != pkceChallengeLength {
+		return client, nil, errors.ErrorOAuthInvalidRequest("A PKCE code_challenge is required.")
+	}
+	if request.CodeChallengeMethod != vo.PKCEMethodS256 {
+		return client, nil, errors.ErrorOAuthInvalidRequest("code_challenge_method must be S256.")
+	}
+	if len(request.Nonce) > maxNonceLength {
+		return client, nil, errors.ErrorOAuthInvalidRequest("nonce is too long.")
+	}
+
+	scopes := parseScopes(request.Scope)
+	if len(scopes) == 0 {
+		scopes = client.Scopes()
+	}
+	for _, scope := range scopes {
+		if !client.AllowsScopes([]string{scope}) {
+			return client, nil, errors.ErrorOAuthInvalidScope(scope)
+		}
+	}
+
+	return client, scopes, nil
+}
+
+// parseScopes splits a space-delimited scope parameter, dropping duplicates.
+func parseScopes(scope string) []string {
+	var scopes []string
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(scopes, s) {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+func joinScopes(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+func withQuery(endpoint string, params url.Values) string {
+	if strings.Contains(endpoint, "?") {
+		return endpoint + "&" + params.Encode()
+	}
+	return endpoint + "?" + params.Encode()
+}
+
+// authorizationErrorRedirect reports err to the client as RFC 6749 §4.1.2.1
+// describes.
+func authorizationErrorRedirect(request dto.OAuthAuthorizationRequest, err *errors.Error) *dto.OAuthRedirectOutput {
+	params := url.Values{
+		"error":             {err.OAuthCode()},
+		"error_description": {err.FriendlyMessage},
+	}
+	if request.State != "" {
+		params.Set("state", request.State)
+	}
+	return &dto.OAuthRedirectOutput{RedirectTo: withQuery(request.RedirectURI, params)}
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	webAuthnChallengePrefix = "auth:webauthn:challenge:"
+	passkeyCredentialType   = "public-key"
+	maxPasskeyNameLength    = 100
+	defaultPasskeyName      = "Passkey"
+)
+
+// passkeyTransports are the authenticator transports of WebAuthn Level 3;
+// anything else a client reports is not kept.
+var passkeyTransports = []string{"ble", "hybrid", "internal", "nfc", "smart-card", "usb"}
+
+// startPasskeyCeremony issues the challenge of a ceremony. Only its hash is
+// used as the cache key, like every other token this service hands out.
+func startPasskeyCeremony(
+	ctx context.Context,
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	session vo.WebAuthnSession,
+	ttl time.Duration,
+) (string, *errors.Error) {
+	challenge, hash, err := opaqueToken.Generate()
+	if err != nil {
+		return "", err
+	}
+	if err := cache.Set(ctx, webAuthnChallengePrefix+hash, session, int(ttl.Seconds())); err != nil {
+		return "", err
+	}
+	return challenge, nil
+}
+
+// consumePasskeyCeremony looks up the ceremony a response answers and drops
+// it before the response is verified, so a challenge is never answered
+// twice. It returns the challenge the response must be verified against.
+func consumePasskeyCeremony(
+	ctx context.Context,
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	webAuthn adapter.WebAuthn,
+	clientDataJSON []byte,
+	ceremony string,
+) (string, *vo.WebAuthnSession, *errors.Error) {
+	challenge, err := webAuthn.Challenge(clientDataJSON)
+	if err != nil {
+		return "", nil, err
+	}
+
+	key := webAuthnChallengePrefix + opaqueToken.Hash(challenge)
+	var session vo.WebAuthnSession
+	found, err := cache.Get(ctx, key, &session)
+	if err != nil {
+		return "", nil, err
+	}
+	if !found {
+		return "", nil, errors.ErrorInvalidPasskeyChallenge()
+	}
+	if err := cache.Delete(ctx, key); err != nil {
+		return "", nil, err
+	}
+	if session.Ceremony != ceremony {
+		return "", nil, errors.ErrorInvalidPasskeyChallenge()
+	}
+	return challenge, &session, nil
+}
+
+// decodeWebAuthnField decodes a base64url value of the WebAuthn JSON forms,
+// tolerating the padding some client libraries still add.
+func decodeWebAuthnField(value string) ([]byte, bool) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	return decoded, err == nil && len(decoded) > 0
+}
+
+func encodeWebAuthnField(value []byte) string {
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+func knownPasskeyTransports(transports []string) []string {
+	known := make([]string, 0, len(transports))
+	for _, transport := range transports {
+		if slices.Contains(passkeyTransports, transport) && !slices.Contains(known, transport) {
+			known = append(known, transport)
+		}
+	}
+	return known
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+// issuePasswordResetToken replaces any pending reset token of the user with a
+// new one and returns it in clear text along with its expiry.
+func issuePasswordResetToken(
+	ctx context.Context,
+	unitOfWork adapter.UnitOfWork,
+	oneTimeTokenRepository port.OneTimeTokenRepository,
+	opaqueToken adapter.OpaqueToken,
+	userID int64,
+	ttl time.Duration,
+) (string, time.Time, *errors.Error) {
+	token, tokenHash, err := opaqueToken.Generate()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().UTC().Add(ttl)
+	err = unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		if err := oneTimeTokenRepository.InvalidateOneTimeTokens(ctx, userID, entity.TokenPurposePasswordReset); err != nil {
+			return err
+		}
+		_, err := oneTimeTokenRepository.CreateOneTimeToken(ctx, entity.BuilderOneTimeToken().
+			WithUserID(userID).
+			WithPurpose(entity.TokenPurposePasswordReset).
+			WithTokenHash(tokenHash).
+			WithExpiresAt(expiresAt).
+			Build())
+		return err
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiresAt, nil
+}
@@ -0,0 +1,75 @@
+package command
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type PrepareOAuthAuthorization struct {
+	clientRepository port.OAuthClientRepository
+	consentURL       string
+	log              adapter.Logger
+	tracer           adapter.Tracer
+}
+
+func NewPrepareOAuthAuthorization(
+	clientRepository port.OAuthClientRepository,
+	consentURL string,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *PrepareOAuthAuthorization {
+	return &PrepareOAuthAuthorization{
+		clientRepository: clientRepository,
+		consentURL:       consentURL,
+		log:              log,
+		tracer:           tracer,
+	}
+}
+
+// Execute checks an authorization request and sends the browser to the
+// consent page with it. Once the redirect URI is trusted, a bad request is
+// reported to the client instead, so only an unknown client or redirect URI
+// comes back as an error.
+func (c *PrepareOAuthAuthorization) Execute(ctx context.Context, input dto.OAuthAuthorizationRequest) (*dto.OAuthRedirectOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "PrepareOAuthAuthorization.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	client, scopes, err := validateAuthorizationRequest(ctx, c.clientRepository, input)
+	if err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Invalid OAuth authorization request",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": input.ClientID,
+				"error":     err.Error(),
+			})
+		if client == nil {
+			return nil, err
+		}
+		return authorizationErrorRedirect(input, err), nil
+	}
+
+	params := url.Values{
+		"response_type":         {input.ResponseType},
+		"client_id":             {client.ClientID()},
+		"client_name":           {client.Name()},
+		"redirect_uri":          {input.RedirectURI},
+		"scope":                 {joinScopes(scopes)},
+		"code_challenge":        {input.CodeChallenge},
+		"code_challenge_method": {input.CodeChallengeMethod},
+	}
+	if input.State != "" {
+		params.Set("state", input.State)
+	}
+	if input.Nonce != "" {
+		params.Set("nonce", input.Nonce)
+	}
+
+	return &dto.OAuthRedirectOutput{RedirectTo: withQuery(c.consentURL, params)}, nil
+}
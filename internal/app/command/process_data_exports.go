@@ -0,0 +1,219 @@
+package command
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+const personalDataFileName = "personal-data.json"
+
+// personalDataSecrets are masked in every bundle; keys stay so the bundle
+// still shows that the secret exists.
+var personalDataSecrets = []string{"password_hash", "token_hash", "totp_secret"}
+
+type ProcessDataExports struct {
+	dataExportRepository         port.DataExportRepository
+	userRepository               port.UserRepository
+	addressRepository            port.AddressRepository
+	sessionRepository            port.SessionRepository
+	refreshTokenRepository       port.RefreshTokenRepository
+	userIdentityRepository       port.UserIdentityRepository
+	oauthConsentRepository       port.OAuthConsentRepository
+	webAuthnCredentialRepository port.WebAuthnCredentialRepository
+	userMFARepository            port.UserMFARepository
+	exportTTL                    time.Duration
+	claimTimeout                 time.Duration
+	log                          adapter.Logger
+	tracer                       adapter.Tracer
+}
+
+func NewProcessDataExports(
+	dataExportRepository port.DataExportRepository,
+	userRepository port.UserRepository,
+	addressRepository port.AddressRepository,
+	sessionRepository port.SessionRepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	userIdentityRepository port.UserIdentityRepository,
+	oauthConsentRepository port.OAuthConsentRepository,
+	webAuthnCredentialRepository port.WebAuthnCredentialRepository,
+	userMFARepository port.UserMFARepository,
+	exportTTL time.Duration,
+	claimTimeout time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ProcessDataExports {
+	return &ProcessDataExports{
+		dataExportRepository:         dataExportRepository,
+		userRepository:               userRepository,
+		addressRepository:            addressRepository,
+		sessionRepository:            sessionRepository,
+		refreshTokenRepository:       refreshTokenRepository,
+		userIdentityRepository:       userIdentityRepository,
+		oauthConsentRepository:       oauthConsentRepository,
+		webAuthnCredentialRepository: webAuthnCredentialRepository,
+		userMFARepository:            userMFARepository,
+		exportTTL:                    exportTTL,
+		claimTimeout:                 claimTimeout,
+		log:                          log,
+		tracer:                       tracer,
+	}
+}
+
+// Execute expires old bundles, then builds every pending export and returns
+// how many were processed. A failing export is marked failed and skipped; one
+// left in processing by a worker that died is built again once claimTimeout
+// has passed.
+func (c *ProcessDataExports) Execute(ctx context.Context) (int64, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "ProcessDataExports.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	if _, err := c.dataExportRepository.ExpireDataExports(ctx, time.Now().UTC()); err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error expiring data exports",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return 0, err
+	}
+
+	var processed int64
+	for ctx.Err() == nil {
+		export, err := c.dataExportRepository.ClaimPendingDataExport(ctx, time.Now().UTC().Add(-c.claimTimeout))
+		if err != nil {
+			span.RecordError(err)
+			c.log.ErrorJSON("Error claiming data export",
+				map[string]any{
+					"trace_id":  traceID,
+					"processed": processed,
+					"error":     err.Error(),
+				})
+			return processed, err
+		}
+		if export == nil {
+			break
+		}
+
+		if err := c.process(ctx, export); err != nil {
+			span.RecordError(err)
+			c.log.ErrorJSON("Error processing data export",
+				map[string]any{
+					"trace_id":  traceID,
+					"export_id": export.PublicID(),
+					"error":     err.Error(),
+				})
+			if err := c.dataExportRepository.FailDataExport(ctx, export.ID(), err.Error()); err != nil {
+				span.RecordError(err)
+				return processed, err
+			}
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+func (c *ProcessDataExports) process(ctx context.Context, export *entity.DataExport) *errors.Error {
+	now := time.Now().UTC()
+
+	bundle, err := c.buildBundle(ctx, export.UserID(), now)
+	if err != nil {
+		return err
+	}
+
+	payload, err := encodeBundle(logger.RedactStruct(bundle, personalDataSecrets...), export.Format())
+	if err != nil {
+		return err
+	}
+
+	return c.dataExportRepository.CompleteDataExport(ctx, export.ID(), payload, now.Add(c.exportTTL))
+}
+
+func (c *ProcessDataExports) buildBundle(ctx context.Context, userID int64, now time.Time) (dto.PersonalDataBundle, *errors.Error) {
+	user, err := c.userRepository.FindUserByID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+	if user == nil {
+		return dto.PersonalDataBundle{}, errors.ErrorUserNotFound(strconv.FormatInt(userID, 10))
+	}
+
+	addresses, err := c.addressRepository.ListAddressesByUserID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	sessions, err := c.sessionRepository.ListSessionsByUserID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	tokens, err := c.refreshTokenRepository.ListRefreshTokensByUserID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	identities, err := c.userIdentityRepository.ListIdentitiesByUserID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	consents, err := c.oauthConsentRepository.ListConsentsByUserID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	passkeys, err := c.webAuthnCredentialRepository.ListCredentialsByUserID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	mfa, err := c.userMFARepository.FindUserMFA(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	exports, err := c.dataExportRepository.ListDataExportsByUserID(ctx, userID)
+	if err != nil {
+		return dto.PersonalDataBundle{}, err
+	}
+
+	return mapper.ToPersonalDataBundle(user, addresses, sessions, tokens, identities, consents, passkeys, mfa, exports, now), nil
+}
+
+func encodeBundle(bundle dto.PersonalDataBundle, format entity.ExportFormat) ([]byte, *errors.Error) {
+	content, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return nil, errors.ErrorEncodeDataExport(err)
+	}
+	if format != entity.ExportFormatZIP {
+		return content, nil
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	file, err := archive.Create(personalDataFileName)
+	if err == nil {
+		_, err = file.Write(content)
+	}
+	if err == nil {
+		err = archive.Close()
+	}
+	if err != nil {
+		return nil, errors.ErrorEncodeDataExport(err)
+	}
+	return buf.Bytes(), nil
+}
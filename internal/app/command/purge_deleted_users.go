@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+const purgeBatchSize = 500
+
+type PurgeDeletedUsers struct {
+	userRepository port.UserRepository
+	gracePeriod    time.Duration
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewPurgeDeletedUsers(
+	userRepository port.UserRepository,
+	gracePeriod time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *PurgeDeletedUsers {
+	return &PurgeDeletedUsers{
+		userRepository: userRepository,
+		gracePeriod:    gracePeriod,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+// Execute anonymizes every account whose grace period has ended, in batches,
+// and returns how many were purged.
+func (c *PurgeDeletedUsers) Execute(ctx context.Context) (int64, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "PurgeDeletedUsers.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	deletedBefore := time.Now().UTC().Add(-c.gracePeriod)
+
+	var total int64
+	for {
+		purged, err := c.userRepository.PurgeDeletedUsers(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			span.RecordError(err)
+			c.log.ErrorJSON("Error purging deleted users",
+				map[string]any{
+					"trace_id": traceID,
+					"purged":   total,
+					"error":    err.Error(),
+				})
+			return total, err
+		}
+		total += purged
+		if purged < purgeBatchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	return total, nil
+}
@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type RefreshAuthToken struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	refreshTokenRepository port.RefreshTokenRepository
+	authTokenService       service.AuthTokenService
+	opaqueToken            adapter.OpaqueToken
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewRefreshAuthToken(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	authTokenService service.AuthTokenService,
+	opaqueToken adapter.OpaqueToken,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RefreshAuthToken {
+	return &RefreshAuthToken{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		authTokenService:       authTokenService,
+		opaqueToken:            opaqueToken,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute rotates the presented refresh token. A token that was already
+// rotated is a reuse signal: the whole family is revoked (and that revocation
+// is committed) before the request is rejected.
+func (c *RefreshAuthToken) Execute(ctx context.Context, input dto.RefreshAuthTokenInput) (*dto.AuthTokenOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RefreshAuthToken.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+	c.log.InfoJSON("Refreshing auth token",
+		map[string]any{
+			"trace_id": traceID,
+		})
+
+	if input.RefreshToken == "" {
+		invalidErr := errors.ErrorInvalidRefreshToken()
+		span.RecordError(invalidErr)
+		return nil, invalidErr
+	}
+
+	tokenHash := c.opaqueToken.Hash(input.RefreshToken)
+
+	var (
+		tokens         *vo.AuthTokens
+		reusedFamilyID string
+	)
+
+	err := c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		stored, err := c.refreshTokenRepository.FindRefreshTokenByHash(ctx, tokenHash)
+		if err != nil {
+			return err
+		}
+
+		if stored == nil || stored.IsRevoked() || stored.IsExpired(time.Now().UTC()) {
+			return errors.ErrorInvalidRefreshToken()
+		}
+
+		if stored.IsRotated() {
+			if err := c.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, stored.FamilyID()); err != nil {
+				return err
+			}
+			reusedFamilyID = stored.FamilyID()
+			return nil
+		}
+
+		if err := c.refreshTokenRepository.MarkRefreshTokenRotated(ctx, stored.ID()); err != nil {
+			return err
+		}
+
+		user, err := c.userRepository.FindUserByID(ctx, stored.UserID())
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return errors.ErrorInvalidRefreshToken()
+		}
+
+		tokens, err = c.authTokenService.IssueTokens(ctx, user, stored.FamilyID())
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error refreshing auth token",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	if reusedFamilyID != "" {
+		reuseErr := errors.ErrorRefreshTokenReused(reusedFamilyID)
+		span.RecordError(reuseErr)
+		c.log.CriticalJSON("Refresh token reuse detected, family revoked",
+			map[string]any{
+				"trace_id":  traceID,
+				"family_id": reusedFamilyID,
+			})
+		return nil, reuseErr
+	}
+
+	return mapper.ToAuthTokenOutput(tokens), nil
+}
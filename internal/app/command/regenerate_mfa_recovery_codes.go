@@ -0,0 +1,89 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+)
+
+type RegenerateMFARecoveryCodes struct {
+	userService service.UserService
+	mfaService  service.MFAService
+	log         adapter.Logger
+	tracer      adapter.Tracer
+}
+
+func NewRegenerateMFARecoveryCodes(
+	userService service.UserService,
+	mfaService service.MFAService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RegenerateMFARecoveryCodes {
+	return &RegenerateMFARecoveryCodes{
+		userService: userService,
+		mfaService:  mfaService,
+		log:         log,
+		tracer:      tracer,
+	}
+}
+
+// Execute replaces the recovery codes of the authenticated user, voiding the
+// previous ones. input.Code must be a current second factor.
+func (c *RegenerateMFARecoveryCodes) Execute(ctx context.Context, input dto.MFACodeInput) (*dto.MFARecoveryCodesOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RegenerateMFARecoveryCodes.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := verifyMFACode(ctx, c.mfaService, user.ID(), input.Code); err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("MFA code rejected",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	codes, err := c.mfaService.IssueRecoveryCodes(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing recovery codes",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("MFA recovery codes regenerated",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	return &dto.MFARecoveryCodesOutput{RecoveryCodes: codes}, nil
+}
+
+// verifyMFACode requires code to be a valid second factor of the user. Guesses
+// are counted per user, so it cannot be brute-forced with a stolen session.
+func verifyMFACode(ctx context.Context, mfaService service.MFAService, userID int64, code string) *errors.Error {
+	valid, err := mfaService.VerifyUserCode(ctx, userID, code)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return errors.ErrorInvalidMFACode()
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type RegisterOAuthClient struct {
+	clientRepository port.OAuthClientRepository
+	opaqueToken      adapter.OpaqueToken
+	utils            adapter.Utils
+	log              adapter.Logger
+	tracer           adapter.Tracer
+}
+
+func NewRegisterOAuthClient(
+	clientRepository port.OAuthClientRepository,
+	opaqueToken adapter.OpaqueToken,
+	utils adapter.Utils,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RegisterOAuthClient {
+	return &RegisterOAuthClient{
+		clientRepository: clientRepository,
+		opaqueToken:      opaqueToken,
+		utils:            utils,
+		log:              log,
+		tracer:           tracer,
+	}
+}
+
+// Execute registers a client application. Confidential clients get a secret,
+// returned only in this response.
+func (c *RegisterOAuthClient) Execute(ctx context.Context, input dto.RegisterOAuthClientInput) (*dto.RegisterOAuthClientOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RegisterOAuthClient.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	c.log.InfoJSON("Registering OAuth client",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     input,
+		})
+
+	builder := entity.BuilderOAuthClient().
+		WithClientID(c.utils.UUID()).
+		WithName(input.Name).
+		WithRedirectURIs(input.RedirectURIs).
+		WithScopes(input.Scopes).
+		WithGrantTypes([]string{entity.GrantTypeAuthorizationCode})
+
+	var secret string
+	if input.Confidential {
+		token, hash, err := c.opaqueToken.Generate()
+		if err != nil {
+			span.RecordError(err)
+			c.log.ErrorJSON("Error generating OAuth client secret",
+				map[string]any{
+					"trace_id": traceID,
+					"error":    err.Error(),
+				})
+			return nil, err
+		}
+		secret = token
+		builder.WithSecretHash(hash)
+	}
+	client := builder.Build()
+
+	if isValid := client.Validate(); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "oauth_client")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("OAuth client validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return nil, validationErr
+	}
+
+	created, err := c.clientRepository.CreateClient(ctx, client)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error creating OAuth client",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	return mapper.ToRegisterOAuthClientOutput(created, secret), nil
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type RegisterServiceClient struct {
+	clientRepository port.OAuthClientRepository
+	opaqueToken      adapter.OpaqueToken
+	utils            adapter.Utils
+	log              adapter.Logger
+	tracer           adapter.Tracer
+}
+
+func NewRegisterServiceClient(
+	clientRepository port.OAuthClientRepository,
+	opaqueToken adapter.OpaqueToken,
+	utils adapter.Utils,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RegisterServiceClient {
+	return &RegisterServiceClient{
+		clientRepository: clientRepository,
+		opaqueToken:      opaqueToken,
+		utils:            utils,
+		log:              log,
+		tracer:           tracer,
+	}
+}
+
+// Execute registers a service allowed to use the client_credentials grant.
+// Its secret is returned only in this response.
+func (c *RegisterServiceClient) Execute(ctx context.Context, input dto.RegisterServiceClientInput) (*dto.RegisterOAuthClientOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RegisterServiceClient.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	c.log.InfoJSON("Registering service client",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     input,
+		})
+
+	secret, secretHash, err := c.opaqueToken.Generate()
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error generating OAuth client secret",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	client := entity.BuilderOAuthClient().
+		WithClientID(c.utils.UUID()).
+		WithSecretHash(secretHash).
+		WithName(input.Name).
+		WithScopes(input.Scopes).
+		WithAudiences(input.Audiences).
+		WithGrantTypes([]string{entity.GrantTypeClientCredentials}).
+		Build()
+
+	if isValid := client.Validate(); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "oauth_client")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Service client validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return nil, validationErr
+	}
+
+	created, err := c.clientRepository.CreateClient(ctx, client)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error creating service client",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	return mapper.ToRegisterOAuthClientOutput(created, secret), nil
+}
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+const errInvalidExportFormat = "format must be json or zip"
+
+type RequestDataExport struct {
+	dataExportRepository port.DataExportRepository
+	userService          service.UserService
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+	utils                adapter.Utils
+}
+
+func NewRequestDataExport(
+	dataExportRepository port.DataExportRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+	utils adapter.Utils,
+) *RequestDataExport {
+	return &RequestDataExport{
+		dataExportRepository: dataExportRepository,
+		userService:          userService,
+		log:                  log,
+		tracer:               tracer,
+		utils:                utils,
+	}
+}
+
+// Execute queues an export of the authenticated user's data. Only one export
+// can be in progress at a time; the worker builds the bundle later.
+func (c *RequestDataExport) Execute(ctx context.Context, input dto.RequestDataExportInput) (*dto.DataExportOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RequestDataExport.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	format := entity.ExportFormatJSON
+	if input.Format != "" {
+		format = entity.ExportFormat(input.Format)
+	}
+	if format != entity.ExportFormatJSON && format != entity.ExportFormatZIP {
+		isValid := validator.New()
+		isValid.AddFieldError("format", errInvalidExportFormat)
+		validationErr := errors.InvalidEntity(isValid, "data_export")
+		span.RecordError(validationErr)
+		return nil, validationErr
+	}
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	c.log.InfoJSON("Requesting data export",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+			"format":    format,
+		})
+
+	exports, err := c.dataExportRepository.ListDataExportsByUserID(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error listing data exports",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	for i := range exports {
+		if exports[i].IsInProgress() {
+			err := errors.ErrorDataExportInProgress(exports[i].PublicID())
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	export, err := c.dataExportRepository.CreateDataExport(ctx, entity.BuilderDataExport().
+		WithPublicID(c.utils.UUID()).
+		WithUserID(user.ID()).
+		WithFormat(format).
+		WithStatus(entity.ExportStatusPending).
+		WithRequestedAt(time.Now().UTC()).
+		Build())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error creating data export",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	output := mapper.ToDataExportOutput(export)
+	return &output, nil
+}
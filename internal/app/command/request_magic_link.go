@@ -0,0 +1,169 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const magicLinkSubject = "Your sign-in link"
+
+type RequestMagicLink struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	oneTimeTokenRepository port.OneTimeTokenRepository
+	opaqueToken            adapter.OpaqueToken
+	signer                 adapter.Signer
+	mailer                 adapter.Mailer
+	linkURL                string
+	linkTTL                time.Duration
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewRequestMagicLink(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	oneTimeTokenRepository port.OneTimeTokenRepository,
+	opaqueToken adapter.OpaqueToken,
+	signer adapter.Signer,
+	mailer adapter.Mailer,
+	linkURL string,
+	linkTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RequestMagicLink {
+	return &RequestMagicLink{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		opaqueToken:            opaqueToken,
+		signer:                 signer,
+		mailer:                 mailer,
+		linkURL:                linkURL,
+		linkTTL:                linkTTL,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute e-mails a sign-in link to the account of input.Email and returns
+// the nonce that binds the link to the requesting browser: the link signature
+// covers the nonce hash, so a link opened anywhere else is useless. A nonce is
+// returned whether or not the account exists, so the endpoint cannot be used
+// to discover registered e-mails; the link is issued and e-mailed in the
+// background and failures are only logged.
+func (c *RequestMagicLink) Execute(ctx context.Context, input dto.RequestMagicLinkInput) (*dto.MagicLinkRequestedOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RequestMagicLink.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	nonce, nonceHash, err := c.opaqueToken.Generate()
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error generating magic link nonce",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+	expiresAt := time.Now().UTC().Add(c.linkTTL).Truncate(time.Second)
+	output := &dto.MagicLinkRequestedOutput{
+		Nonce:     nonce,
+		ExpiresAt: expiresAt.Format(time.RFC3339),
+	}
+
+	user, err := c.userRepository.FindUserByEmail(ctx, input.Email)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by email",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+	if user == nil {
+		c.log.InfoJSON("Magic link requested for unknown e-mail",
+			map[string]any{
+				"trace_id": traceID,
+			})
+		return output, nil
+	}
+
+	// the link is issued in the background too, so a known account is
+	// answered as quickly as an unknown one
+	deliverInBackground(ctx, c.log, "Error sending magic link e-mail",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		},
+		func(ctx context.Context) *errors.Error {
+			return c.issue(ctx, user, nonceHash, expiresAt)
+		})
+
+	c.log.InfoJSON("Magic link requested",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+	return output, nil
+}
+
+// issue replaces any pending magic link of user and e-mails the new one.
+func (c *RequestMagicLink) issue(ctx context.Context, user *entity.User, nonceHash string, expiresAt time.Time) *errors.Error {
+	token, tokenHash, err := c.opaqueToken.Generate()
+	if err != nil {
+		return err
+	}
+
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		if err := c.oneTimeTokenRepository.InvalidateOneTimeTokens(ctx, user.ID(), entity.TokenPurposeMagicLink); err != nil {
+			return err
+		}
+		_, err := c.oneTimeTokenRepository.CreateOneTimeToken(ctx, entity.BuilderOneTimeToken().
+			WithUserID(user.ID()).
+			WithPurpose(entity.TokenPurposeMagicLink).
+			WithTokenHash(tokenHash).
+			WithExpiresAt(expiresAt).
+			Build())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	signature := c.signer.Sign(magicLinkSignedSubject(tokenHash, nonceHash), expiresAt)
+	return c.mailer.Send(ctx, c.linkMessage(user.Email(), token, expiresAt, signature))
+}
+
+func (c *RequestMagicLink) linkMessage(email, token string, expiresAt time.Time, signature string) vo.MailMessage {
+	query := url.Values{}
+	query.Set("token", token)
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("signature", signature)
+	link := c.linkURL + "?" + query.Encode()
+	return vo.MailMessage{
+		To:      email,
+		Subject: magicLinkSubject,
+		Body: fmt.Sprintf("Use the link below to sign in. It works once, within %s, "+
+			"and only in the browser where you asked for it:\n%s\n\n"+
+			"If you did not ask for this, you can ignore this e-mail.", c.linkTTL, link),
+	}
+}
+
+// magicLinkSignedSubject binds a link to its token and to the browser that
+// holds the nonce.
+func magicLinkSignedSubject(tokenHash, nonceHash string) string {
+	return "magic_link:" + tokenHash + ":" + nonceHash
+}
@@ -0,0 +1,100 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+const resendEmailVerificationPrefix = "auth:verify_email:resend:"
+
+type ResendEmailVerification struct {
+	userRepository    port.UserRepository
+	emailVerification service.EmailVerificationService
+	cache             adapter.Cache
+	resendLimit       int
+	resendWindow      time.Duration
+	log               adapter.Logger
+	tracer            adapter.Tracer
+}
+
+func NewResendEmailVerification(
+	userRepository port.UserRepository,
+	emailVerification service.EmailVerificationService,
+	cache adapter.Cache,
+	resendLimit int,
+	resendWindow time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ResendEmailVerification {
+	return &ResendEmailVerification{
+		userRepository:    userRepository,
+		emailVerification: emailVerification,
+		cache:             cache,
+		resendLimit:       resendLimit,
+		resendWindow:      resendWindow,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+// Execute mails a new verification link to input.Email. Requests are counted
+// per address before the lookup, so the limit applies alike to registered and
+// unknown e-mails and the answer reveals neither.
+func (c *ResendEmailVerification) Execute(ctx context.Context, input dto.ResendEmailVerificationInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "ResendEmailVerification.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	key := resendEmailVerificationPrefix + strings.ToLower(strings.TrimSpace(input.Email))
+	count, err := c.cache.Increment(ctx, key, int(c.resendWindow.Seconds()))
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error counting verification e-mail requests",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+	if count > int64(c.resendLimit) {
+		limitErr := errors.ErrorTooManyVerificationEmails()
+		span.RecordError(limitErr)
+		c.log.WarnJSON("Verification e-mail resend limit reached",
+			map[string]any{
+				"trace_id": traceID,
+			})
+		return limitErr
+	}
+
+	user, err := c.userRepository.FindUserByEmail(ctx, input.Email)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by email",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+	if user == nil || user.IsEmailVerified() {
+		c.log.InfoJSON("Verification e-mail not needed",
+			map[string]any{
+				"trace_id": traceID,
+			})
+		return nil
+	}
+
+	// delivery failures are logged by the service and not revealed here
+	if err := c.emailVerification.SendVerification(ctx, user); err != nil {
+		span.RecordError(err)
+	}
+
+	return nil
+}
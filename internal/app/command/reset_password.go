@@ -0,0 +1,132 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+type ResetPassword struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	oneTimeTokenRepository port.OneTimeTokenRepository
+	refreshTokenRepository port.RefreshTokenRepository
+	denylist               adapter.TokenDenylist
+	opaqueToken            adapter.OpaqueToken
+	bcrypt                 adapter.Bcrypt
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewResetPassword(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	oneTimeTokenRepository port.OneTimeTokenRepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	denylist adapter.TokenDenylist,
+	opaqueToken adapter.OpaqueToken,
+	bcrypt adapter.Bcrypt,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ResetPassword {
+	return &ResetPassword{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		denylist:               denylist,
+		opaqueToken:            opaqueToken,
+		bcrypt:                 bcrypt,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute redeems a reset token and replaces the password. The token is
+// consumed in the same transaction as the change and every session of the
+// user is ended, since the old password may have been compromised.
+func (c *ResetPassword) Execute(ctx context.Context, input dto.ResetPasswordInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "ResetPassword.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	c.log.InfoJSON("Resetting password",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     logger.RedactStruct[dto.ResetPasswordInput](input, "token", "password", "password_confirm"),
+		})
+
+	newPassword := vo.NewPassword(input.Password)
+	if isValid := validateNewPassword(&newPassword, input.PasswordConfirm); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "password")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Password validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return validationErr
+	}
+
+	hashedPassword, err := c.bcrypt.Hash(newPassword.String())
+	if err != nil {
+		span.RecordError(err)
+		c.log.CriticalJSON("Error hashing password",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+
+	now := time.Now().UTC()
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		token, err := c.oneTimeTokenRepository.ConsumeOneTimeToken(ctx, entity.TokenPurposePasswordReset,
+			c.opaqueToken.Hash(input.Token), now)
+		if err != nil {
+			return err
+		}
+		if token == nil {
+			return errors.ErrorInvalidResetToken()
+		}
+
+		user, err := c.userRepository.FindUserByID(ctx, token.UserID())
+		if err != nil {
+			return err
+		}
+		// a deleted account cannot be recovered through a reset link
+		if user == nil {
+			return errors.ErrorInvalidResetToken()
+		}
+
+		updated, err := c.userRepository.UpdateUserPassword(ctx, user.ID(), hashedPassword, now)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return errors.ErrorInvalidResetToken()
+		}
+		if err := c.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.PublicID(), ""); err != nil {
+			return err
+		}
+		return c.denylist.RevokeUserTokens(ctx, user.PublicID(), "")
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error resetting password",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+
+	return nil
+}
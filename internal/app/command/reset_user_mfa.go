@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type ResetUserMFA struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	userMFARepository      port.UserMFARepository
+	recoveryCodeRepository port.MFARecoveryCodeRepository
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewResetUserMFA(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	userMFARepository port.UserMFARepository,
+	recoveryCodeRepository port.MFARecoveryCodeRepository,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ResetUserMFA {
+	return &ResetUserMFA{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		userMFARepository:      userMFARepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute removes the MFA of the user with input.PublicID, for an administrator to
+// recover an account whose authenticator and recovery codes are lost. The
+// user signs in with the password alone until enrolling again.
+func (c *ResetUserMFA) Execute(ctx context.Context, input dto.ResetUserMFAInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "ResetUserMFA.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+	publicID := input.PublicID
+
+	user, err := c.userRepository.FindUserByPublicID(ctx, publicID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by public ID",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": publicID,
+				"error":     err.Error(),
+			})
+		return err
+	}
+	if user == nil {
+		notFoundErr := errors.ErrorUserNotFound(publicID)
+		span.RecordError(notFoundErr)
+		return notFoundErr
+	}
+
+	if err := removeMFA(ctx, c.unitOfWork, c.userMFARepository, c.recoveryCodeRepository, user.ID()); err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error resetting MFA",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": publicID,
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	principal, _ := vo.PrincipalFromContext(ctx)
+	c.log.InfoJSON("MFA reset by administrator",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": publicID,
+			"admin_id":  principal.PublicID,
+		})
+
+	return nil
+}
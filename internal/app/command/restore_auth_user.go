@@ -0,0 +1,122 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+type RestoreAuthUser struct {
+	userRepository port.UserRepository
+	loginThrottle  adapter.LoginThrottle
+	bcrypt         adapter.Bcrypt
+	gracePeriod    time.Duration
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewRestoreAuthUser(
+	userRepository port.UserRepository,
+	loginThrottle adapter.LoginThrottle,
+	bcrypt adapter.Bcrypt,
+	gracePeriod time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RestoreAuthUser {
+	return &RestoreAuthUser{
+		userRepository: userRepository,
+		loginThrottle:  loginThrottle,
+		bcrypt:         bcrypt,
+		gracePeriod:    gracePeriod,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+// Execute reactivates a soft deleted account within its grace period. The
+// credentials are checked like a login so unknown emails are not disclosed.
+func (c *RestoreAuthUser) Execute(ctx context.Context, input dto.RestoreAuthUserInput) (*dto.UserProfileOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RestoreAuthUser.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+	c.log.InfoJSON("Restoring user",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     logger.RedactStruct[dto.RestoreAuthUserInput](input, "password"),
+		})
+
+	if err := checkLoginThrottle(ctx, c.loginThrottle, input.Email); err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Restore throttled",
+			map[string]any{
+				"trace_id": traceID,
+				"email":    input.Email,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	user, err := c.userRepository.FindDeletedUserByEmail(ctx, input.Email)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding deleted user by email",
+			map[string]any{
+				"trace_id": traceID,
+				"email":    input.Email,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	if !checkPassword(c.bcrypt, user, input.Password) {
+		credentialsErr := recordLoginFailure(ctx, c.loginThrottle, input.Email, errors.ErrorInvalidCredentials())
+		span.RecordError(credentialsErr)
+		c.log.WarnJSON("Invalid credentials",
+			map[string]any{
+				"trace_id": traceID,
+				"email":    input.Email,
+			})
+		return nil, credentialsErr
+	}
+
+	if err := c.loginThrottle.RecordSuccess(ctx, input.Email, vo.ClientIPFromContext(ctx)); err != nil {
+		c.log.ErrorJSON("Error clearing failed logins",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+	}
+
+	if time.Now().UTC().After(user.DeletedAt().Add(c.gracePeriod)) {
+		expiredErr := errors.ErrorRestoreWindowExpired(user.PublicID())
+		span.RecordError(expiredErr)
+		return nil, expiredErr
+	}
+
+	restored, err := c.userRepository.RestoreUser(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error restoring user",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if restored == nil {
+		notFoundErr := errors.ErrorUserNotFound(user.PublicID())
+		span.RecordError(notFoundErr)
+		return nil, notFoundErr
+	}
+
+	return mapper.ToUserProfileOutput(restored), nil
+}
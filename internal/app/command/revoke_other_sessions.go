@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type RevokeOtherSessions struct {
+	unitOfWork             adapter.UnitOfWork
+	refreshTokenRepository port.RefreshTokenRepository
+	userService            service.UserService
+	denylist               adapter.TokenDenylist
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewRevokeOtherSessions(
+	unitOfWork adapter.UnitOfWork,
+	refreshTokenRepository port.RefreshTokenRepository,
+	userService service.UserService,
+	denylist adapter.TokenDenylist,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RevokeOtherSessions {
+	return &RevokeOtherSessions{
+		unitOfWork:             unitOfWork,
+		refreshTokenRepository: refreshTokenRepository,
+		userService:            userService,
+		denylist:               denylist,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute signs the current user out everywhere but the session making the
+// request. Tokens without a session, such as those issued to OAuth clients,
+// sign the user out of every session.
+func (c *RevokeOtherSessions) Execute(ctx context.Context) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "RevokeOtherSessions.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	principal, _ := vo.PrincipalFromContext(ctx)
+	c.log.InfoJSON("Revoking other sessions",
+		map[string]any{
+			"trace_id":   traceID,
+			"public_id":  user.PublicID(),
+			"session_id": principal.SessionID,
+		})
+
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		if err := c.refreshTokenRepository.RevokeUserRefreshTokens(ctx, user.PublicID(), principal.SessionID); err != nil {
+			return err
+		}
+		// last step, so a denylist failure rolls the revocation back
+		return c.denylist.RevokeUserTokens(ctx, user.PublicID(), principal.SessionID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error revoking other sessions",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type RevokeSession struct {
+	unitOfWork             adapter.UnitOfWork
+	sessionRepository      port.SessionRepository
+	refreshTokenRepository port.RefreshTokenRepository
+	userService            service.UserService
+	denylist               adapter.TokenDenylist
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewRevokeSession(
+	unitOfWork adapter.UnitOfWork,
+	sessionRepository port.SessionRepository,
+	refreshTokenRepository port.RefreshTokenRepository,
+	userService service.UserService,
+	denylist adapter.TokenDenylist,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RevokeSession {
+	return &RevokeSession{
+		unitOfWork:             unitOfWork,
+		sessionRepository:      sessionRepository,
+		refreshTokenRepository: refreshTokenRepository,
+		userService:            userService,
+		denylist:               denylist,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute signs the current user out of one of their sessions: its refresh
+// tokens stop working and the access tokens already issued to it are denied.
+// Revoking the current session works as a logout.
+func (c *RevokeSession) Execute(ctx context.Context, input dto.RevokeSessionInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "RevokeSession.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.log.InfoJSON("Revoking session",
+		map[string]any{
+			"trace_id":   traceID,
+			"public_id":  user.PublicID(),
+			"session_id": input.SessionID,
+		})
+
+	if !vo.IsSessionID(input.SessionID) {
+		notFoundErr := errors.ErrorSessionNotFound(input.SessionID)
+		span.RecordError(notFoundErr)
+		return notFoundErr
+	}
+
+	session, err := c.sessionRepository.FindSessionByFamilyID(ctx, input.SessionID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding session",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return err
+	}
+	if session == nil || session.UserID() != user.ID() {
+		notFoundErr := errors.ErrorSessionNotFound(input.SessionID)
+		span.RecordError(notFoundErr)
+		return notFoundErr
+	}
+
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		if err := c.refreshTokenRepository.RevokeRefreshTokenFamily(ctx, session.FamilyID()); err != nil {
+			return err
+		}
+		// last step, so a denylist failure rolls the revocation back
+		return c.denylist.RevokeSession(ctx, session.FamilyID())
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error revoking session",
+			map[string]any{
+				"trace_id":   traceID,
+				"public_id":  user.PublicID(),
+				"session_id": session.FamilyID(),
+				"error":      err.Error(),
+			})
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type RotateOAuthClientSecret struct {
+	unitOfWork       adapter.UnitOfWork
+	clientRepository port.OAuthClientRepository
+	denylist         adapter.TokenDenylist
+	opaqueToken      adapter.OpaqueToken
+	log              adapter.Logger
+	tracer           adapter.Tracer
+}
+
+func NewRotateOAuthClientSecret(
+	unitOfWork adapter.UnitOfWork,
+	clientRepository port.OAuthClientRepository,
+	denylist adapter.TokenDenylist,
+	opaqueToken adapter.OpaqueToken,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *RotateOAuthClientSecret {
+	return &RotateOAuthClientSecret{
+		unitOfWork:       unitOfWork,
+		clientRepository: clientRepository,
+		denylist:         denylist,
+		opaqueToken:      opaqueToken,
+		log:              log,
+		tracer:           tracer,
+	}
+}
+
+// Execute replaces the secret of a confidential client. The previous secret
+// stops working at once and tokens the client issued for itself are revoked.
+func (c *RotateOAuthClientSecret) Execute(ctx context.Context, input dto.RotateOAuthClientSecretInput) (*dto.RotateOAuthClientSecretOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "RotateOAuthClientSecret.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	client, err := c.clientRepository.FindClientByClientID(ctx, input.ClientID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding OAuth client",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": input.ClientID,
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if client == nil || client.IsDisabled() {
+		notFoundErr := errors.ErrorOAuthClientNotFound(input.ClientID)
+		span.RecordError(notFoundErr)
+		return nil, notFoundErr
+	}
+	if !client.IsConfidential() {
+		publicErr := errors.ErrorPublicOAuthClientSecret(input.ClientID)
+		span.RecordError(publicErr)
+		return nil, publicErr
+	}
+
+	secret, secretHash, err := c.opaqueToken.Generate()
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error generating OAuth client secret",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		updated, err := c.clientRepository.UpdateClientSecret(ctx, client.ClientID(), secretHash, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return errors.ErrorOAuthClientNotFound(client.ClientID())
+		}
+		// last step, so a denylist failure keeps the previous secret
+		return c.denylist.RevokeUserTokens(ctx, client.ClientID(), "")
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error rotating OAuth client secret",
+			map[string]any{
+				"trace_id":  traceID,
+				"client_id": client.ClientID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("OAuth client secret rotated",
+		map[string]any{
+			"trace_id":  traceID,
+			"client_id": client.ClientID(),
+		})
+	return &dto.RotateOAuthClientSecretOutput{ClientID: client.ClientID(), ClientSecret: secret}, nil
+}
@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type StartMFAEnrollment struct {
+	userMFARepository port.UserMFARepository
+	userService       service.UserService
+	totp              adapter.TOTP
+	log               adapter.Logger
+	tracer            adapter.Tracer
+}
+
+func NewStartMFAEnrollment(
+	userMFARepository port.UserMFARepository,
+	userService service.UserService,
+	totp adapter.TOTP,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *StartMFAEnrollment {
+	return &StartMFAEnrollment{
+		userMFARepository: userMFARepository,
+		userService:       userService,
+		totp:              totp,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+// Execute creates a TOTP secret for the authenticated user. It is not used
+// for sign-in until ConfirmMFAEnrollment proves the authenticator holds it;
+// calling Execute again before that replaces the secret.
+func (c *StartMFAEnrollment) Execute(ctx context.Context) (*dto.MFAEnrollmentOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "StartMFAEnrollment.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	mfa, err := c.userMFARepository.FindUserMFA(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding MFA enrollment",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if mfa != nil && mfa.IsConfirmed() {
+		enabledErr := errors.ErrorMFAAlreadyEnabled()
+		span.RecordError(enabledErr)
+		return nil, enabledErr
+	}
+
+	secret, err := c.totp.GenerateSecret()
+	if err != nil {
+		span.RecordError(err)
+		c.log.CriticalJSON("Error generating MFA secret",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	err = c.userMFARepository.SaveUserMFA(ctx, entity.BuilderUserMFA().
+		WithUserID(user.ID()).
+		WithTOTPSecret(secret).
+		WithCreatedAt(now).
+		WithUpdatedAt(now).
+		Build())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error saving MFA enrollment",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("MFA enrollment started",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	return &dto.MFAEnrollmentOutput{
+		Secret:     secret,
+		OTPAuthURI: c.totp.ProvisioningURI(secret, user.Email()),
+	}, nil
+}
@@ -0,0 +1,122 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const oauthStatePrefix = "auth:oauth:state:"
+
+type StartOAuthLogin struct {
+	providers       map[string]adapter.IdentityProvider
+	cache           adapter.Cache
+	opaqueToken     adapter.OpaqueToken
+	callbackBaseURL string
+	stateTTL        time.Duration
+	log             adapter.Logger
+	tracer          adapter.Tracer
+}
+
+func NewStartOAuthLogin(
+	providers map[string]adapter.IdentityProvider,
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	callbackBaseURL string,
+	stateTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *StartOAuthLogin {
+	return &StartOAuthLogin{
+		providers:       providers,
+		cache:           cache,
+		opaqueToken:     opaqueToken,
+		callbackBaseURL: callbackBaseURL,
+		stateTTL:        stateTTL,
+		log:             log,
+		tracer:          tracer,
+	}
+}
+
+// Execute prepares an authorization request: state, PKCE verifier and nonce
+// are generated here and kept in the cache until the provider redirects back.
+// The hash of the state is returned as the binding the browser keeps, so a
+// callback carrying someone else's state is refused.
+func (c *StartOAuthLogin) Execute(ctx context.Context, input dto.StartOAuthLoginInput) (*dto.StartOAuthLoginOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "StartOAuthLogin.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	provider, ok := c.providers[input.Provider]
+	if !ok {
+		providerErr := errors.ErrorUnknownOAuthProvider(input.Provider)
+		span.RecordError(providerErr)
+		c.log.WarnJSON("Unknown OAuth provider",
+			map[string]any{
+				"trace_id": traceID,
+				"provider": input.Provider,
+			})
+		return nil, providerErr
+	}
+
+	login := vo.OAuthLoginState{
+		Provider:    input.Provider,
+		RedirectURI: oauthRedirectURI(c.callbackBaseURL, input.Provider),
+	}
+	for _, target := range []*string{&login.State, &login.CodeVerifier, &login.Nonce} {
+		token, _, err := c.opaqueToken.Generate()
+		if err != nil {
+			span.RecordError(err)
+			c.log.ErrorJSON("Error generating OAuth state",
+				map[string]any{
+					"trace_id": traceID,
+					"error":    err.Error(),
+				})
+			return nil, err
+		}
+		*target = token
+	}
+
+	authorizationURL, err := provider.AuthorizationURL(ctx, login)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error building OAuth authorization URL",
+			map[string]any{
+				"trace_id": traceID,
+				"provider": input.Provider,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	stateHash := c.opaqueToken.Hash(login.State)
+	if err := c.cache.Set(ctx, oauthStatePrefix+stateHash, login, int(c.stateTTL.Seconds())); err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error storing OAuth state",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("OAuth login started",
+		map[string]any{
+			"trace_id": traceID,
+			"provider": input.Provider,
+		})
+	return &dto.StartOAuthLoginOutput{
+		AuthorizationURL: authorizationURL,
+		StateBinding:     stateHash,
+		StateTTL:         c.stateTTL,
+	}, nil
+}
+
+func oauthRedirectURI(callbackBaseURL, provider string) string {
+	return strings.TrimSuffix(callbackBaseURL, "/") + "/" + provider + "/callback"
+}
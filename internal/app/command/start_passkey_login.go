@@ -0,0 +1,69 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type StartPasskeyLogin struct {
+	cache        adapter.Cache
+	opaqueToken  adapter.OpaqueToken
+	rpID         string
+	challengeTTL time.Duration
+	log          adapter.Logger
+	tracer       adapter.Tracer
+}
+
+func NewStartPasskeyLogin(
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	rpID string,
+	challengeTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *StartPasskeyLogin {
+	return &StartPasskeyLogin{
+		cache:        cache,
+		opaqueToken:  opaqueToken,
+		rpID:         rpID,
+		challengeTTL: challengeTTL,
+		log:          log,
+		tracer:       tracer,
+	}
+}
+
+// Execute returns the options of navigator.credentials.get. No credentials
+// are listed: the authenticator offers the passkeys it holds for the relying
+// party, so the user is not asked for an e-mail and none is disclosed.
+func (c *StartPasskeyLogin) Execute(ctx context.Context) (*dto.PasskeyRequestOptionsOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "StartPasskeyLogin.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	challenge, err := startPasskeyCeremony(ctx, c.cache, c.opaqueToken,
+		vo.WebAuthnSession{Ceremony: vo.WebAuthnCeremonyLogin}, c.challengeTTL)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error starting passkey login",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	return &dto.PasskeyRequestOptionsOutput{
+		PublicKey: dto.PasskeyRequestOptions{
+			Challenge:        challenge,
+			Timeout:          c.challengeTTL.Milliseconds(),
+			RPID:             c.rpID,
+			AllowCredentials: []dto.PasskeyCredentialDescriptor{},
+			UserVerification: "required",
+		},
+	}, nil
+}
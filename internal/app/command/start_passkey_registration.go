@@ -0,0 +1,122 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type StartPasskeyRegistration struct {
+	credentialRepository port.WebAuthnCredentialRepository
+	userService          service.UserService
+	cache                adapter.Cache
+	opaqueToken          adapter.OpaqueToken
+	rpID                 string
+	rpName               string
+	challengeTTL         time.Duration
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewStartPasskeyRegistration(
+	credentialRepository port.WebAuthnCredentialRepository,
+	userService service.UserService,
+	cache adapter.Cache,
+	opaqueToken adapter.OpaqueToken,
+	rpID string,
+	rpName string,
+	challengeTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *StartPasskeyRegistration {
+	return &StartPasskeyRegistration{
+		credentialRepository: credentialRepository,
+		userService:          userService,
+		cache:                cache,
+		opaqueToken:          opaqueToken,
+		rpID:                 rpID,
+		rpName:               rpName,
+		challengeTTL:         challengeTTL,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+// Execute returns the options of navigator.credentials.create. Passkeys are
+// discoverable credentials, so the login needs no e-mail, and the user
+// handle is the public ID. The passkeys the user already holds are excluded
+// so an authenticator is not registered twice.
+func (c *StartPasskeyRegistration) Execute(ctx context.Context) (*dto.PasskeyCreationOptionsOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "StartPasskeyRegistration.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	credentials, err := c.credentialRepository.ListCredentialsByUserID(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error listing passkeys",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	challenge, err := startPasskeyCeremony(ctx, c.cache, c.opaqueToken,
+		vo.WebAuthnSession{Ceremony: vo.WebAuthnCeremonyRegistration, UserID: user.ID()}, c.challengeTTL)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error starting passkey registration",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	params := make([]dto.PasskeyCredentialParameter, 0, len(vo.COSEAlgorithms))
+	for _, algorithm := range vo.COSEAlgorithms {
+		params = append(params, dto.PasskeyCredentialParameter{Type: passkeyCredentialType, Algorithm: algorithm})
+	}
+
+	c.log.InfoJSON("Passkey registration started",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+	return &dto.PasskeyCreationOptionsOutput{
+		PublicKey: dto.PasskeyCreationOptions{
+			RP: dto.PasskeyRelyingParty{ID: c.rpID, Name: c.rpName},
+			User: dto.PasskeyUser{
+				ID:          encodeWebAuthnField([]byte(user.PublicID())),
+				Name:        user.Email(),
+				DisplayName: user.Name(),
+			},
+			Challenge:          challenge,
+			PubKeyCredParams:   params,
+			Timeout:            c.challengeTTL.Milliseconds(),
+			ExcludeCredentials: mapper.ToPasskeyDescriptors(credentials),
+			AuthenticatorSelection: dto.PasskeyAuthenticatorSelection{
+				ResidentKey:        "required",
+				RequireResidentKey: true,
+				UserVerification:   "required",
+			},
+			Attestation: "none",
+		},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type UnlockUser struct {
+	userRepository port.UserRepository
+	loginThrottle  adapter.LoginThrottle
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewUnlockUser(
+	userRepository port.UserRepository,
+	loginThrottle adapter.LoginThrottle,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *UnlockUser {
+	return &UnlockUser{
+		userRepository: userRepository,
+		loginThrottle:  loginThrottle,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+// Execute lifts the lockouts of the user with input.PublicID before they
+// expire, from every address, and forgets the failed attempts behind them.
+func (c *UnlockUser) Execute(ctx context.Context, input dto.UnlockUserInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "UnlockUser.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+	publicID := input.PublicID
+
+	user, err := c.userRepository.FindUserByPublicID(ctx, publicID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by public ID",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": publicID,
+				"error":     err.Error(),
+			})
+		return err
+	}
+	if user == nil {
+		notFoundErr := errors.ErrorUserNotFound(publicID)
+		span.RecordError(notFoundErr)
+		return notFoundErr
+	}
+
+	if err := c.loginThrottle.Unlock(ctx, user.Email()); err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error unlocking user",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": publicID,
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	principal, _ := vo.PrincipalFromContext(ctx)
+	c.log.InfoJSON("User unlocked by administrator",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": publicID,
+			"admin_id":  principal.PublicID,
+		})
+
+	return nil
+}
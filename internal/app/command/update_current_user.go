@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type UpdateCurrentUser struct {
+	userRepository port.UserRepository
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewUpdateCurrentUser(
+	userRepository port.UserRepository,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *UpdateCurrentUser {
+	return &UpdateCurrentUser{
+		userRepository: userRepository,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+func (c *UpdateCurrentUser) Execute(ctx context.Context, input dto.UpdateCurrentUserInput) (*dto.UserProfileOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "UpdateCurrentUser.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	principal, ok := vo.PrincipalFromContext(ctx)
+	if !ok {
+		err := errors.ErrorMissingBearerToken()
+		span.RecordError(err)
+		return nil, err
+	}
+
+	c.log.InfoJSON("Updating current user",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": principal.PublicID,
+			"body":      input,
+		})
+
+	user, err := c.userRepository.FindUserByPublicID(ctx, principal.PublicID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by public id",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+	if user == nil {
+		notFoundErr := errors.ErrorUserNotFound(principal.PublicID)
+		span.RecordError(notFoundErr)
+		return nil, notFoundErr
+	}
+
+	if input.Name == nil && input.AvatarURL == nil {
+		return mapper.ToUserProfileOutput(user), nil
+	}
+
+	if input.Name != nil {
+		user.AssignName(strings.TrimSpace(*input.Name))
+	}
+	if input.AvatarURL != nil {
+		user.AssignAvatarURL(strings.TrimSpace(*input.AvatarURL))
+	}
+
+	isValid := user.ValidateProfile()
+	if isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "user")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("User profile validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return nil, validationErr
+	}
+
+	updated, err := c.userRepository.UpdateUser(ctx, *user)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error updating user",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+	if updated == nil {
+		notFoundErr := errors.ErrorUserNotFound(principal.PublicID)
+		span.RecordError(notFoundErr)
+		return nil, notFoundErr
+	}
+
+	return mapper.ToUserProfileOutput(updated), nil
+}
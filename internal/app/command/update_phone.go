@@ -0,0 +1,104 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type UpdatePhone struct {
+	userService service.UserService
+	otpStore    adapter.OTPStore
+	smsSender   adapter.SmsSender
+	otpTTL      time.Duration
+	log         adapter.Logger
+	tracer      adapter.Tracer
+}
+
+func NewUpdatePhone(
+	userService service.UserService,
+	otpStore adapter.OTPStore,
+	smsSender adapter.SmsSender,
+	otpTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *UpdatePhone {
+	return &UpdatePhone{
+		userService: userService,
+		otpStore:    otpStore,
+		smsSender:   smsSender,
+		otpTTL:      otpTTL,
+		log:         log,
+		tracer:      tracer,
+	}
+}
+
+// Execute texts a verification code to input.Phone. The phone is only stored
+// once the code is confirmed through VerifyPhone.
+func (c *UpdatePhone) Execute(ctx context.Context, input dto.UpdatePhoneInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "UpdatePhone.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	phone := vo.NewPhone(input.Phone)
+	if isValid := phone.Validate(); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "phone")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Phone validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return validationErr
+	}
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	c.log.InfoJSON("Sending phone verification code",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	code, err := c.otpStore.Issue(ctx, phoneVerificationKey(user.PublicID(), phone.String()))
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing phone verification code",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	message := fmt.Sprintf("Your verification code is %s. It expires in %s.", code, c.otpTTL)
+	if err := c.smsSender.Send(ctx, phone.String(), message); err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error sending phone verification code",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	return nil
+}
+
+// phoneVerificationKey binds a code to both the user and the phone it was sent
+// to, so it cannot confirm a different number.
+func phoneVerificationKey(publicID, phone string) string {
+	return "phone_verification:" + publicID + ":" + phone
+}
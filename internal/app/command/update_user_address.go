@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type UpdateUserAddress struct {
+	unitOfWork        adapter.UnitOfWork
+	addressRepository port.AddressRepository
+	userService       service.UserService
+	log               adapter.Logger
+	tracer            adapter.Tracer
+}
+
+func NewUpdateUserAddress(
+	unitOfWork adapter.UnitOfWork,
+	addressRepository port.AddressRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *UpdateUserAddress {
+	return &UpdateUserAddress{
+		unitOfWork:        unitOfWork,
+		addressRepository: addressRepository,
+		userService:       userService,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+// Execute replaces the address identified by input.AddressID. Setting a
+// default flag moves it away from the user's other addresses.
+func (c *UpdateUserAddress) Execute(ctx context.Context, input dto.UpdateUserAddressInput) (*dto.AddressOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "UpdateUserAddress.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	c.log.InfoJSON("Updating user address",
+		map[string]any{
+			"trace_id":   traceID,
+			"public_id":  user.PublicID(),
+			"address_id": input.AddressID,
+		})
+
+	address := mapper.ToAddress(input.AddressInput)
+	if isValid := address.Validate(); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "address")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Address validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return nil, validationErr
+	}
+
+	var updated *entity.Address
+	err = c.unitOfWork.WithTransaction(ctx, func(ctx context.Context) *errors.Error {
+		stored, err := c.addressRepository.FindAddressByPublicID(ctx, user.ID(), input.AddressID)
+		if err != nil {
+			return err
+		}
+		if stored == nil {
+			return errors.ErrorAddressNotFound(input.AddressID)
+		}
+
+		address.AssignID(stored.ID())
+		address.AssignPublicID(stored.PublicID())
+		address.AssignUserID(user.ID())
+
+		if err := c.addressRepository.ClearDefaultAddresses(ctx, user.ID(),
+			address.IsDefaultShipping(), address.IsDefaultBilling()); err != nil {
+			return err
+		}
+
+		updated, err = c.addressRepository.UpdateAddress(ctx, address)
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error updating user address",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	output := mapper.ToAddressOutput(updated)
+	return &output, nil
+}
@@ -0,0 +1,94 @@
+package command
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type VerifyEmail struct {
+	userRepository    port.UserRepository
+	emailVerification service.EmailVerificationService
+	log               adapter.Logger
+	tracer            adapter.Tracer
+}
+
+func NewVerifyEmail(
+	userRepository port.UserRepository,
+	emailVerification service.EmailVerificationService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *VerifyEmail {
+	return &VerifyEmail{
+		userRepository:    userRepository,
+		emailVerification: emailVerification,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+// Execute confirms the e-mail of the user named by a signed verification link.
+// Opening a valid link again is not an error.
+func (c *VerifyEmail) Execute(ctx context.Context, input dto.VerifyEmailInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "VerifyEmail.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := c.userRepository.FindUserByPublicID(ctx, input.PublicID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by public id",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": input.PublicID,
+				"error":     err.Error(),
+			})
+		return err
+	}
+
+	expires, parseErr := strconv.ParseInt(input.Expires, 10, 64)
+	if user == nil || parseErr != nil || !c.emailVerification.IsValidLink(user, time.Unix(expires, 0), input.Signature) {
+		linkErr := errors.ErrorInvalidVerificationLink()
+		span.RecordError(linkErr)
+		c.log.WarnJSON("Invalid e-mail verification link",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": input.PublicID,
+			})
+		return linkErr
+	}
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	updated, err := c.userRepository.MarkEmailVerified(ctx, user.ID(), user.Email(), time.Now().UTC())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error marking e-mail as verified",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+	if !updated {
+		linkErr := errors.ErrorInvalidVerificationLink()
+		span.RecordError(linkErr)
+		return linkErr
+	}
+
+	c.log.InfoJSON("E-mail verified",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+	return nil
+}
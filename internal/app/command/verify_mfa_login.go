@@ -0,0 +1,103 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+type VerifyMFALogin struct {
+	userRepository   port.UserRepository
+	authTokenService service.AuthTokenService
+	mfaService       service.MFAService
+	log              adapter.Logger
+	tracer           adapter.Tracer
+}
+
+func NewVerifyMFALogin(
+	userRepository port.UserRepository,
+	authTokenService service.AuthTokenService,
+	mfaService service.MFAService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *VerifyMFALogin {
+	return &VerifyMFALogin{
+		userRepository:   userRepository,
+		authTokenService: authTokenService,
+		mfaService:       mfaService,
+		log:              log,
+		tracer:           tracer,
+	}
+}
+
+// Execute completes a login that stopped at an MFA challenge: the tokens of
+// the session are issued once input.Code is a valid second factor of the
+// user behind input.MFAToken.
+func (c *VerifyMFALogin) Execute(ctx context.Context, input dto.VerifyMFALoginInput) (*dto.AuthTokenOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "VerifyMFALogin.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+	c.log.InfoJSON("Verifying MFA login",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     logger.RedactStruct[dto.VerifyMFALoginInput](input, "mfa_token", "code"),
+		})
+
+	if input.MFAToken == "" {
+		tokenErr := errors.ErrorInvalidMFAToken()
+		span.RecordError(tokenErr)
+		return nil, tokenErr
+	}
+
+	userID, err := c.mfaService.VerifyChallenge(ctx, input.MFAToken, input.Code)
+	if err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("MFA challenge failed",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	user, err := c.userRepository.FindUserByID(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by ID",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+	if user == nil {
+		tokenErr := errors.ErrorInvalidMFAToken()
+		span.RecordError(tokenErr)
+		return nil, tokenErr
+	}
+
+	tokens, err := c.authTokenService.IssueTokens(ctx, user, "")
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing auth tokens",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	c.log.InfoJSON("MFA login completed",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+	return mapper.ToAuthTokenOutput(tokens), nil
+}
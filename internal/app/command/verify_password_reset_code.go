@@ -0,0 +1,126 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+)
+
+type VerifyPasswordResetCode struct {
+	unitOfWork             adapter.UnitOfWork
+	userRepository         port.UserRepository
+	oneTimeTokenRepository port.OneTimeTokenRepository
+	otpStore               adapter.OTPStore
+	opaqueToken            adapter.OpaqueToken
+	resetTTL               time.Duration
+	log                    adapter.Logger
+	tracer                 adapter.Tracer
+}
+
+func NewVerifyPasswordResetCode(
+	unitOfWork adapter.UnitOfWork,
+	userRepository port.UserRepository,
+	oneTimeTokenRepository port.OneTimeTokenRepository,
+	otpStore adapter.OTPStore,
+	opaqueToken adapter.OpaqueToken,
+	resetTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *VerifyPasswordResetCode {
+	return &VerifyPasswordResetCode{
+		unitOfWork:             unitOfWork,
+		userRepository:         userRepository,
+		oneTimeTokenRepository: oneTimeTokenRepository,
+		otpStore:               otpStore,
+		opaqueToken:            opaqueToken,
+		resetTTL:               resetTTL,
+		log:                    log,
+		tracer:                 tracer,
+	}
+}
+
+// Execute exchanges a code sent by ForgotPasswordSms for a reset token, which
+// is then redeemed through ResetPassword like the one sent by e-mail.
+func (c *VerifyPasswordResetCode) Execute(ctx context.Context, input dto.VerifyPasswordResetCodeInput) (*dto.PasswordResetTokenOutput, *errors.Error) {
+	ctx, span := c.tracer.Start(ctx, "VerifyPasswordResetCode.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	c.log.InfoJSON("Verifying password reset code",
+		map[string]any{
+			"trace_id": traceID,
+			"body":     logger.RedactStruct[dto.VerifyPasswordResetCodeInput](input, "code"),
+		})
+
+	phone := vo.NewPhone(input.Phone)
+	if isValid := phone.Validate(); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "phone")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Phone validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return nil, validationErr
+	}
+
+	valid, err := c.otpStore.Verify(ctx, passwordResetCodeKey(phone.String()), input.Code)
+	if err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Error verifying password reset code",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+	if !valid {
+		invalidErr := errors.ErrorInvalidResetCode()
+		span.RecordError(invalidErr)
+		c.log.WarnJSON("Invalid password reset code",
+			map[string]any{
+				"trace_id": traceID,
+			})
+		return nil, invalidErr
+	}
+
+	user, err := c.userRepository.FindUserByVerifiedPhone(ctx, phone.String())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error finding user by phone",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+	// the phone may have been removed or the account deleted since the code was sent
+	if user == nil {
+		invalidErr := errors.ErrorInvalidResetCode()
+		span.RecordError(invalidErr)
+		return nil, invalidErr
+	}
+
+	token, expiresAt, err := issuePasswordResetToken(ctx, c.unitOfWork, c.oneTimeTokenRepository, c.opaqueToken, user.ID(), c.resetTTL)
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error issuing password reset token",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	return &dto.PasswordResetTokenOutput{
+		ResetToken: token,
+		ExpiresAt:  expiresAt.Format(time.RFC3339),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type VerifyPhone struct {
+	userRepository port.UserRepository
+	userService    service.UserService
+	otpStore       adapter.OTPStore
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewVerifyPhone(
+	userRepository port.UserRepository,
+	userService service.UserService,
+	otpStore adapter.OTPStore,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *VerifyPhone {
+	return &VerifyPhone{
+		userRepository: userRepository,
+		userService:    userService,
+		otpStore:       otpStore,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+// Execute stores input.Phone as the verified phone of the authenticated user
+// when input.Code matches the one sent by UpdatePhone.
+func (c *VerifyPhone) Execute(ctx context.Context, input dto.VerifyPhoneInput) *errors.Error {
+	ctx, span := c.tracer.Start(ctx, "VerifyPhone.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	phone := vo.NewPhone(input.Phone)
+	if isValid := phone.Validate(); isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "phone")
+		span.RecordError(validationErr)
+		c.log.WarnJSON("Phone validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return validationErr
+	}
+
+	user, err := c.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	valid, err := c.otpStore.Verify(ctx, phoneVerificationKey(user.PublicID(), phone.String()), input.Code)
+	if err != nil {
+		span.RecordError(err)
+		c.log.WarnJSON("Error verifying phone code",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+	if !valid {
+		invalidErr := errors.ErrorInvalidPhoneVerificationCode()
+		span.RecordError(invalidErr)
+		c.log.WarnJSON("Invalid phone verification code",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+			})
+		return invalidErr
+	}
+
+	updated, err := c.userRepository.UpdateUserPhone(ctx, user.ID(), phone.String(), time.Now().UTC())
+	if err != nil {
+		span.RecordError(err)
+		c.log.ErrorJSON("Error updating user phone",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return err
+	}
+	if !updated {
+		notFoundErr := errors.ErrorUserNotFound(user.PublicID())
+		span.RecordError(notFoundErr)
+		return notFoundErr
+	}
+
+	c.log.InfoJSON("Phone verified",
+		map[string]any{
+			"trace_id":  traceID,
+			"public_id": user.PublicID(),
+		})
+
+	return nil
+}
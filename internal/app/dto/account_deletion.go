@@ -0,0 +1,11 @@
+package dto
+
+type DeleteCurrentUserOutput struct {
+	DeletedAt    string `json:"deleted_at"`
+	RestoreUntil string `json:"restore_until"`
+}
+
+type RestoreAuthUserInput struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
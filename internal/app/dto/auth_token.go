@@ -0,0 +1,13 @@
+package dto
+
+type RefreshAuthTokenInput struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+type AuthTokenOutput struct {
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresAt        string `json:"expires_at"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresAt string `json:"refresh_expires_at"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type ChangePasswordInput struct {
+	CurrentPassword string `json:"current_password"`
+	Password        string `json:"password"`
+	PasswordConfirm string `json:"password_confirm"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+type RequestDataExportInput struct {
+	Format string `json:"format"`
+}
+
+type GetDataExportInput struct {
+	ExportID string `json:"-"`
+}
+
+type DownloadDataExportInput struct {
+	ExportID  string
+	Expires   string
+	Signature string
+}
+
+type DataExportOutput struct {
+	ID                string  `json:"id"`
+	Format            string  `json:"format"`
+	Status            string  `json:"status"`
+	RequestedAt       string  `json:"requested_at"`
+	CompletedAt       *string `json:"completed_at"`
+	ExpiresAt         *string `json:"expires_at"`
+	DownloadURL       string  `json:"download_url,omitempty"`
+	DownloadExpiresAt string  `json:"download_expires_at,omitempty"`
+}
+
+type DataExportsOutput struct {
+	Exports []DataExportOutput `json:"exports"`
+}
+
+type DataExportFile struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
@@ -0,0 +1,11 @@
+package dto
+
+type VerifyEmailInput struct {
+	PublicID  string
+	Expires   string
+	Signature string
+}
+
+type ResendEmailVerificationInput struct {
+	Email string `json:"email"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+// ListUsersInput carries the raw query string of GET /admin/users.
+type ListUsersInput struct {
+	Role        string
+	CreatedFrom string
+	CreatedTo   string
+	Deleted     string
+	Email       string
+	Name        string
+	Sort        string
+	Limit       string
+	Cursor      string
+}
+
+type AdminUserOutput struct {
+	PublicID  string  `json:"public_id"`
+	Name      string  `json:"name"`
+	Email     string  `json:"email"`
+	Role      string  `json:"role"`
+	AvatarURL string  `json:"avatar_url,omitempty"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+	DeletedAt *string `json:"deleted_at"`
+}
+
+type CursorPagination struct {
+	Limit      int     `json:"limit"`
+	Sort       string  `json:"sort"`
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+}
+
+type ListUsersOutput struct {
+	Users      []AdminUserOutput `json:"users"`
+	Pagination CursorPagination  `json:"pagination"`
+}
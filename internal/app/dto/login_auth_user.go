@@ -0,0 +1,15 @@
+package dto
+
+type LoginAuthUserInput struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// LoginAuthUserOutput carries the tokens of the session, or, for users with
+// MFA, the token of the challenge to complete at /auth/login/mfa.
+type LoginAuthUserOutput struct {
+	*AuthTokenOutput
+	MFARequired       bool   `json:"mfa_required,omitempty"`
+	MFAToken          string `json:"mfa_token,omitempty"`
+	MFATokenExpiresAt string `json:"mfa_token_expires_at,omitempty"`
+}
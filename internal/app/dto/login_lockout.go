@@ -0,0 +1,5 @@
+package dto
+
+type UnlockUserInput struct {
+	PublicID string `json:"-"`
+}
@@ -0,0 +1,6 @@
+package dto
+
+type LogoutAuthUserInput struct {
+	AccessToken string `json:"-"`
+	AllSessions bool   `json:"all_sessions"`
+}
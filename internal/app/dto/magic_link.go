@@ -0,0 +1,19 @@
+package dto
+
+type RequestMagicLinkInput struct {
+	Email string `json:"email"`
+}
+
+// MagicLinkRequestedOutput carries the nonce the requesting browser must keep
+// and present with the link; the link alone does not sign anyone in.
+type MagicLinkRequestedOutput struct {
+	Nonce     string `json:"nonce"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type ConsumeMagicLinkInput struct {
+	Token     string `json:"token"`
+	Expires   string `json:"expires"`
+	Signature string `json:"signature"`
+	Nonce     string `json:"nonce"`
+}
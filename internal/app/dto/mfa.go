@@ -0,0 +1,23 @@
+package dto
+
+type MFACodeInput struct {
+	Code string `json:"code"`
+}
+
+type VerifyMFALoginInput struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+type ResetUserMFAInput struct {
+	PublicID string `json:"-"`
+}
+
+type MFAEnrollmentOutput struct {
+	Secret     string `json:"secret"`
+	OTPAuthURI string `json:"otpauth_uri"`
+}
+
+type MFARecoveryCodesOutput struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
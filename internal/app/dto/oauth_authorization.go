@@ -0,0 +1,42 @@
+package dto
+
+type OAuthAuthorizationRequest struct {
+	ResponseType        string `json:"response_type"`
+	ClientID            string `json:"client_id"`
+	RedirectURI         string `json:"redirect_uri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	CodeChallenge       string `json:"code_challenge"`
+	CodeChallengeMethod string `json:"code_challenge_method"`
+	Nonce               string `json:"nonce"`
+}
+
+// AuthorizeOAuthClientInput is sent by the consent page once the user is
+// signed in. A nil Approve asks to reuse a previous consent.
+type AuthorizeOAuthClientInput struct {
+	OAuthAuthorizationRequest
+	Approve *bool `json:"approve"`
+}
+
+type OAuthRedirectOutput struct {
+	RedirectTo string `json:"redirect_to"`
+}
+
+type OAuthTokenInput struct {
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	ClientID     string
+	ClientSecret string
+	CodeVerifier string
+	Scope        string
+	Audience     string
+}
+
+type OAuthTokenOutput struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+	IDToken     string `json:"id_token,omitempty"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+type RegisterOAuthClientInput struct {
+	Name         string   `json:"name"`
+	RedirectURIs []string `json:"redirect_uris"`
+	Scopes       []string `json:"scopes"`
+	Confidential bool     `json:"confidential"`
+}
+
+// RegisterOAuthClientOutput carries the client secret, which is shown only
+// once: just its hash is stored.
+type RegisterOAuthClientOutput struct {
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Name         string   `json:"name"`
+	GrantTypes   []string `json:"grant_types"`
+	RedirectURIs []string `json:"redirect_uris,omitempty"`
+	Scopes       []string `json:"scopes"`
+	Audiences    []string `json:"audiences,omitempty"`
+	CreatedAt    string   `json:"created_at"`
+}
+
+// RegisterServiceClientInput describes a service calling other services with
+// client_credentials tokens; it is always confidential.
+type RegisterServiceClientInput struct {
+	Name      string   `json:"name"`
+	Scopes    []string `json:"scopes"`
+	Audiences []string `json:"audiences"`
+}
+
+type RotateOAuthClientSecretInput struct {
+	ClientID string
+}
+
+type RotateOAuthClientSecretOutput struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+type DisableOAuthClientInput struct {
+	ClientID string
+}
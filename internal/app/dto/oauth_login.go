@@ -0,0 +1,23 @@
+package dto
+
+import "time"
+
+type StartOAuthLoginInput struct {
+	Provider string
+}
+
+// StartOAuthLoginOutput carries, besides the provider URL, the binding the
+// browser must keep for StateTTL and send back with the callback.
+type StartOAuthLoginOutput struct {
+	AuthorizationURL string
+	StateBinding     string
+	StateTTL         time.Duration
+}
+
+type CompleteOAuthLoginInput struct {
+	Provider     string
+	Code         string
+	State        string
+	StateBinding string
+	Error        string
+}
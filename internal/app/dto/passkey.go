@@ -0,0 +1,116 @@
+package dto
+
+// The passkey ceremonies exchange the JSON forms of the WebAuthn Level 3
+// dictionaries, so browsers can pass them to
+// PublicKeyCredential.parseCreationOptionsFromJSON/parseRequestOptionsFromJSON
+// and send credential.toJSON() back. Binary values are base64url encoded.
+
+type PasskeyRelyingParty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type PasskeyUser struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+type PasskeyCredentialParameter struct {
+	Type      string `json:"type"`
+	Algorithm int64  `json:"alg"`
+}
+
+type PasskeyCredentialDescriptor struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type PasskeyAuthenticatorSelection struct {
+	ResidentKey        string `json:"residentKey"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification"`
+}
+
+type PasskeyCreationOptions struct {
+	RP                     PasskeyRelyingParty           `json:"rp"`
+	User                   PasskeyUser                   `json:"user"`
+	Challenge              string                        `json:"challenge"`
+	PubKeyCredParams       []PasskeyCredentialParameter  `json:"pubKeyCredParams"`
+	Timeout                int64                         `json:"timeout"`
+	ExcludeCredentials     []PasskeyCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection PasskeyAuthenticatorSelection `json:"authenticatorSelection"`
+	Attestation            string                        `json:"attestation"`
+}
+
+type PasskeyRequestOptions struct {
+	Challenge        string                        `json:"challenge"`
+	Timeout          int64                         `json:"timeout"`
+	RPID             string                        `json:"rpId"`
+	AllowCredentials []PasskeyCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                        `json:"userVerification"`
+}
+
+type PasskeyCreationOptionsOutput struct {
+	PublicKey PasskeyCreationOptions `json:"publicKey"`
+}
+
+type PasskeyRequestOptionsOutput struct {
+	PublicKey PasskeyRequestOptions `json:"publicKey"`
+}
+
+type PasskeyAttestationResponse struct {
+	ClientDataJSON    string   `json:"clientDataJSON"`
+	AttestationObject string   `json:"attestationObject"`
+	Transports        []string `json:"transports"`
+}
+
+type PasskeyAttestationCredential struct {
+	ID       string                     `json:"id"`
+	RawID    string                     `json:"rawId"`
+	Type     string                     `json:"type"`
+	Response PasskeyAttestationResponse `json:"response"`
+}
+
+type PasskeyAssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON"`
+	AuthenticatorData string `json:"authenticatorData"`
+	Signature         string `json:"signature"`
+	UserHandle        string `json:"userHandle"`
+}
+
+type PasskeyAssertionCredential struct {
+	ID       string                   `json:"id"`
+	RawID    string                   `json:"rawId"`
+	Type     string                   `json:"type"`
+	Response PasskeyAssertionResponse `json:"response"`
+}
+
+type FinishPasskeyRegistrationInput struct {
+	Name       string                       `json:"name"`
+	Credential PasskeyAttestationCredential `json:"credential"`
+}
+
+type FinishPasskeyLoginInput struct {
+	Credential PasskeyAssertionCredential `json:"credential"`
+}
+
+type DeletePasskeyInput struct {
+	CredentialID string `json:"-"`
+}
+
+type PasskeyOutput struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Transports     []string `json:"transports"`
+	AAGUID         string   `json:"aaguid,omitempty"`
+	BackupEligible bool     `json:"backup_eligible"`
+	BackedUp       bool     `json:"backed_up"`
+	CreatedAt      string   `json:"created_at"`
+	LastUsedAt     string   `json:"last_used_at,omitempty"`
+}
+
+type PasskeysOutput struct {
+	Passkeys []PasskeyOutput `json:"passkeys"`
+}
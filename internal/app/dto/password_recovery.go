@@ -0,0 +1,25 @@
+package dto
+
+type ForgotPasswordInput struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordInput struct {
+	Token           string `json:"token"`
+	Password        string `json:"password"`
+	PasswordConfirm string `json:"password_confirm"`
+}
+
+type ForgotPasswordSmsInput struct {
+	Phone string `json:"phone"`
+}
+
+type VerifyPasswordResetCodeInput struct {
+	Phone string `json:"phone"`
+	Code  string `json:"code"`
+}
+
+type PasswordResetTokenOutput struct {
+	ResetToken string `json:"reset_token"`
+	ExpiresAt  string `json:"expires_at"`
+}
@@ -0,0 +1,92 @@
+package dto
+
+// PersonalDataBundleVersion is bumped whenever the bundle layout changes.
+const PersonalDataBundleVersion = "2.0"
+
+// PersonalDataBundle is everything the service stores about a user. Secrets
+// keep their keys but are redacted before the bundle is encoded.
+type PersonalDataBundle struct {
+	Version          string                     `json:"version"`
+	GeneratedAt      string                     `json:"generated_at"`
+	Profile          PersonalDataProfile        `json:"profile"`
+	Addresses        []AddressOutput            `json:"addresses"`
+	Sessions         []PersonalDataSession      `json:"sessions"`
+	RefreshTokens    []PersonalDataRefreshToken `json:"refresh_tokens"`
+	LinkedIdentities []PersonalDataIdentity     `json:"linked_identities"`
+	OAuthConsents    []PersonalDataConsent      `json:"oauth_consents"`
+	Passkeys         []PersonalDataPasskey      `json:"passkeys"`
+	MFA              *PersonalDataMFA           `json:"mfa"`
+	Exports          []PersonalDataExport       `json:"exports"`
+}
+
+type PersonalDataProfile struct {
+	PublicID        string  `json:"public_id"`
+	Email           string  `json:"email"`
+	EmailVerifiedAt *string `json:"email_verified_at"`
+	Name            string  `json:"name"`
+	Role            string  `json:"role"`
+	AvatarURL       string  `json:"avatar_url,omitempty"`
+	Phone           string  `json:"phone,omitempty"`
+	PhoneVerifiedAt *string `json:"phone_verified_at,omitempty"`
+	PasswordHash    string  `json:"password_hash"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+	DeletedAt       *string `json:"deleted_at"`
+}
+
+type PersonalDataSession struct {
+	SessionID  string `json:"session_id"`
+	DeviceName string `json:"device_name"`
+	UserAgent  string `json:"user_agent"`
+	IPAddress  string `json:"ip_address"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+}
+
+type PersonalDataRefreshToken struct {
+	SessionID string  `json:"session_id"`
+	TokenHash string  `json:"token_hash"`
+	IssuedAt  string  `json:"issued_at"`
+	ExpiresAt string  `json:"expires_at"`
+	RotatedAt *string `json:"rotated_at"`
+	RevokedAt *string `json:"revoked_at"`
+}
+
+type PersonalDataIdentity struct {
+	Provider    string `json:"provider"`
+	Subject     string `json:"subject"`
+	Email       string `json:"email"`
+	CreatedAt   string `json:"created_at"`
+	LastLoginAt string `json:"last_login_at"`
+}
+
+type PersonalDataConsent struct {
+	ClientName string   `json:"client_name"`
+	Scopes     []string `json:"scopes"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+type PersonalDataPasskey struct {
+	CredentialID   string   `json:"credential_id"`
+	Name           string   `json:"name"`
+	AAGUID         string   `json:"aaguid"`
+	Transports     []string `json:"transports"`
+	BackupEligible bool     `json:"backup_eligible"`
+	BackedUp       bool     `json:"backed_up"`
+	CreatedAt      string   `json:"created_at"`
+	LastUsedAt     *string  `json:"last_used_at"`
+}
+
+type PersonalDataMFA struct {
+	TOTPSecret  string  `json:"totp_secret"`
+	ConfirmedAt *string `json:"confirmed_at"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type PersonalDataExport struct {
+	ID          string `json:"id"`
+	Format      string `json:"format"`
+	Status      string `json:"status"`
+	RequestedAt string `json:"requested_at"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+type UpdatePhoneInput struct {
+	Phone string `json:"phone"`
+}
+
+type VerifyPhoneInput struct {
+	Phone string `json:"phone"`
+	Code  string `json:"code"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+type RevokeSessionInput struct {
+	SessionID string `json:"-"`
+}
+
+type SessionOutput struct {
+	ID         string `json:"id"`
+	DeviceName string `json:"device_name"`
+	UserAgent  string `json:"user_agent"`
+	IPAddress  string `json:"ip_address"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	Current    bool   `json:"current"`
+}
+
+type SessionsOutput struct {
+	Sessions []SessionOutput `json:"sessions"`
+}
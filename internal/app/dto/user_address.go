@@ -0,0 +1,47 @@
+package dto
+
+type AddressInput struct {
+	Label             string `json:"label"`
+	Street            string `json:"street"`
+	Number            string `json:"number"`
+	Complement        string `json:"complement"`
+	Neighborhood      string `json:"neighborhood"`
+	City              string `json:"city"`
+	State             string `json:"state"`
+	ZipCode           string `json:"zip_code"`
+	IsDefaultShipping bool   `json:"is_default_shipping"`
+	IsDefaultBilling  bool   `json:"is_default_billing"`
+}
+
+type CreateUserAddressesInput struct {
+	Addresses []AddressInput `json:"addresses"`
+}
+
+type UpdateUserAddressInput struct {
+	AddressID string `json:"-"`
+	AddressInput
+}
+
+type DeleteUserAddressInput struct {
+	AddressID string `json:"-"`
+}
+
+type AddressOutput struct {
+	PublicID          string `json:"public_id"`
+	Label             string `json:"label,omitempty"`
+	Street            string `json:"street"`
+	Number            string `json:"number"`
+	Complement        string `json:"complement,omitempty"`
+	Neighborhood      string `json:"neighborhood"`
+	City              string `json:"city"`
+	State             string `json:"state"`
+	ZipCode           string `json:"zip_code"`
+	IsDefaultShipping bool   `json:"is_default_shipping"`
+	IsDefaultBilling  bool   `json:"is_default_billing"`
+	CreatedAt         string `json:"created_at"`
+	UpdatedAt         string `json:"updated_at"`
+}
+
+type UserAddressesOutput struct {
+	Addresses []AddressOutput `json:"addresses"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+// UpdateCurrentUserInput is a partial update: nil fields are left untouched
+// and an empty avatar_url removes the avatar.
+type UpdateCurrentUserInput struct {
+	Name      *string `json:"name,omitempty"`
+	AvatarURL *string `json:"avatar_url,omitempty"`
+}
+
+type UserProfileOutput struct {
+	PublicID      string `json:"public_id"`
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Role          string `json:"role"`
+	AvatarURL     string `json:"avatar_url,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+)
+
+func ToDeleteCurrentUserOutput(deletedAt time.Time, gracePeriod time.Duration) *dto.DeleteCurrentUserOutput {
+	const layout = "2006-01-02T15:04:05.000000Z"
+	return &dto.DeleteCurrentUserOutput{
+		DeletedAt:    deletedAt.Format(layout),
+		RestoreUntil: deletedAt.Add(gracePeriod).Format(layout),
+	}
+}
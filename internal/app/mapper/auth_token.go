@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const TokenTypeBearer = "Bearer"
+
+const tokenTimeLayout = "2006-01-02T15:04:05.000000Z"
+
+func ToAuthTokenOutput(tokens *vo.AuthTokens) *dto.AuthTokenOutput {
+	return &dto.AuthTokenOutput{
+		AccessToken:      tokens.Access.Token,
+		TokenType:        TokenTypeBearer,
+		ExpiresAt:        tokens.Access.ExpiresAt.UTC().Format(tokenTimeLayout),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: tokens.RefreshExpiresAt.UTC().Format(tokenTimeLayout),
+	}
+}
+
+func ToLoginAuthUserOutput(tokens *vo.AuthTokens) *dto.LoginAuthUserOutput {
+	return &dto.LoginAuthUserOutput{AuthTokenOutput: ToAuthTokenOutput(tokens)}
+}
+
+func ToMFAChallengeOutput(challenge *vo.MFAChallenge) *dto.LoginAuthUserOutput {
+	return &dto.LoginAuthUserOutput{
+		MFARequired:       true,
+		MFAToken:          challenge.Token,
+		MFATokenExpiresAt: challenge.ExpiresAt.UTC().Format(tokenTimeLayout),
+	}
+}
@@ -0,0 +1,136 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+)
+
+const dataExportLayout = "2006-01-02T15:04:05.000000Z"
+
+func ToDataExportOutput(export *entity.DataExport) dto.DataExportOutput {
+	return dto.DataExportOutput{
+		ID:          export.PublicID(),
+		Format:      string(export.Format()),
+		Status:      string(export.Status()),
+		RequestedAt: export.RequestedAt().Format(dataExportLayout),
+		CompletedAt: formatOptionalTime(export.CompletedAt()),
+		ExpiresAt:   formatOptionalTime(export.ExpiresAt()),
+	}
+}
+
+func ToPersonalDataBundle(
+	user *entity.User,
+	addresses []entity.Address,
+	sessions []entity.Session,
+	tokens []entity.RefreshToken,
+	identities []entity.UserIdentity,
+	consents []entity.OAuthConsent,
+	passkeys []entity.WebAuthnCredential,
+	mfa *entity.UserMFA,
+	exports []entity.DataExport,
+	generatedAt time.Time,
+) dto.PersonalDataBundle {
+	bundle := dto.PersonalDataBundle{
+		Version:     dto.PersonalDataBundleVersion,
+		GeneratedAt: generatedAt.Format(dataExportLayout),
+		Profile: dto.PersonalDataProfile{
+			PublicID:        user.PublicID(),
+			Email:           user.Email(),
+			EmailVerifiedAt: formatOptionalTime(user.EmailVerifiedAt()),
+			Name:            user.Name(),
+			Role:            user.Role(),
+			AvatarURL:       user.AvatarURL(),
+			Phone:           user.Phone(),
+			PhoneVerifiedAt: formatOptionalTime(user.PhoneVerifiedAt()),
+			PasswordHash:    user.PasswordHash(),
+			CreatedAt:       user.CreateAT().Format(dataExportLayout),
+			UpdatedAt:       user.UpdateAT().Format(dataExportLayout),
+			DeletedAt:       formatOptionalTime(user.DeletedAt()),
+		},
+		Addresses:        make([]dto.AddressOutput, 0, len(addresses)),
+		Sessions:         make([]dto.PersonalDataSession, 0, len(sessions)),
+		RefreshTokens:    make([]dto.PersonalDataRefreshToken, 0, len(tokens)),
+		LinkedIdentities: make([]dto.PersonalDataIdentity, 0, len(identities)),
+		OAuthConsents:    make([]dto.PersonalDataConsent, 0, len(consents)),
+		Passkeys:         make([]dto.PersonalDataPasskey, 0, len(passkeys)),
+		Exports:          make([]dto.PersonalDataExport, 0, len(exports)),
+	}
+	for i := range addresses {
+		bundle.Addresses = append(bundle.Addresses, ToAddressOutput(&addresses[i]))
+	}
+	for i := range sessions {
+		bundle.Sessions = append(bundle.Sessions, dto.PersonalDataSession{
+			SessionID:  sessions[i].FamilyID(),
+			DeviceName: sessions[i].DeviceName(),
+			UserAgent:  sessions[i].UserAgent(),
+			IPAddress:  sessions[i].IPAddress(),
+			CreatedAt:  sessions[i].CreatedAt().Format(dataExportLayout),
+			LastSeenAt: sessions[i].LastSeenAt().Format(dataExportLayout),
+		})
+	}
+	for i := range tokens {
+		bundle.RefreshTokens = append(bundle.RefreshTokens, dto.PersonalDataRefreshToken{
+			SessionID: tokens[i].FamilyID(),
+			TokenHash: tokens[i].TokenHash(),
+			IssuedAt:  tokens[i].CreatedAt().Format(dataExportLayout),
+			ExpiresAt: tokens[i].ExpiresAt().Format(dataExportLayout),
+			RotatedAt: formatOptionalTime(tokens[i].RotatedAt()),
+			RevokedAt: formatOptionalTime(tokens[i].RevokedAt()),
+		})
+	}
+	for i := range identities {
+		bundle.LinkedIdentities = append(bundle.LinkedIdentities, dto.PersonalDataIdentity{
+			Provider:    identities[i].Provider(),
+			Subject:     identities[i].Subject(),
+			Email:       identities[i].Email(),
+			CreatedAt:   identities[i].CreatedAt().Format(dataExportLayout),
+			LastLoginAt: identities[i].LastLoginAt().Format(dataExportLayout),
+		})
+	}
+	for i := range consents {
+		bundle.OAuthConsents = append(bundle.OAuthConsents, dto.PersonalDataConsent{
+			ClientName: consents[i].ClientName(),
+			Scopes:     consents[i].Scopes(),
+			CreatedAt:  consents[i].CreatedAt().Format(dataExportLayout),
+			UpdatedAt:  consents[i].UpdatedAt().Format(dataExportLayout),
+		})
+	}
+	for i := range passkeys {
+		bundle.Passkeys = append(bundle.Passkeys, dto.PersonalDataPasskey{
+			CredentialID:   passkeys[i].CredentialID(),
+			Name:           passkeys[i].Name(),
+			AAGUID:         passkeys[i].AAGUID(),
+			Transports:     passkeys[i].Transports(),
+			BackupEligible: passkeys[i].BackupEligible(),
+			BackedUp:       passkeys[i].BackedUp(),
+			CreatedAt:      passkeys[i].CreatedAt().Format(dataExportLayout),
+			LastUsedAt:     formatOptionalTime(passkeys[i].LastUsedAt()),
+		})
+	}
+	if mfa != nil {
+		bundle.MFA = &dto.PersonalDataMFA{
+			TOTPSecret:  mfa.TOTPSecret(),
+			ConfirmedAt: formatOptionalTime(mfa.ConfirmedAt()),
+			CreatedAt:   mfa.CreatedAt().Format(dataExportLayout),
+		}
+	}
+	for i := range exports {
+		bundle.Exports = append(bundle.Exports, dto.PersonalDataExport{
+			ID:          exports[i].PublicID(),
+			Format:      string(exports[i].Format()),
+			Status:      string(exports[i].Status()),
+			RequestedAt: exports[i].RequestedAt().Format(dataExportLayout),
+		})
+	}
+	return bundle
+}
+
+func formatOptionalTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.Format(dataExportLayout)
+	return &formatted
+}
@@ -0,0 +1,44 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+func ToAdminUserOutput(user *entity.User) dto.AdminUserOutput {
+	const layout = "2006-01-02T15:04:05.000000Z"
+	var deletedAt *string
+	if user.DeletedAt() != nil {
+		formatted := user.DeletedAt().Format(layout)
+		deletedAt = &formatted
+	}
+	return dto.AdminUserOutput{
+		PublicID:  user.PublicID(),
+		Name:      user.Name(),
+		Email:     user.Email(),
+		Role:      user.Role(),
+		AvatarURL: user.AvatarURL(),
+		CreatedAt: user.CreateAT().Format(layout),
+		UpdatedAt: user.UpdateAT().Format(layout),
+		DeletedAt: deletedAt,
+	}
+}
+
+// ToUserCursor builds the cursor that continues the listing after (next) or
+// before (prev) user.
+func ToUserCursor(user *entity.User, search vo.UserSearch, direction vo.CursorDirection) *string {
+	value := user.CreateAT().UTC().Format(time.RFC3339Nano)
+	if search.SortField == vo.UserSortEmail {
+		value = user.Email()
+	}
+	encoded := vo.UserCursor{
+		Sort:      search.Sort(),
+		Value:     value,
+		ID:        user.ID(),
+		Direction: direction,
+	}.Encode()
+	return &encoded
+}
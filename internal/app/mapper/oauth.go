@@ -0,0 +1,51 @@
+package mapper
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+func ToRegisterOAuthClientOutput(client *entity.OAuthClient, secret string) *dto.RegisterOAuthClientOutput {
+	const layout = "2006-01-02T15:04:05.000000Z"
+	return &dto.RegisterOAuthClientOutput{
+		ClientID:     client.ClientID(),
+		ClientSecret: secret,
+		Name:         client.Name(),
+		GrantTypes:   client.GrantTypes(),
+		RedirectURIs: client.RedirectURIs(),
+		Scopes:       client.Scopes(),
+		Audiences:    client.Audiences(),
+		CreatedAt:    client.CreatedAt().UTC().Format(layout),
+	}
+}
+
+func ToOAuthTokenOutput(access *vo.TokenClaims, idToken string, now time.Time) *dto.OAuthTokenOutput {
+	return &dto.OAuthTokenOutput{
+		AccessToken: access.Token,
+		TokenType:   TokenTypeBearer,
+		ExpiresIn:   int64(access.ExpiresAt.Sub(now).Round(time.Second).Seconds()),
+		Scope:       strings.Join(access.Scopes, " "),
+		IDToken:     idToken,
+	}
+}
+
+// ToUserInfo releases the claims about user that scopes allow: the subject
+// always, e-mail claims with the email scope and name claims with profile.
+func ToUserInfo(user *entity.User, scopes []string) vo.UserInfo {
+	info := vo.UserInfo{Subject: user.PublicID()}
+	if slices.Contains(scopes, entity.ScopeEmail) {
+		verified := user.IsEmailVerified()
+		info.Email = user.Email()
+		info.EmailVerified = &verified
+	}
+	if slices.Contains(scopes, entity.ScopeProfile) {
+		info.Name = user.Name()
+		info.Picture = user.AvatarURL()
+	}
+	return info
+}
@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+)
+
+func ToPasskeyOutput(credential *entity.WebAuthnCredential) dto.PasskeyOutput {
+	const layout = "2006-01-02T15:04:05.000000Z"
+	output := dto.PasskeyOutput{
+		ID:             credential.CredentialID(),
+		Name:           credential.Name(),
+		Transports:     credential.Transports(),
+		AAGUID:         credential.AAGUID(),
+		BackupEligible: credential.BackupEligible(),
+		BackedUp:       credential.BackedUp(),
+		CreatedAt:      credential.CreatedAt().Format(layout),
+	}
+	if output.Transports == nil {
+		output.Transports = []string{}
+	}
+	if lastUsedAt := credential.LastUsedAt(); lastUsedAt != nil {
+		output.LastUsedAt = lastUsedAt.Format(layout)
+	}
+	return output
+}
+
+func ToPasskeysOutput(credentials []entity.WebAuthnCredential) *dto.PasskeysOutput {
+	output := &dto.PasskeysOutput{Passkeys: make([]dto.PasskeyOutput, 0, len(credentials))}
+	for i := range credentials {
+		output.Passkeys = append(output.Passkeys, ToPasskeyOutput(&credentials[i]))
+	}
+	return output
+}
+
+func ToPasskeyDescriptors(credentials []entity.WebAuthnCredential) []dto.PasskeyCredentialDescriptor {
+	descriptors := make([]dto.PasskeyCredentialDescriptor, 0, len(credentials))
+	for i := range credentials {
+		descriptors = append(descriptors, dto.PasskeyCredentialDescriptor{
+			Type:       "public-key",
+			ID:         credentials[i].CredentialID(),
+			Transports: credentials[i].Transports(),
+		})
+	}
+	return descriptors
+}
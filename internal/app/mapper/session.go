@@ -0,0 +1,24 @@
+package mapper
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+)
+
+// ToSessionsOutput marks the session of currentSessionID as the current one.
+func ToSessionsOutput(sessions []entity.Session, currentSessionID string) *dto.SessionsOutput {
+	const layout = "2006-01-02T15:04:05.000000Z"
+	output := &dto.SessionsOutput{Sessions: make([]dto.SessionOutput, 0, len(sessions))}
+	for i := range sessions {
+		output.Sessions = append(output.Sessions, dto.SessionOutput{
+			ID:         sessions[i].FamilyID(),
+			DeviceName: sessions[i].DeviceName(),
+			UserAgent:  sessions[i].UserAgent(),
+			IPAddress:  sessions[i].IPAddress(),
+			CreatedAt:  sessions[i].CreatedAt().Format(layout),
+			LastSeenAt: sessions[i].LastSeenAt().Format(layout),
+			Current:    currentSessionID != "" && sessions[i].FamilyID() == currentSessionID,
+		})
+	}
+	return output
+}
@@ -0,0 +1,50 @@
+package mapper
+
+import (
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+)
+
+func ToAddress(input dto.AddressInput) entity.Address {
+	return entity.BuilderAddress().
+		WithLabel(strings.TrimSpace(input.Label)).
+		WithStreet(input.Street).
+		WithNumber(input.Number).
+		WithComplement(input.Complement).
+		WithNeighborhood(strings.TrimSpace(input.Neighborhood)).
+		WithCity(strings.TrimSpace(input.City)).
+		WithState(input.State).
+		WithZipCode(input.ZipCode).
+		WithDefaultShipping(input.IsDefaultShipping).
+		WithDefaultBilling(input.IsDefaultBilling).
+		Build()
+}
+
+func ToAddressOutput(address *entity.Address) dto.AddressOutput {
+	const layout = "2006-01-02T15:04:05.000000Z"
+	return dto.AddressOutput{
+		PublicID:          address.PublicID(),
+		Label:             address.Label(),
+		Street:            address.Street(),
+		Number:            address.Number(),
+		Complement:        address.Complement(),
+		Neighborhood:      address.Neighborhood(),
+		City:              address.City(),
+		State:             address.State(),
+		ZipCode:           address.FormattedZipCode(),
+		IsDefaultShipping: address.IsDefaultShipping(),
+		IsDefaultBilling:  address.IsDefaultBilling(),
+		CreatedAt:         address.CreatedAt().Format(layout),
+		UpdatedAt:         address.UpdatedAt().Format(layout),
+	}
+}
+
+func ToUserAddressesOutput(addresses []entity.Address) *dto.UserAddressesOutput {
+	output := &dto.UserAddressesOutput{Addresses: make([]dto.AddressOutput, 0, len(addresses))}
+	for i := range addresses {
+		output.Addresses = append(output.Addresses, ToAddressOutput(&addresses[i]))
+	}
+	return output
+}
@@ -0,0 +1,21 @@
+package mapper
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+)
+
+func ToUserProfileOutput(user *entity.User) *dto.UserProfileOutput {
+	const layout = "2006-01-02T15:04:05.000000Z"
+	return &dto.UserProfileOutput{
+		PublicID:      user.PublicID(),
+		Name:          user.Name(),
+		Email:         user.Email(),
+		EmailVerified: user.IsEmailVerified(),
+		Role:          user.Role(),
+		AvatarURL:     user.AvatarURL(),
+		Phone:         user.Phone(),
+		CreatedAt:     user.CreateAT().Format(layout),
+		UpdatedAt:     user.UpdateAT().Format(layout),
+	}
+}
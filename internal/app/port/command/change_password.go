@@ -0,0 +1,12 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type ChangePassword interface {
+	Execute(ctx context.Context, input dto.ChangePasswordInput) *errors.Error
+}
@@ -0,0 +1,11 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type GetJWKS interface {
+	Execute(ctx context.Context) vo.JSONWebKeySet
+}
@@ -0,0 +1,11 @@
+package command
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type RevokeOtherSessions interface {
+	Execute(ctx context.Context) *errors.Error
+}
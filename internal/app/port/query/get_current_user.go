@@ -0,0 +1,12 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type GetCurrentUser interface {
+	Execute(ctx context.Context) (*dto.UserProfileOutput, *errors.Error)
+}
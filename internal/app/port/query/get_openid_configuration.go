@@ -0,0 +1,11 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+)
+
+type GetOpenIDConfiguration interface {
+	Execute(ctx context.Context) *dto.OpenIDConfigurationOutput
+}
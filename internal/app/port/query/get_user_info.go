@@ -0,0 +1,12 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type GetUserInfo interface {
+	Execute(ctx context.Context) (*vo.UserInfo, *errors.Error)
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type AuthTokenService interface {
+	IssueTokens(ctx context.Context, user *entity.User, familyID string) (*vo.AuthTokens, *errors.Error)
+	VerifyAccessToken(ctx context.Context, token string) (*vo.TokenClaims, *errors.Error)
+}
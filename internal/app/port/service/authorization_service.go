@@ -0,0 +1,13 @@
+package service
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type AuthorizationService interface {
+	HasPermission(ctx context.Context, role entity.RoleTypes, permission entity.Permission) (bool, *errors.Error)
+	HasMFA(ctx context.Context, publicID string) (bool, *errors.Error)
+}
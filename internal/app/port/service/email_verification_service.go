@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type EmailVerificationService interface {
+	SendVerification(ctx context.Context, user *entity.User) *errors.Error
+	IsValidLink(user *entity.User, expiresAt time.Time, signature string) bool
+}
@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type MFAService interface {
+	StartChallenge(ctx context.Context, user *entity.User) (*vo.MFAChallenge, *errors.Error)
+	VerifyChallenge(ctx context.Context, mfaToken, code string) (int64, *errors.Error)
+	VerifyUserCode(ctx context.Context, userID int64, code string) (bool, *errors.Error)
+	IssueRecoveryCodes(ctx context.Context, userID int64) ([]string, *errors.Error)
+}
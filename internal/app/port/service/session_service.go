@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type SessionService interface {
+	StartSession(ctx context.Context, userID int64, sessionID string) *errors.Error
+	TouchSession(ctx context.Context, sessionID string) *errors.Error
+}
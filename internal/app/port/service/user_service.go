@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 
+	"github.com/andreis3/auth-ms/internal/domain/entity"
 	"github.com/andreis3/auth-ms/internal/domain/errors"
 )
 
 type UserService interface {
 	ValidateEmailAvailability(ctx context.Context, email string) *errors.Error
+	FindCurrentUser(ctx context.Context) (*entity.User, *errors.Error)
 }
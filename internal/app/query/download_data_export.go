@@ -0,0 +1,76 @@
+package query
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type DownloadDataExport struct {
+	dataExportRepository port.DataExportRepository
+	signer               adapter.Signer
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewDownloadDataExport(
+	dataExportRepository port.DataExportRepository,
+	signer adapter.Signer,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *DownloadDataExport {
+	return &DownloadDataExport{
+		dataExportRepository: dataExportRepository,
+		signer:               signer,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+// Execute serves the bundle of a signed download link. The signature is the
+// only credential, so it is checked before the export is loaded.
+func (q *DownloadDataExport) Execute(ctx context.Context, input dto.DownloadDataExportInput) (*dto.DataExportFile, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "DownloadDataExport.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	expires, parseErr := strconv.ParseInt(input.Expires, 10, 64)
+	if parseErr != nil || !q.signer.Verify(dataExportSignedSubject(input.ExportID), time.Unix(expires, 0), input.Signature) {
+		err := errors.ErrorInvalidDownloadLink(input.ExportID)
+		span.RecordError(err)
+		q.log.WarnJSON("Invalid data export download link",
+			map[string]any{
+				"trace_id":  traceID,
+				"export_id": input.ExportID,
+			})
+		return nil, err
+	}
+
+	export, err := q.dataExportRepository.FindDataExportWithPayload(ctx, input.ExportID)
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error finding data export",
+			map[string]any{
+				"trace_id":  traceID,
+				"export_id": input.ExportID,
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if export == nil || !export.IsDownloadable(time.Now().UTC()) {
+		err := errors.ErrorDataExportNotFound(input.ExportID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &dto.DataExportFile{
+		FileName:    export.FileName(),
+		ContentType: export.ContentType(),
+		Content:     export.Payload(),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type GetCurrentUser struct {
+	userRepository port.UserRepository
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewGetCurrentUser(
+	userRepository port.UserRepository,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *GetCurrentUser {
+	return &GetCurrentUser{
+		userRepository: userRepository,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+func (q *GetCurrentUser) Execute(ctx context.Context) (*dto.UserProfileOutput, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "GetCurrentUser.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	principal, ok := vo.PrincipalFromContext(ctx)
+	if !ok {
+		err := errors.ErrorMissingBearerToken()
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := q.userRepository.FindUserByPublicID(ctx, principal.PublicID)
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error finding user by public id",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": principal.PublicID,
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if user == nil {
+		notFoundErr := errors.ErrorUserNotFound(principal.PublicID)
+		span.RecordError(notFoundErr)
+		return nil, notFoundErr
+	}
+
+	return mapper.ToUserProfileOutput(user), nil
+}
@@ -0,0 +1,72 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type GetDataExport struct {
+	dataExportRepository port.DataExportRepository
+	userService          service.UserService
+	signer               adapter.Signer
+	linkTTL              time.Duration
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewGetDataExport(
+	dataExportRepository port.DataExportRepository,
+	userService service.UserService,
+	signer adapter.Signer,
+	linkTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *GetDataExport {
+	return &GetDataExport{
+		dataExportRepository: dataExportRepository,
+		userService:          userService,
+		signer:               signer,
+		linkTTL:              linkTTL,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+func (q *GetDataExport) Execute(ctx context.Context, input dto.GetDataExportInput) (*dto.DataExportOutput, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "GetDataExport.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := q.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	export, err := q.dataExportRepository.FindDataExportByPublicID(ctx, user.ID(), input.ExportID)
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error finding data export",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"export_id": input.ExportID,
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if export == nil {
+		err := errors.ErrorDataExportNotFound(input.ExportID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	output := toDataExportOutputWithLink(export, q.signer, q.linkTTL, time.Now().UTC())
+	return &output, nil
+}
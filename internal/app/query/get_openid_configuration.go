@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type GetOpenIDConfiguration struct {
+	jwt    adapter.JWT
+	issuer string
+	tracer adapter.Tracer
+}
+
+func NewGetOpenIDConfiguration(jwt adapter.JWT, issuer string, tracer adapter.Tracer) *GetOpenIDConfiguration {
+	return &GetOpenIDConfiguration{
+		jwt:    jwt,
+		issuer: strings.TrimSuffix(issuer, "/"),
+		tracer: tracer,
+	}
+}
+
+// Execute describes the endpoints under the issuer and the signing
+// algorithms of the keys currently published in the JWKS.
+func (q *GetOpenIDConfiguration) Execute(ctx context.Context) *dto.OpenIDConfigurationOutput {
+	_, span := q.tracer.Start(ctx, "GetOpenIDConfiguration.Execute")
+	defer span.End()
+
+	var algorithms []string
+	for _, key := range q.jwt.JWKS().Keys {
+		if !slices.Contains(algorithms, key.Algorithm) {
+			algorithms = append(algorithms, key.Algorithm)
+		}
+	}
+
+	return &dto.OpenIDConfigurationOutput{
+		Issuer:                            q.issuer,
+		AuthorizationEndpoint:             q.issuer + "/oauth/authorize",
+		TokenEndpoint:                     q.issuer + "/oauth/token",
+		UserinfoEndpoint:                  q.issuer + "/oauth/userinfo",
+		JWKSURI:                           q.issuer + "/.well-known/jwks.json",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{entity.GrantTypeAuthorizationCode, entity.GrantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  algorithms,
+		ScopesSupported:                   []string{entity.ScopeOpenID, entity.ScopeEmail, entity.ScopeProfile},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{vo.PKCEMethodS256},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce",
+			"email", "email_verified", "name", "picture",
+		},
+	}
+}
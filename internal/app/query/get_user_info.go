@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type GetUserInfo struct {
+	userRepository port.UserRepository
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewGetUserInfo(
+	userRepository port.UserRepository,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *GetUserInfo {
+	return &GetUserInfo{
+		userRepository: userRepository,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+// Execute serves the OpenID Connect userinfo endpoint: it answers only tokens
+// granted the openid scope, with the claims the other granted scopes allow.
+func (q *GetUserInfo) Execute(ctx context.Context) (*vo.UserInfo, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "GetUserInfo.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	principal, ok := vo.PrincipalFromContext(ctx)
+	if !ok {
+		err := errors.ErrorMissingBearerToken()
+		span.RecordError(err)
+		return nil, err
+	}
+	if !principal.HasScope(entity.ScopeOpenID) {
+		err := errors.ErrorMissingScope(entity.ScopeOpenID)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := q.userRepository.FindUserByPublicID(ctx, principal.PublicID)
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error finding user by public id",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": principal.PublicID,
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+	if user == nil {
+		notFoundErr := errors.ErrorUserNotFound(principal.PublicID)
+		span.RecordError(notFoundErr)
+		return nil, notFoundErr
+	}
+
+	info := mapper.ToUserInfo(user, principal.Scopes)
+	return &info, nil
+}
@@ -0,0 +1,101 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type ListDataExports struct {
+	dataExportRepository port.DataExportRepository
+	userService          service.UserService
+	signer               adapter.Signer
+	linkTTL              time.Duration
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewListDataExports(
+	dataExportRepository port.DataExportRepository,
+	userService service.UserService,
+	signer adapter.Signer,
+	linkTTL time.Duration,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ListDataExports {
+	return &ListDataExports{
+		dataExportRepository: dataExportRepository,
+		userService:          userService,
+		signer:               signer,
+		linkTTL:              linkTTL,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+func (q *ListDataExports) Execute(ctx context.Context) (*dto.DataExportsOutput, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "ListDataExports.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := q.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	exports, err := q.dataExportRepository.ListDataExportsByUserID(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error listing data exports",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	output := &dto.DataExportsOutput{Exports: make([]dto.DataExportOutput, 0, len(exports))}
+	for i := range exports {
+		output.Exports = append(output.Exports, toDataExportOutputWithLink(&exports[i], q.signer, q.linkTTL, now))
+	}
+
+	return output, nil
+}
+
+// toDataExportOutputWithLink attaches a signed download link to downloadable
+// exports. The link never outlives the export itself.
+func toDataExportOutputWithLink(export *entity.DataExport, signer adapter.Signer, linkTTL time.Duration, now time.Time) dto.DataExportOutput {
+	output := mapper.ToDataExportOutput(export)
+	if !export.IsDownloadable(now) {
+		return output
+	}
+
+	expiresAt := now.Add(linkTTL)
+	if export.ExpiresAt().Before(expiresAt) {
+		expiresAt = *export.ExpiresAt()
+	}
+	signature := signer.Sign(dataExportSignedSubject(export.PublicID()), expiresAt)
+
+	output.DownloadURL = fmt.Sprintf("/users/me/exports/%s/download?expires=%d&signature=%s",
+		export.PublicID(), expiresAt.Unix(), url.QueryEscape(signature))
+	output.DownloadExpiresAt = expiresAt.Format(time.RFC3339)
+	return output
+}
+
+// dataExportSignedSubject scopes a download signature to data exports, so it
+// cannot be replayed against another kind of signed link.
+func dataExportSignedSubject(exportID string) string {
+	return "data_export:" + exportID
+}
@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type ListPasskeys struct {
+	credentialRepository port.WebAuthnCredentialRepository
+	userService          service.UserService
+	log                  adapter.Logger
+	tracer               adapter.Tracer
+}
+
+func NewListPasskeys(
+	credentialRepository port.WebAuthnCredentialRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ListPasskeys {
+	return &ListPasskeys{
+		credentialRepository: credentialRepository,
+		userService:          userService,
+		log:                  log,
+		tracer:               tracer,
+	}
+}
+
+func (q *ListPasskeys) Execute(ctx context.Context) (*dto.PasskeysOutput, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "ListPasskeys.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := q.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	credentials, err := q.credentialRepository.ListCredentialsByUserID(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error listing passkeys",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	return mapper.ToPasskeysOutput(credentials), nil
+}
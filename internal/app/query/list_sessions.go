@@ -0,0 +1,61 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type ListSessions struct {
+	sessionRepository port.SessionRepository
+	userService       service.UserService
+	log               adapter.Logger
+	tracer            adapter.Tracer
+}
+
+func NewListSessions(
+	sessionRepository port.SessionRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ListSessions {
+	return &ListSessions{
+		sessionRepository: sessionRepository,
+		userService:       userService,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+func (q *ListSessions) Execute(ctx context.Context) (*dto.SessionsOutput, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "ListSessions.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := q.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	sessions, err := q.sessionRepository.ListActiveSessionsByUserID(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error listing sessions",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	principal, _ := vo.PrincipalFromContext(ctx)
+	return mapper.ToSessionsOutput(sessions, principal.SessionID), nil
+}
@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+type ListUserAddresses struct {
+	addressRepository port.AddressRepository
+	userService       service.UserService
+	log               adapter.Logger
+	tracer            adapter.Tracer
+}
+
+func NewListUserAddresses(
+	addressRepository port.AddressRepository,
+	userService service.UserService,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ListUserAddresses {
+	return &ListUserAddresses{
+		addressRepository: addressRepository,
+		userService:       userService,
+		log:               log,
+		tracer:            tracer,
+	}
+}
+
+func (q *ListUserAddresses) Execute(ctx context.Context) (*dto.UserAddressesOutput, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "ListUserAddresses.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	user, err := q.userService.FindCurrentUser(ctx)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	addresses, err := q.addressRepository.ListAddressesByUserID(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error listing user addresses",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": user.PublicID(),
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	return mapper.ToUserAddressesOutput(addresses), nil
+}
@@ -0,0 +1,192 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	DefaultUsersPageSize = 20
+	MaxUsersPageSize     = 100
+
+	maxSearchTermLength = 255
+
+	errInvalidRole     = "must be one of user, admin or support"
+	errInvalidDate     = "must be a RFC 3339 timestamp or a YYYY-MM-DD date"
+	errInvalidRange    = "must be after created_from"
+	errInvalidDeleted  = "must be one of true, false or all"
+	errInvalidSort     = "must be one of created_at, -created_at, email or -email"
+	errInvalidLimit    = "must be a number between 1 and %d"
+	errInvalidCursor   = "is malformed"
+	errCursorSortDrift = "was issued for a different sort"
+)
+
+var userRoles = []string{string(entity.RoleUser), string(entity.RoleAdmin), string(entity.RoleSupport)}
+
+type ListUsers struct {
+	userRepository port.UserRepository
+	log            adapter.Logger
+	tracer         adapter.Tracer
+}
+
+func NewListUsers(
+	userRepository port.UserRepository,
+	log adapter.Logger,
+	tracer adapter.Tracer,
+) *ListUsers {
+	return &ListUsers{
+		userRepository: userRepository,
+		log:            log,
+		tracer:         tracer,
+	}
+}
+
+func (q *ListUsers) Execute(ctx context.Context, input dto.ListUsersInput) (*dto.ListUsersOutput, *errors.Error) {
+	ctx, span := q.tracer.Start(ctx, "ListUsers.Execute")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	search, isValid := toUserSearch(input)
+	if isValid.HasErrors() {
+		validationErr := errors.InvalidEntity(isValid, "user search")
+		span.RecordError(validationErr)
+		q.log.WarnJSON("User search validation failed",
+			map[string]any{
+				"trace_id": traceID,
+				"errors":   isValid.FieldErrorsFlat(),
+			})
+		return nil, validationErr
+	}
+
+	users, err := q.userRepository.SearchUsers(ctx, search)
+	if err != nil {
+		span.RecordError(err)
+		q.log.ErrorJSON("Error searching users",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	hasMore := len(users) > search.Limit
+	if hasMore {
+		users = users[:search.Limit]
+	}
+	backwards := search.Cursor != nil && search.Cursor.Direction == vo.CursorPrev
+	if backwards {
+		slices.Reverse(users)
+	}
+
+	output := &dto.ListUsersOutput{
+		Users:      make([]dto.AdminUserOutput, 0, len(users)),
+		Pagination: dto.CursorPagination{Limit: search.Limit, Sort: search.Sort()},
+	}
+	for i := range users {
+		output.Users = append(output.Users, mapper.ToAdminUserOutput(&users[i]))
+	}
+	if len(users) == 0 {
+		return output, nil
+	}
+
+	first, last := &users[0], &users[len(users)-1]
+	if hasMore || backwards {
+		output.Pagination.NextCursor = mapper.ToUserCursor(last, search, vo.CursorNext)
+	}
+	if (hasMore && backwards) || (!backwards && search.Cursor != nil) {
+		output.Pagination.PrevCursor = mapper.ToUserCursor(first, search, vo.CursorPrev)
+	}
+
+	return output, nil
+}
+
+func toUserSearch(input dto.ListUsersInput) (vo.UserSearch, *validator.Validator) {
+	isValid := validator.New()
+	search := vo.UserSearch{
+		Role:        input.Role,
+		EmailPrefix: input.Email,
+		Name:        input.Name,
+		SortField:   vo.UserSortCreatedAt,
+		Descending:  true,
+		Limit:       DefaultUsersPageSize,
+	}
+
+	isValid.Assert(input.Role == "" || slices.Contains(userRoles, input.Role), "role", errInvalidRole)
+	isValid.Assert(validator.MaxChars(input.Email, maxSearchTermLength), "email", fmt.Sprintf(validator.ErrMaxLength, maxSearchTermLength))
+	isValid.Assert(validator.MaxChars(input.Name, maxSearchTermLength), "name", fmt.Sprintf(validator.ErrMaxLength, maxSearchTermLength))
+
+	search.CreatedFrom = parseSearchDate(isValid, "created_from", input.CreatedFrom)
+	search.CreatedTo = parseSearchDate(isValid, "created_to", input.CreatedTo)
+	if search.CreatedFrom != nil && search.CreatedTo != nil {
+		isValid.Assert(search.CreatedTo.After(*search.CreatedFrom), "created_to", errInvalidRange)
+	}
+
+	switch input.Deleted {
+	case "", "false":
+		deleted := false
+		search.Deleted = &deleted
+	case "true":
+		deleted := true
+		search.Deleted = &deleted
+	case "all":
+	default:
+		isValid.AddFieldError("deleted", errInvalidDeleted)
+	}
+
+	switch input.Sort {
+	case "", "-created_at":
+	case "created_at":
+		search.Descending = false
+	case "email", "-email":
+		search.SortField = vo.UserSortEmail
+		search.Descending = input.Sort == "-email"
+	default:
+		isValid.AddFieldError("sort", errInvalidSort)
+	}
+
+	if input.Limit != "" {
+		limit, err := strconv.Atoi(input.Limit)
+		isValid.Assert(err == nil && limit >= 1 && limit <= MaxUsersPageSize, "limit", fmt.Sprintf(errInvalidLimit, MaxUsersPageSize))
+		search.Limit = limit
+	}
+
+	if input.Cursor != "" {
+		cursor, err := vo.DecodeUserCursor(input.Cursor)
+		switch {
+		case err != nil || (cursor.Direction != vo.CursorNext && cursor.Direction != vo.CursorPrev):
+			isValid.AddFieldError("cursor", errInvalidCursor)
+		case cursor.Sort != search.Sort():
+			isValid.AddFieldError("cursor", errCursorSortDrift)
+		default:
+			search.Cursor = &cursor
+		}
+	}
+
+	return search, isValid
+}
+
+func parseSearchDate(isValid *validator.Validator, key, value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			parsed = parsed.UTC()
+			return &parsed
+		}
+	}
+	isValid.AddFieldError(key, errInvalidDate)
+	return nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/port/service"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type AuthTokenService struct {
+	refreshTokenRepository port.RefreshTokenRepository
+	sessionService         service.SessionService
+	jwt                    adapter2.JWT
+	denylist               adapter2.TokenDenylist
+	opaqueToken            adapter2.OpaqueToken
+	utils                  adapter2.Utils
+	refreshExpiry          time.Duration
+	tracer                 adapter2.Tracer
+	log                    adapter2.Logger
+}
+
+func NewAuthTokenService(
+	refreshTokenRepository port.RefreshTokenRepository,
+	sessionService service.SessionService,
+	jwt adapter2.JWT,
+	denylist adapter2.TokenDenylist,
+	opaqueToken adapter2.OpaqueToken,
+	utils adapter2.Utils,
+	refreshExpiry time.Duration,
+	trace adapter2.Tracer,
+	log adapter2.Logger,
+) *AuthTokenService {
+	return &AuthTokenService{
+		refreshTokenRepository: refreshTokenRepository,
+		sessionService:         sessionService,
+		jwt:                    jwt,
+		denylist:               denylist,
+		opaqueToken:            opaqueToken,
+		utils:                  utils,
+		refreshExpiry:          refreshExpiry,
+		tracer:                 trace,
+		log:                    log,
+	}
+}
+
+// IssueTokens signs a new access token and persists a new refresh token for the
+// given family. An empty familyID starts a new family (a new sign-in), which
+// is recorded as a new session of the user.
+func (s *AuthTokenService) IssueTokens(ctx context.Context, user *entity.User, familyID string) (*vo.AuthTokens, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "AuthTokenService.IssueTokens")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	if familyID == "" {
+		familyID = s.utils.UUID()
+		if err := s.sessionService.StartSession(ctx, user.ID(), familyID); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	} else {
+		s.touchSession(ctx, traceID, familyID)
+	}
+
+	access, err := s.jwt.Generate(vo.TokenClaims{
+		PublicID:  user.PublicID(),
+		Role:      user.Role(),
+		Email:     user.Email(),
+		SessionID: familyID,
+	})
+	if err != nil {
+		span.RecordError(err)
+		s.log.CriticalJSON("Error generating access token",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	refreshToken, refreshHash, err := s.opaqueToken.Generate()
+	if err != nil {
+		span.RecordError(err)
+		s.log.CriticalJSON("Error generating refresh token",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	stored, err := s.refreshTokenRepository.CreateRefreshToken(ctx, entity.BuilderRefreshToken().
+		WithUserID(user.ID()).
+		WithFamilyID(familyID).
+		WithTokenHash(refreshHash).
+		WithExpiresAt(time.Now().UTC().Add(s.refreshExpiry)).
+		Build())
+	if err != nil {
+		span.RecordError(err)
+		s.log.ErrorJSON("Error storing refresh token",
+			map[string]any{
+				"trace_id":  traceID,
+				"family_id": familyID,
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	return &vo.AuthTokens{
+		Access:           *access,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: stored.ExpiresAt(),
+	}, nil
+}
+
+// VerifyAccessToken checks the token signature and expiry and then consults the
+// revocation denylist; every access token accepted by the service goes through here.
+func (s *AuthTokenService) VerifyAccessToken(ctx context.Context, token string) (*vo.TokenClaims, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "AuthTokenService.VerifyAccessToken")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	claims, err := s.jwt.Validate(token)
+	if err != nil {
+		span.RecordError(err)
+		s.log.WarnJSON("Invalid access token",
+			map[string]any{
+				"trace_id": traceID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	revoked, err := s.denylist.IsRevoked(ctx, *claims)
+	if err != nil {
+		span.RecordError(err)
+		s.log.ErrorJSON("Error checking token denylist",
+			map[string]any{
+				"trace_id": traceID,
+				"jti":      claims.ID,
+				"error":    err.Error(),
+			})
+		return nil, err
+	}
+
+	if revoked {
+		revokedErr := errors.ErrorRevokedToken(claims.ID)
+		span.RecordError(revokedErr)
+		s.log.WarnJSON("Revoked access token presented",
+			map[string]any{
+				"trace_id":  traceID,
+				"jti":       claims.ID,
+				"public_id": claims.PublicID,
+			})
+		return nil, revokedErr
+	}
+
+	if claims.SessionID != "" {
+		s.touchSession(ctx, traceID, claims.SessionID)
+	}
+
+	return claims, nil
+}
+
+// touchSession only logs failures: losing track of when a session was last
+// seen must not fail the request.
+func (s *AuthTokenService) touchSession(ctx context.Context, traceID, sessionID string) {
+	if err := s.sessionService.TouchSession(ctx, sessionID); err != nil {
+		s.log.WarnJSON("Error recording session activity",
+			map[string]any{
+				"trace_id":   traceID,
+				"session_id": sessionID,
+				"error":      err.Error(),
+			})
+	}
+}
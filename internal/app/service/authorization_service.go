@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+)
+
+const (
+	rolePermissionsPrefix     = "auth:role:permissions:"
+	rolePermissionsTTLSeconds = 300
+)
+
+type AuthorizationService struct {
+	roleRepository    port.RoleRepository
+	userMFARepository port.UserMFARepository
+	cache             adapter2.Cache
+	tracer            adapter2.Tracer
+	log               adapter2.Logger
+}
+
+func NewAuthorizationService(
+	roleRepository port.RoleRepository,
+	userMFARepository port.UserMFARepository,
+	cache adapter2.Cache,
+	trace adapter2.Tracer,
+	log adapter2.Logger,
+) *AuthorizationService {
+	return &AuthorizationService{
+		roleRepository:    roleRepository,
+		userMFARepository: userMFARepository,
+		cache:             cache,
+		tracer:            trace,
+		log:               log,
+	}
+}
+
+// HasPermission checks the grants of role. Grants are cached for a few minutes,
+// so changes to role_permissions take effect without a restart.
+func (s *AuthorizationService) HasPermission(ctx context.Context, role entity.RoleTypes, permission entity.Permission) (bool, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "AuthorizationService.HasPermission")
+	defer span.End()
+
+	permissions, err := s.permissions(ctx, role)
+	if err != nil {
+		span.RecordError(err)
+		s.log.ErrorJSON("Error loading role permissions",
+			map[string]any{
+				"trace_id": span.SpanContext().TraceID(),
+				"role":     role,
+				"error":    err.Error(),
+			})
+		return false, err
+	}
+
+	return slices.Contains(permissions, permission), nil
+}
+
+// HasMFA reports whether the user has confirmed an MFA enrollment. It is not
+// cached, so a reset takes effect on the next request.
+func (s *AuthorizationService) HasMFA(ctx context.Context, publicID string) (bool, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "AuthorizationService.HasMFA")
+	defer span.End()
+
+	enabled, err := s.userMFARepository.HasConfirmedMFA(ctx, publicID)
+	if err != nil {
+		span.RecordError(err)
+		s.log.ErrorJSON("Error loading MFA enrollment",
+			map[string]any{
+				"trace_id":  span.SpanContext().TraceID(),
+				"public_id": publicID,
+				"error":     err.Error(),
+			})
+		return false, err
+	}
+
+	return enabled, nil
+}
+
+func (s *AuthorizationService) permissions(ctx context.Context, role entity.RoleTypes) ([]entity.Permission, *errors.Error) {
+	key := rolePermissionsPrefix + string(role)
+
+	var permissions []entity.Permission
+	found, err := s.cache.Get(ctx, key, &permissions)
+	if err == nil && found {
+		return permissions, nil
+	}
+
+	permissions, err = s.roleRepository.FindPermissionsByRole(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	// a cache failure must not deny access, the grants come from the database
+	_ = s.cache.Set(ctx, key, permissions, rolePermissionsTTLSeconds)
+	return permissions, nil
+}
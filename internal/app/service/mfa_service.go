@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	mfaChallengePrefix         = "auth:mfa:challenge:"
+	mfaChallengeAttemptsSuffix = ":attempts"
+	mfaUserAttemptsPrefix      = "auth:mfa:user:"
+)
+
+// MFAService checks the second factor of users with a confirmed TOTP
+// enrollment: a code of their authenticator app or one of their recovery codes.
+type MFAService struct {
+	userMFARepository      port.UserMFARepository
+	recoveryCodeRepository port.MFARecoveryCodeRepository
+	totp                   adapter2.TOTP
+	cache                  adapter2.Cache
+	opaqueToken            adapter2.OpaqueToken
+	challengeTTL           time.Duration
+	maxAttempts            int
+	recoveryCodes          int
+	tracer                 adapter2.Tracer
+	log                    adapter2.Logger
+}
+
+func NewMFAService(
+	userMFARepository port.UserMFARepository,
+	recoveryCodeRepository port.MFARecoveryCodeRepository,
+	totp adapter2.TOTP,
+	cache adapter2.Cache,
+	opaqueToken adapter2.OpaqueToken,
+	challengeTTL time.Duration,
+	maxAttempts int,
+	recoveryCodes int,
+	trace adapter2.Tracer,
+	log adapter2.Logger,
+) *MFAService {
+	return &MFAService{
+		userMFARepository:      userMFARepository,
+		recoveryCodeRepository: recoveryCodeRepository,
+		totp:                   totp,
+		cache:                  cache,
+		opaqueToken:            opaqueToken,
+		challengeTTL:           challengeTTL,
+		maxAttempts:            maxAttempts,
+		recoveryCodes:          recoveryCodes,
+		tracer:                 trace,
+		log:                    log,
+	}
+}
+
+// StartChallenge opens an MFA challenge for a user who just proved the first
+// factor. It returns nil when the user has no confirmed enrollment, so the
+// sign-in can go on without one.
+func (s *MFAService) StartChallenge(ctx context.Context, user *entity.User) (*vo.MFAChallenge, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "MFAService.StartChallenge")
+	defer span.End()
+
+	mfa, err := s.userMFARepository.FindUserMFA(ctx, user.ID())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if mfa == nil || !mfa.IsConfirmed() {
+		return nil, nil
+	}
+
+	token, tokenHash, err := s.opaqueToken.Generate()
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	expiresAt := time.Now().UTC().Add(s.challengeTTL)
+	if err := s.cache.Set(ctx, mfaChallengePrefix+tokenHash, user.ID(), int(s.challengeTTL.Seconds())); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return &vo.MFAChallenge{Token: token, ExpiresAt: expiresAt}, nil
+}
+
+// VerifyChallenge checks code against the user of mfaToken and, on success,
+// closes the challenge and returns the user ID. Every guess is counted: past
+// maxAttempts the challenge is dropped and the sign-in must start over.
+func (s *MFAService) VerifyChallenge(ctx context.Context, mfaToken, code string) (int64, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "MFAService.VerifyChallenge")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	key := mfaChallengePrefix + s.opaqueToken.Hash(mfaToken)
+	attempts, err := s.cache.Increment(ctx, key+mfaChallengeAttemptsSuffix, int(s.challengeTTL.Seconds()))
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	if attempts > int64(s.maxAttempts) {
+		if err := s.cache.Delete(ctx, key); err != nil {
+			span.RecordError(err)
+			return 0, err
+		}
+		tooManyErr := errors.ErrorTooManyMFAAttempts()
+		span.RecordError(tooManyErr)
+		return 0, tooManyErr
+	}
+
+	var userID int64
+	found, err := s.cache.Get(ctx, key, &userID)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	if !found {
+		tokenErr := errors.ErrorInvalidMFAToken()
+		span.RecordError(tokenErr)
+		return 0, tokenErr
+	}
+
+	valid, err := s.VerifyCode(ctx, userID, code)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	if !valid {
+		codeErr := errors.ErrorInvalidMFACode()
+		span.RecordError(codeErr)
+		s.log.WarnJSON("Invalid MFA code",
+			map[string]any{
+				"trace_id": traceID,
+				"user_id":  userID,
+				"attempt":  attempts,
+			})
+		return 0, codeErr
+	}
+
+	if err := s.cache.Delete(ctx, key); err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	if err := s.cache.Delete(ctx, key+mfaChallengeAttemptsSuffix); err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+	return userID, nil
+}
+
+// VerifyUserCode checks code like VerifyCode for an already signed-in user,
+// counting every guess per user: past maxAttempts within challengeTTL of the
+// first one it fails with ErrorTooManyMFACodeAttempts, so a stolen session
+// cannot brute-force the second factor.
+func (s *MFAService) VerifyUserCode(ctx context.Context, userID int64, code string) (bool, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "MFAService.VerifyUserCode")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	key := fmt.Sprintf("%s%d%s", mfaUserAttemptsPrefix, userID, mfaChallengeAttemptsSuffix)
+	attempts, err := s.cache.Increment(ctx, key, int(s.challengeTTL.Seconds()))
+	if err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+	if attempts > int64(s.maxAttempts) {
+		tooManyErr := errors.ErrorTooManyMFACodeAttempts()
+		span.RecordError(tooManyErr)
+		return false, tooManyErr
+	}
+
+	valid, err := s.VerifyCode(ctx, userID, code)
+	if err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+	if !valid {
+		s.log.WarnJSON("Invalid MFA code",
+			map[string]any{
+				"trace_id": traceID,
+				"user_id":  userID,
+				"attempt":  attempts,
+			})
+		return false, nil
+	}
+
+	if err := s.cache.Delete(ctx, key); err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// VerifyCode accepts a TOTP code, or else an unused recovery code, of a user
+// with a confirmed enrollment; without one it fails with ErrorMFANotEnabled.
+// Accepted codes are spent: a TOTP code moves the last used step forward, so
+// neither it nor an older one is taken again.
+func (s *MFAService) VerifyCode(ctx context.Context, userID int64, code string) (bool, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "MFAService.VerifyCode")
+	defer span.End()
+
+	mfa, err := s.userMFARepository.FindUserMFA(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		return false, err
+	}
+	if mfa == nil || !mfa.IsConfirmed() {
+		notEnabledErr := errors.ErrorMFANotEnabled()
+		span.RecordError(notEnabledErr)
+		return false, notEnabledErr
+	}
+
+	code = strings.TrimSpace(code)
+	now := time.Now().UTC()
+	if step, ok := s.totp.Match(mfa.TOTPSecret(), code, now); ok {
+		return s.userMFARepository.RecordTOTPStep(ctx, userID, step)
+	}
+
+	return s.recoveryCodeRepository.ConsumeRecoveryCode(ctx, userID, s.hashRecoveryCode(code), now)
+}
+
+// IssueRecoveryCodes replaces the recovery codes of the user and returns the
+// new ones, which are only ever shown this once.
+func (s *MFAService) IssueRecoveryCodes(ctx context.Context, userID int64) ([]string, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "MFAService.IssueRecoveryCodes")
+	defer span.End()
+
+	codes, err := s.totp.GenerateRecoveryCodes(s.recoveryCodes)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, s.hashRecoveryCode(code))
+	}
+	if err := s.recoveryCodeRepository.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// hashRecoveryCode ignores case and separators, so a code typed as read
+// from paper still matches.
+func (s *MFAService) hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return s.opaqueToken.Hash(normalized)
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	sessionDeviceNameMaxLength = 100
+	sessionUserAgentMaxLength  = 512
+)
+
+// SessionService records where and when the sessions of users are used. A
+// session is identified by the refresh token family it started.
+type SessionService struct {
+	sessionRepository port.SessionRepository
+	sessionCache      adapter2.SessionCache
+	touchInterval     time.Duration
+	tracer            adapter2.Tracer
+	log               adapter2.Logger
+}
+
+func NewSessionService(
+	sessionRepository port.SessionRepository,
+	sessionCache adapter2.SessionCache,
+	touchInterval time.Duration,
+	trace adapter2.Tracer,
+	log adapter2.Logger,
+) *SessionService {
+	return &SessionService{
+		sessionRepository: sessionRepository,
+		sessionCache:      sessionCache,
+		touchInterval:     touchInterval,
+		tracer:            trace,
+		log:               log,
+	}
+}
+
+// StartSession records a sign-in from the address and User-Agent of ctx.
+func (s *SessionService) StartSession(ctx context.Context, userID int64, sessionID string) *errors.Error {
+	ctx, span := s.tracer.Start(ctx, "SessionService.StartSession")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	userAgent := vo.UserAgentFromContext(ctx)
+	session, err := s.sessionRepository.CreateSession(ctx, entity.BuilderSession().
+		WithUserID(userID).
+		WithFamilyID(sessionID).
+		WithDeviceName(truncate(vo.DeviceName(userAgent), sessionDeviceNameMaxLength)).
+		WithUserAgent(truncate(userAgent, sessionUserAgentMaxLength)).
+		WithIPAddress(vo.ClientIPFromContext(ctx)).
+		Build())
+	if err != nil {
+		span.RecordError(err)
+		s.log.ErrorJSON("Error creating session",
+			map[string]any{
+				"trace_id":   traceID,
+				"session_id": sessionID,
+				"error":      err.Error(),
+			})
+		return err
+	}
+
+	if err := s.sessionCache.Set(ctx, sessionID, activityOf(session)); err != nil {
+		s.log.WarnJSON("Error caching session",
+			map[string]any{
+				"trace_id":   traceID,
+				"session_id": sessionID,
+				"error":      err.Error(),
+			})
+	}
+	return nil
+}
+
+// TouchSession records that the session is in use from the address of ctx.
+// Activity is kept in the cache and only written to Postgres once the stored
+// one is touchInterval old or the address changed, so the check that runs on
+// every authenticated request stays off the database. Unknown sessions, such
+// as those of tokens issued to OAuth clients, are ignored.
+func (s *SessionService) TouchSession(ctx context.Context, sessionID string) *errors.Error {
+	ctx, span := s.tracer.Start(ctx, "SessionService.TouchSession")
+	defer span.End()
+
+	if !vo.IsSessionID(sessionID) {
+		return nil
+	}
+
+	activity, err := s.sessionCache.Get(ctx, sessionID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	cached := activity != nil
+	if !cached {
+		session, err := s.sessionRepository.FindSessionByFamilyID(ctx, sessionID)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+		if session == nil {
+			return nil
+		}
+		loaded := activityOf(session)
+		activity = &loaded
+	}
+
+	now := time.Now().UTC()
+	ip := vo.ClientIPFromContext(ctx)
+	if now.Sub(activity.LastSeenAt) >= s.touchInterval || (ip != "" && ip != activity.IPAddress) {
+		if err := s.sessionRepository.TouchSession(ctx, sessionID, ip, now); err != nil {
+			span.RecordError(err)
+			return err
+		}
+		activity.LastSeenAt = now
+		if ip != "" {
+			activity.IPAddress = ip
+		}
+	} else if cached {
+		return nil
+	}
+
+	if err := s.sessionCache.Set(ctx, sessionID, *activity); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	return nil
+}
+
+func activityOf(session *entity.Session) vo.SessionActivity {
+	return vo.SessionActivity{
+		UserID:     session.UserID(),
+		IPAddress:  session.IPAddress(),
+		LastSeenAt: session.LastSeenAt(),
+	}
+}
+
+func truncate(value string, maxLength int) string {
+	runes := []rune(value)
+	if len(runes) <= maxLength {
+		return value
+	}
+	return string(runes[:maxLength])
+}
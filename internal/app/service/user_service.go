@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 
+	"github.com/andreis3/auth-ms/internal/domain/entity"
 	"github.com/andreis3/auth-ms/internal/domain/errors"
 	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
 	"github.com/andreis3/auth-ms/internal/domain/port"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
 )
 
 type UserService struct {
@@ -63,3 +65,37 @@ func (s *UserService) ValidateEmailAvailability(ctx context.Context, email strin
 		})
 	return nil
 }
+
+// FindCurrentUser loads the user behind the authenticated principal of ctx.
+func (s *UserService) FindCurrentUser(ctx context.Context) (*entity.User, *errors.Error) {
+	ctx, span := s.tracer.Start(ctx, "UserService.FindCurrentUser")
+	defer span.End()
+	traceID := span.SpanContext().TraceID()
+
+	principal, ok := vo.PrincipalFromContext(ctx)
+	if !ok {
+		err := errors.ErrorMissingBearerToken()
+		span.RecordError(err)
+		return nil, err
+	}
+
+	user, err := s.repository.FindUserByPublicID(ctx, principal.PublicID)
+	if err != nil {
+		span.RecordError(err)
+		s.log.ErrorJSON("Error finding user by public id",
+			map[string]any{
+				"trace_id":  traceID,
+				"public_id": principal.PublicID,
+				"error":     err.Error(),
+			})
+		return nil, err
+	}
+
+	if user == nil {
+		notFoundErr := errors.ErrorUserNotFound(principal.PublicID)
+		span.RecordError(notFoundErr)
+		return nil, notFoundErr
+	}
+
+	return user, nil
+}
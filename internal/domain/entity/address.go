@@ -0,0 +1,215 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+	vo2 "github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+const (
+	maxAddressLabelLength = 50
+	maxNeighborhoodLength = 120
+	maxCityLength         = 120
+)
+
+type Address struct {
+	id              int64
+	publicID        string
+	userID          int64
+	label           string
+	street          vo2.Street
+	number          vo2.StreetNumber
+	complement      vo2.Complement
+	neighborhood    string
+	city            string
+	state           vo2.UF
+	zipCode         vo2.CEP
+	defaultShipping bool
+	defaultBilling  bool
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+func BuilderAddress() *Address {
+	return &Address{}
+}
+
+func (a *Address) Build() Address {
+	return *a
+}
+
+func (a *Address) WithID(id int64) *Address {
+	a.id = id
+	return a
+}
+
+func (a *Address) WithPublicID(publicID string) *Address {
+	a.publicID = publicID
+	return a
+}
+
+func (a *Address) WithUserID(userID int64) *Address {
+	a.userID = userID
+	return a
+}
+
+func (a *Address) WithLabel(label string) *Address {
+	a.label = label
+	return a
+}
+
+func (a *Address) WithStreet(street string) *Address {
+	a.street = vo2.NewStreet(street)
+	return a
+}
+
+func (a *Address) WithNumber(number string) *Address {
+	a.number = vo2.NewStreetNumber(number)
+	return a
+}
+
+func (a *Address) WithComplement(complement string) *Address {
+	a.complement = vo2.NewComplement(complement)
+	return a
+}
+
+func (a *Address) WithNeighborhood(neighborhood string) *Address {
+	a.neighborhood = neighborhood
+	return a
+}
+
+func (a *Address) WithCity(city string) *Address {
+	a.city = city
+	return a
+}
+
+func (a *Address) WithState(state string) *Address {
+	a.state = vo2.NewUF(state)
+	return a
+}
+
+func (a *Address) WithZipCode(zipCode string) *Address {
+	a.zipCode = vo2.NewCEP(zipCode)
+	return a
+}
+
+func (a *Address) WithDefaultShipping(defaultShipping bool) *Address {
+	a.defaultShipping = defaultShipping
+	return a
+}
+
+func (a *Address) WithDefaultBilling(defaultBilling bool) *Address {
+	a.defaultBilling = defaultBilling
+	return a
+}
+
+func (a *Address) WithCreatedAt(createdAt time.Time) *Address {
+	a.createdAt = createdAt
+	return a
+}
+
+func (a *Address) WithUpdatedAt(updatedAt time.Time) *Address {
+	a.updatedAt = updatedAt
+	return a
+}
+
+func (a *Address) Validate() *validator.Validator {
+	v := validator.New()
+	v.Assert(validator.MaxChars(a.label, maxAddressLabelLength), "label", fmt.Sprintf(validator.ErrMaxLength, maxAddressLabelLength))
+	v.Assert(validator.NotBlank(a.neighborhood), "neighborhood", validator.ErrNotBlank)
+	v.Assert(validator.MaxChars(a.neighborhood, maxNeighborhoodLength), "neighborhood", fmt.Sprintf(validator.ErrMaxLength, maxNeighborhoodLength))
+	v.Assert(validator.NotBlank(a.city), "city", validator.ErrNotBlank)
+	v.Assert(validator.MaxChars(a.city, maxCityLength), "city", fmt.Sprintf(validator.ErrMaxLength, maxCityLength))
+	v.Merge(a.street.Validate())
+	v.Merge(a.number.Validate())
+	v.Merge(a.complement.Validate())
+	v.Merge(a.state.Validate())
+	v.Merge(a.zipCode.Validate())
+	return v
+}
+
+func (a *Address) AssignID(id int64) *Address {
+	a.id = id
+	return a
+}
+
+func (a *Address) AssignPublicID(publicID string) *Address {
+	a.publicID = publicID
+	return a
+}
+
+func (a *Address) AssignUserID(userID int64) *Address {
+	a.userID = userID
+	return a
+}
+
+func (a *Address) AssignDefaultShipping(defaultShipping bool) *Address {
+	a.defaultShipping = defaultShipping
+	return a
+}
+
+func (a *Address) AssignDefaultBilling(defaultBilling bool) *Address {
+	a.defaultBilling = defaultBilling
+	return a
+}
+
+func (a *Address) AssignCreatedAt(createdAt time.Time) *Address {
+	a.createdAt = createdAt
+	return a
+}
+
+func (a *Address) AssignUpdatedAt(updatedAt time.Time) *Address {
+	a.updatedAt = updatedAt
+	return a
+}
+
+func (a *Address) ID() int64 {
+	return a.id
+}
+func (a *Address) PublicID() string {
+	return a.publicID
+}
+func (a *Address) UserID() int64 {
+	return a.userID
+}
+func (a *Address) Label() string {
+	return a.label
+}
+func (a *Address) Street() string {
+	return a.street.String()
+}
+func (a *Address) Number() string {
+	return a.number.String()
+}
+func (a *Address) Complement() string {
+	return a.complement.String()
+}
+func (a *Address) Neighborhood() string {
+	return a.neighborhood
+}
+func (a *Address) City() string {
+	return a.city
+}
+func (a *Address) State() string {
+	return a.state.String()
+}
+func (a *Address) ZipCode() string {
+	return a.zipCode.String()
+}
+func (a *Address) FormattedZipCode() string {
+	return a.zipCode.Formatted()
+}
+func (a *Address) IsDefaultShipping() bool {
+	return a.defaultShipping
+}
+func (a *Address) IsDefaultBilling() bool {
+	return a.defaultBilling
+}
+func (a *Address) CreatedAt() time.Time {
+	return a.createdAt
+}
+func (a *Address) UpdatedAt() time.Time {
+	return a.updatedAt
+}
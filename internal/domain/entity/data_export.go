@@ -0,0 +1,143 @@
+package entity
+
+import "time"
+
+type ExportFormat string
+
+const (
+	ExportFormatJSON ExportFormat = "json"
+	ExportFormatZIP  ExportFormat = "zip"
+)
+
+type ExportStatus string
+
+const (
+	ExportStatusPending    ExportStatus = "pending"
+	ExportStatusProcessing ExportStatus = "processing"
+	ExportStatusReady      ExportStatus = "ready"
+	ExportStatusFailed     ExportStatus = "failed"
+	ExportStatusExpired    ExportStatus = "expired"
+)
+
+type DataExport struct {
+	id            int64
+	publicID      string
+	userID        int64
+	format        ExportFormat
+	status        ExportStatus
+	payload       []byte
+	failureReason string
+	requestedAt   time.Time
+	completedAt   *time.Time
+	expiresAt     *time.Time
+}
+
+func BuilderDataExport() *DataExport {
+	return &DataExport{}
+}
+
+func (d *DataExport) Build() DataExport {
+	return *d
+}
+
+func (d *DataExport) WithID(id int64) *DataExport {
+	d.id = id
+	return d
+}
+
+func (d *DataExport) WithPublicID(publicID string) *DataExport {
+	d.publicID = publicID
+	return d
+}
+
+func (d *DataExport) WithUserID(userID int64) *DataExport {
+	d.userID = userID
+	return d
+}
+
+func (d *DataExport) WithFormat(format ExportFormat) *DataExport {
+	d.format = format
+	return d
+}
+
+func (d *DataExport) WithStatus(status ExportStatus) *DataExport {
+	d.status = status
+	return d
+}
+
+func (d *DataExport) WithPayload(payload []byte) *DataExport {
+	d.payload = payload
+	return d
+}
+
+func (d *DataExport) WithFailureReason(failureReason string) *DataExport {
+	d.failureReason = failureReason
+	return d
+}
+
+func (d *DataExport) WithRequestedAt(requestedAt time.Time) *DataExport {
+	d.requestedAt = requestedAt
+	return d
+}
+
+func (d *DataExport) WithCompletedAt(completedAt *time.Time) *DataExport {
+	d.completedAt = completedAt
+	return d
+}
+
+func (d *DataExport) WithExpiresAt(expiresAt *time.Time) *DataExport {
+	d.expiresAt = expiresAt
+	return d
+}
+
+// IsInProgress reports whether the export still waits for the worker.
+func (d *DataExport) IsInProgress() bool {
+	return d.status == ExportStatusPending || d.status == ExportStatusProcessing
+}
+
+// IsDownloadable reports whether the bundle is ready and not expired at now.
+func (d *DataExport) IsDownloadable(now time.Time) bool {
+	return d.status == ExportStatusReady && d.expiresAt != nil && now.Before(*d.expiresAt)
+}
+
+func (d *DataExport) FileName() string {
+	return "personal-data-" + d.publicID + "." + string(d.format)
+}
+
+func (d *DataExport) ContentType() string {
+	if d.format == ExportFormatZIP {
+		return "application/zip"
+	}
+	return "application/json"
+}
+
+func (d *DataExport) ID() int64 {
+	return d.id
+}
+func (d *DataExport) PublicID() string {
+	return d.publicID
+}
+func (d *DataExport) UserID() int64 {
+	return d.userID
+}
+func (d *DataExport) Format() ExportFormat {
+	return d.format
+}
+func (d *DataExport) Status() ExportStatus {
+	return d.status
+}
+func (d *DataExport) Payload() []byte {
+	return d.payload
+}
+func (d *DataExport) FailureReason() string {
+	return d.failureReason
+}
+func (d *DataExport) RequestedAt() time.Time {
+	return d.requestedAt
+}
+func (d *DataExport) CompletedAt() *time.Time {
+	return d.completedAt
+}
+func (d *DataExport) ExpiresAt() *time.Time {
+	return d.expiresAt
+}
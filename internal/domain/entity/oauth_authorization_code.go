@@ -0,0 +1,125 @@
+package entity
+
+import "time"
+
+// AuthorizationCode is the single-use grant handed to a client through its
+// redirect URI and redeemed at the token endpoint with the PKCE verifier.
+type AuthorizationCode struct {
+	id            int64
+	codeHash      string
+	clientID      int64
+	userID        int64
+	redirectURI   string
+	scopes        []string
+	codeChallenge string
+	nonce         string
+	authTime      *time.Time
+	expiresAt     time.Time
+	consumedAt    *time.Time
+	createdAt     time.Time
+}
+
+func BuilderAuthorizationCode() *AuthorizationCode {
+	return &AuthorizationCode{}
+}
+
+func (a *AuthorizationCode) Build() AuthorizationCode {
+	return *a
+}
+
+func (a *AuthorizationCode) WithID(id int64) *AuthorizationCode {
+	a.id = id
+	return a
+}
+
+func (a *AuthorizationCode) WithCodeHash(codeHash string) *AuthorizationCode {
+	a.codeHash = codeHash
+	return a
+}
+
+func (a *AuthorizationCode) WithClientID(clientID int64) *AuthorizationCode {
+	a.clientID = clientID
+	return a
+}
+
+func (a *AuthorizationCode) WithUserID(userID int64) *AuthorizationCode {
+	a.userID = userID
+	return a
+}
+
+func (a *AuthorizationCode) WithRedirectURI(redirectURI string) *AuthorizationCode {
+	a.redirectURI = redirectURI
+	return a
+}
+
+func (a *AuthorizationCode) WithScopes(scopes []string) *AuthorizationCode {
+	a.scopes = scopes
+	return a
+}
+
+func (a *AuthorizationCode) WithCodeChallenge(codeChallenge string) *AuthorizationCode {
+	a.codeChallenge = codeChallenge
+	return a
+}
+
+func (a *AuthorizationCode) WithNonce(nonce string) *AuthorizationCode {
+	a.nonce = nonce
+	return a
+}
+
+func (a *AuthorizationCode) WithAuthTime(authTime *time.Time) *AuthorizationCode {
+	a.authTime = authTime
+	return a
+}
+
+func (a *AuthorizationCode) WithExpiresAt(expiresAt time.Time) *AuthorizationCode {
+	a.expiresAt = expiresAt
+	return a
+}
+
+func (a *AuthorizationCode) WithConsumedAt(consumedAt *time.Time) *AuthorizationCode {
+	a.consumedAt = consumedAt
+	return a
+}
+
+func (a *AuthorizationCode) WithCreatedAt(createdAt time.Time) *AuthorizationCode {
+	a.createdAt = createdAt
+	return a
+}
+
+func (a *AuthorizationCode) ID() int64 {
+	return a.id
+}
+func (a *AuthorizationCode) CodeHash() string {
+	return a.codeHash
+}
+func (a *AuthorizationCode) ClientID() int64 {
+	return a.clientID
+}
+func (a *AuthorizationCode) UserID() int64 {
+	return a.userID
+}
+func (a *AuthorizationCode) RedirectURI() string {
+	return a.redirectURI
+}
+func (a *AuthorizationCode) Scopes() []string {
+	return a.scopes
+}
+func (a *AuthorizationCode) CodeChallenge() string {
+	return a.codeChallenge
+}
+func (a *AuthorizationCode) Nonce() string {
+	return a.nonce
+}
+func (a *AuthorizationCode) AuthTime() *time.Time {
+	return a.authTime
+}
+func (a *AuthorizationCode) ExpiresAt() time.Time {
+	return a.expiresAt
+}
+func (a *AuthorizationCode) ConsumedAt() *time.Time {
+	return a.consumedAt
+}
+func (a *AuthorizationCode) CreatedAt() time.Time {
+	return a.createdAt
+}
@@ -0,0 +1,221 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+const (
+	maxClientNameLength     = 100
+	errUnsupportedGrantType = "contains an unsupported grant type"
+	errServiceClientSecret  = "is required for the client_credentials grant"
+)
+
+const (
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeClientCredentials = "client_credentials"
+)
+
+// OAuthClient is an application registered to request access on behalf of
+// users, or a service acting on its own behalf with the client_credentials
+// grant. Clients without a secret are public and rely on PKCE alone.
+type OAuthClient struct {
+	id           int64
+	clientID     string
+	secretHash   string
+	name         string
+	redirectURIs []string
+	scopes       []string
+	grantTypes   []string
+	audiences    []string
+	disabledAt   *time.Time
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func BuilderOAuthClient() *OAuthClient {
+	return &OAuthClient{}
+}
+
+func (c *OAuthClient) Build() OAuthClient {
+	return *c
+}
+
+func (c *OAuthClient) WithID(id int64) *OAuthClient {
+	c.id = id
+	return c
+}
+
+func (c *OAuthClient) WithClientID(clientID string) *OAuthClient {
+	c.clientID = clientID
+	return c
+}
+
+func (c *OAuthClient) WithSecretHash(secretHash string) *OAuthClient {
+	c.secretHash = secretHash
+	return c
+}
+
+func (c *OAuthClient) WithName(name string) *OAuthClient {
+	c.name = name
+	return c
+}
+
+func (c *OAuthClient) WithRedirectURIs(redirectURIs []string) *OAuthClient {
+	c.redirectURIs = redirectURIs
+	return c
+}
+
+func (c *OAuthClient) WithScopes(scopes []string) *OAuthClient {
+	c.scopes = scopes
+	return c
+}
+
+func (c *OAuthClient) WithGrantTypes(grantTypes []string) *OAuthClient {
+	c.grantTypes = grantTypes
+	return c
+}
+
+func (c *OAuthClient) WithAudiences(audiences []string) *OAuthClient {
+	c.audiences = audiences
+	return c
+}
+
+func (c *OAuthClient) WithDisabledAt(disabledAt *time.Time) *OAuthClient {
+	c.disabledAt = disabledAt
+	return c
+}
+
+func (c *OAuthClient) WithCreatedAt(createdAt time.Time) *OAuthClient {
+	c.createdAt = createdAt
+	return c
+}
+
+func (c *OAuthClient) WithUpdatedAt(updatedAt time.Time) *OAuthClient {
+	c.updatedAt = updatedAt
+	return c
+}
+
+func (c *OAuthClient) Validate() *validator.Validator {
+	v := validator.New()
+	v.Assert(validator.NotBlank(c.name), "name", validator.ErrNotBlank)
+	v.Assert(validator.MaxChars(c.name, maxClientNameLength), "name", fmt.Sprintf(validator.ErrMaxLength, maxClientNameLength))
+	v.Assert(len(c.grantTypes) > 0, "grant_types", validator.ErrNotBlank)
+	for _, grantType := range c.grantTypes {
+		v.Assert(grantType == GrantTypeAuthorizationCode || grantType == GrantTypeClientCredentials,
+			"grant_types", errUnsupportedGrantType)
+	}
+	for _, uri := range c.redirectURIs {
+		v.Assert(validator.IsRedirectURI(uri), "redirect_uris", validator.ErrInvalidRedirectURI)
+	}
+	for _, audience := range c.audiences {
+		v.Assert(validator.IsScopeToken(audience), "audiences", validator.ErrInvalidScopeToken)
+	}
+
+	// user-facing clients act with the permissions of the user, while a
+	// service scope is only meaningful to the audience receiving the token
+	if c.AllowsGrantType(GrantTypeAuthorizationCode) {
+		v.Assert(len(c.redirectURIs) > 0, "redirect_uris", validator.ErrNotBlank)
+		for _, scope := range c.scopes {
+			v.Assert(IsKnownPermission(scope) || IsOIDCScope(scope), "scopes", validator.ErrUnknownScope)
+		}
+	}
+	if c.AllowsGrantType(GrantTypeClientCredentials) {
+		v.Assert(c.IsConfidential(), "client_secret", errServiceClientSecret)
+		v.Assert(len(c.audiences) > 0, "audiences", validator.ErrNotBlank)
+		for _, scope := range c.scopes {
+			v.Assert(validator.IsScopeToken(scope), "scopes", validator.ErrInvalidScopeToken)
+		}
+	}
+	return v
+}
+
+func (c *OAuthClient) AssignID(id int64) *OAuthClient {
+	c.id = id
+	return c
+}
+
+func (c *OAuthClient) AssignCreatedAt(createdAt time.Time) *OAuthClient {
+	c.createdAt = createdAt
+	return c
+}
+
+func (c *OAuthClient) AssignUpdatedAt(updatedAt time.Time) *OAuthClient {
+	c.updatedAt = updatedAt
+	return c
+}
+
+// IsConfidential reports whether the client must authenticate with a secret.
+func (c *OAuthClient) IsConfidential() bool {
+	return c.secretHash != ""
+}
+
+// AllowsRedirectURI compares uri exactly against the registered URIs.
+func (c *OAuthClient) AllowsRedirectURI(uri string) bool {
+	return slices.Contains(c.redirectURIs, uri)
+}
+
+func (c *OAuthClient) AllowsGrantType(grantType string) bool {
+	return slices.Contains(c.grantTypes, grantType)
+}
+
+func (c *OAuthClient) AllowsAudiences(audiences []string) bool {
+	for _, audience := range audiences {
+		if !slices.Contains(c.audiences, audience) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsDisabled reports whether the client was switched off; it can no longer
+// authenticate or start authorization requests.
+func (c *OAuthClient) IsDisabled() bool {
+	return c.disabledAt != nil
+}
+
+func (c *OAuthClient) AllowsScopes(scopes []string) bool {
+	for _, scope := range scopes {
+		if !slices.Contains(c.scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *OAuthClient) ID() int64 {
+	return c.id
+}
+func (c *OAuthClient) ClientID() string {
+	return c.clientID
+}
+func (c *OAuthClient) SecretHash() string {
+	return c.secretHash
+}
+func (c *OAuthClient) Name() string {
+	return c.name
+}
+func (c *OAuthClient) RedirectURIs() []string {
+	return c.redirectURIs
+}
+func (c *OAuthClient) Scopes() []string {
+	return c.scopes
+}
+func (c *OAuthClient) GrantTypes() []string {
+	return c.grantTypes
+}
+func (c *OAuthClient) Audiences() []string {
+	return c.audiences
+}
+func (c *OAuthClient) DisabledAt() *time.Time {
+	return c.disabledAt
+}
+func (c *OAuthClient) CreatedAt() time.Time {
+	return c.createdAt
+}
+func (c *OAuthClient) UpdatedAt() time.Time {
+	return c.updatedAt
+}
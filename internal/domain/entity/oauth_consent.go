@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"slices"
+	"time"
+)
+
+// OAuthConsent records the scopes a user has granted to a client, so later
+// authorization requests within them need no new confirmation.
+type OAuthConsent struct {
+	id         int64
+	userID     int64
+	clientID   int64
+	clientName string
+	scopes     []string
+	createdAt  time.Time
+	updatedAt  time.Time
+}
+
+func BuilderOAuthConsent() *OAuthConsent {
+	return &OAuthConsent{}
+}
+
+func (c *OAuthConsent) Build() OAuthConsent {
+	return *c
+}
+
+func (c *OAuthConsent) WithID(id int64) *OAuthConsent {
+	c.id = id
+	return c
+}
+
+func (c *OAuthConsent) WithUserID(userID int64) *OAuthConsent {
+	c.userID = userID
+	return c
+}
+
+func (c *OAuthConsent) WithClientID(clientID int64) *OAuthConsent {
+	c.clientID = clientID
+	return c
+}
+
+// WithClientName names the client for people; only listings load it.
+func (c *OAuthConsent) WithClientName(clientName string) *OAuthConsent {
+	c.clientName = clientName
+	return c
+}
+
+func (c *OAuthConsent) WithScopes(scopes []string) *OAuthConsent {
+	c.scopes = scopes
+	return c
+}
+
+func (c *OAuthConsent) WithCreatedAt(createdAt time.Time) *OAuthConsent {
+	c.createdAt = createdAt
+	return c
+}
+
+func (c *OAuthConsent) WithUpdatedAt(updatedAt time.Time) *OAuthConsent {
+	c.updatedAt = updatedAt
+	return c
+}
+
+// Covers reports whether every scope was already granted.
+func (c *OAuthConsent) Covers(scopes []string) bool {
+	for _, scope := range scopes {
+		if !slices.Contains(c.scopes, scope) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *OAuthConsent) ID() int64 {
+	return c.id
+}
+func (c *OAuthConsent) UserID() int64 {
+	return c.userID
+}
+func (c *OAuthConsent) ClientID() int64 {
+	return c.clientID
+}
+func (c *OAuthConsent) ClientName() string {
+	return c.clientName
+}
+func (c *OAuthConsent) Scopes() []string {
+	return c.scopes
+}
+func (c *OAuthConsent) CreatedAt() time.Time {
+	return c.createdAt
+}
+func (c *OAuthConsent) UpdatedAt() time.Time {
+	return c.updatedAt
+}
@@ -0,0 +1,19 @@
+package entity
+
+// OpenID Connect scopes a user-facing client may request next to permissions.
+// They grant no access of their own, only claims about the user.
+const (
+	ScopeOpenID  = "openid"
+	ScopeEmail   = "email"
+	ScopeProfile = "profile"
+)
+
+// IsOIDCScope reports whether name is one of the scopes above.
+func IsOIDCScope(name string) bool {
+	switch name {
+	case ScopeOpenID, ScopeEmail, ScopeProfile:
+		return true
+	default:
+		return false
+	}
+}
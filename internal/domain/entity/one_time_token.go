@@ -0,0 +1,92 @@
+package entity
+
+import "time"
+
+// TokenPurpose scopes a one-time token, so a token issued for one flow can
+// never be redeemed by another.
+type TokenPurpose string
+
+const (
+	TokenPurposePasswordReset TokenPurpose = "password_reset"
+	TokenPurposeMagicLink     TokenPurpose = "magic_link"
+)
+
+type OneTimeToken struct {
+	id        int64
+	userID    int64
+	purpose   TokenPurpose
+	tokenHash string
+	expiresAt time.Time
+	usedAt    *time.Time
+	createdAt time.Time
+}
+
+func BuilderOneTimeToken() *OneTimeToken {
+	return &OneTimeToken{}
+}
+
+func (o *OneTimeToken) Build() OneTimeToken {
+	return *o
+}
+
+func (o *OneTimeToken) WithID(id int64) *OneTimeToken {
+	o.id = id
+	return o
+}
+
+func (o *OneTimeToken) WithUserID(userID int64) *OneTimeToken {
+	o.userID = userID
+	return o
+}
+
+func (o *OneTimeToken) WithPurpose(purpose TokenPurpose) *OneTimeToken {
+	o.purpose = purpose
+	return o
+}
+
+func (o *OneTimeToken) WithTokenHash(tokenHash string) *OneTimeToken {
+	o.tokenHash = tokenHash
+	return o
+}
+
+func (o *OneTimeToken) WithExpiresAt(expiresAt time.Time) *OneTimeToken {
+	o.expiresAt = expiresAt
+	return o
+}
+
+func (o *OneTimeToken) WithUsedAt(usedAt *time.Time) *OneTimeToken {
+	o.usedAt = usedAt
+	return o
+}
+
+func (o *OneTimeToken) WithCreatedAt(createdAt time.Time) *OneTimeToken {
+	o.createdAt = createdAt
+	return o
+}
+
+func (o *OneTimeToken) AssignID(id int64) *OneTimeToken {
+	o.id = id
+	return o
+}
+
+func (o *OneTimeToken) ID() int64 {
+	return o.id
+}
+func (o *OneTimeToken) UserID() int64 {
+	return o.userID
+}
+func (o *OneTimeToken) Purpose() TokenPurpose {
+	return o.purpose
+}
+func (o *OneTimeToken) TokenHash() string {
+	return o.tokenHash
+}
+func (o *OneTimeToken) ExpiresAt() time.Time {
+	return o.expiresAt
+}
+func (o *OneTimeToken) UsedAt() *time.Time {
+	return o.usedAt
+}
+func (o *OneTimeToken) CreatedAt() time.Time {
+	return o.createdAt
+}
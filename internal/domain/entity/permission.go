@@ -0,0 +1,26 @@
+package entity
+
+// Permission names an action a role may perform. Role grants are persisted in
+// role_permissions; the migration seeds the defaults below.
+type Permission string
+
+const (
+	PermissionProfileRead   Permission = "profile:read"
+	PermissionProfileWrite  Permission = "profile:write"
+	PermissionUsersRead     Permission = "users:read"
+	PermissionUsersWrite    Permission = "users:write"
+	PermissionUsersDelete   Permission = "users:delete"
+	PermissionRolesManage   Permission = "roles:manage"
+	PermissionClientsManage Permission = "clients:manage"
+)
+
+// IsKnownPermission reports whether name is one of the permissions above.
+func IsKnownPermission(name string) bool {
+	switch Permission(name) {
+	case PermissionProfileRead, PermissionProfileWrite, PermissionUsersRead, PermissionUsersWrite,
+		PermissionUsersDelete, PermissionRolesManage, PermissionClientsManage:
+		return true
+	default:
+		return false
+	}
+}
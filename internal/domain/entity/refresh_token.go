@@ -0,0 +1,111 @@
+package entity
+
+import "time"
+
+type RefreshToken struct {
+	id        int64
+	userID    int64
+	familyID  string
+	tokenHash string
+	expiresAt time.Time
+	rotatedAt *time.Time
+	revokedAt *time.Time
+	createdAt time.Time
+}
+
+func BuilderRefreshToken() *RefreshToken {
+	return &RefreshToken{}
+}
+
+func (r *RefreshToken) Build() RefreshToken {
+	return *r
+}
+
+func (r *RefreshToken) WithID(id int64) *RefreshToken {
+	r.id = id
+	return r
+}
+
+func (r *RefreshToken) WithUserID(userID int64) *RefreshToken {
+	r.userID = userID
+	return r
+}
+
+func (r *RefreshToken) WithFamilyID(familyID string) *RefreshToken {
+	r.familyID = familyID
+	return r
+}
+
+func (r *RefreshToken) WithTokenHash(tokenHash string) *RefreshToken {
+	r.tokenHash = tokenHash
+	return r
+}
+
+func (r *RefreshToken) WithExpiresAt(expiresAt time.Time) *RefreshToken {
+	r.expiresAt = expiresAt
+	return r
+}
+
+func (r *RefreshToken) WithRotatedAt(rotatedAt *time.Time) *RefreshToken {
+	r.rotatedAt = rotatedAt
+	return r
+}
+
+func (r *RefreshToken) WithRevokedAt(revokedAt *time.Time) *RefreshToken {
+	r.revokedAt = revokedAt
+	return r
+}
+
+func (r *RefreshToken) WithCreatedAt(createdAt time.Time) *RefreshToken {
+	r.createdAt = createdAt
+	return r
+}
+
+func (r *RefreshToken) AssignID(id int64) *RefreshToken {
+	r.id = id
+	return r
+}
+
+func (r *RefreshToken) AssignCreatedAt(createdAt time.Time) *RefreshToken {
+	r.createdAt = createdAt
+	return r
+}
+
+// IsRotated reports whether the token was already exchanged for a new one.
+// Presenting a rotated token again is treated as a reuse attempt.
+func (r *RefreshToken) IsRotated() bool {
+	return r.rotatedAt != nil
+}
+
+func (r *RefreshToken) IsRevoked() bool {
+	return r.revokedAt != nil
+}
+
+func (r *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(r.expiresAt)
+}
+
+func (r *RefreshToken) ID() int64 {
+	return r.id
+}
+func (r *RefreshToken) UserID() int64 {
+	return r.userID
+}
+func (r *RefreshToken) FamilyID() string {
+	return r.familyID
+}
+func (r *RefreshToken) TokenHash() string {
+	return r.tokenHash
+}
+func (r *RefreshToken) ExpiresAt() time.Time {
+	return r.expiresAt
+}
+func (r *RefreshToken) RotatedAt() *time.Time {
+	return r.rotatedAt
+}
+func (r *RefreshToken) RevokedAt() *time.Time {
+	return r.revokedAt
+}
+func (r *RefreshToken) CreatedAt() time.Time {
+	return r.createdAt
+}
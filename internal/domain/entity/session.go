@@ -0,0 +1,104 @@
+package entity
+
+import "time"
+
+// Session is a sign-in of a user on a device. It shares its id with the
+// refresh token family issued at sign-in, and lives as long as the family
+// still has a usable refresh token.
+type Session struct {
+	id         int64
+	userID     int64
+	familyID   string
+	deviceName string
+	userAgent  string
+	ipAddress  string
+	createdAt  time.Time
+	lastSeenAt time.Time
+}
+
+func BuilderSession() *Session {
+	return &Session{}
+}
+
+func (s *Session) Build() Session {
+	return *s
+}
+
+func (s *Session) WithID(id int64) *Session {
+	s.id = id
+	return s
+}
+
+func (s *Session) WithUserID(userID int64) *Session {
+	s.userID = userID
+	return s
+}
+
+func (s *Session) WithFamilyID(familyID string) *Session {
+	s.familyID = familyID
+	return s
+}
+
+func (s *Session) WithDeviceName(deviceName string) *Session {
+	s.deviceName = deviceName
+	return s
+}
+
+func (s *Session) WithUserAgent(userAgent string) *Session {
+	s.userAgent = userAgent
+	return s
+}
+
+func (s *Session) WithIPAddress(ipAddress string) *Session {
+	s.ipAddress = ipAddress
+	return s
+}
+
+func (s *Session) WithCreatedAt(createdAt time.Time) *Session {
+	s.createdAt = createdAt
+	return s
+}
+
+func (s *Session) WithLastSeenAt(lastSeenAt time.Time) *Session {
+	s.lastSeenAt = lastSeenAt
+	return s
+}
+
+func (s *Session) AssignID(id int64) *Session {
+	s.id = id
+	return s
+}
+
+// Touch records activity of the session from ipAddress at now.
+func (s *Session) Touch(ipAddress string, now time.Time) *Session {
+	if ipAddress != "" {
+		s.ipAddress = ipAddress
+	}
+	s.lastSeenAt = now
+	return s
+}
+
+func (s *Session) ID() int64 {
+	return s.id
+}
+func (s *Session) UserID() int64 {
+	return s.userID
+}
+func (s *Session) FamilyID() string {
+	return s.familyID
+}
+func (s *Session) DeviceName() string {
+	return s.deviceName
+}
+func (s *Session) UserAgent() string {
+	return s.userAgent
+}
+func (s *Session) IPAddress() string {
+	return s.ipAddress
+}
+func (s *Session) CreatedAt() time.Time {
+	return s.createdAt
+}
+func (s *Session) LastSeenAt() time.Time {
+	return s.lastSeenAt
+}
@@ -1,29 +1,41 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/andreis3/auth-ms/internal/domain/validator"
 	vo2 "github.com/andreis3/auth-ms/internal/domain/vo"
 )
 
+const (
+	maxNameLength      = 255
+	maxAvatarURLLength = 2048
+)
+
 type RoleTypes string
 
 const (
-	RoleUser RoleTypes = "user"
+	RoleUser    RoleTypes = "user"
+	RoleAdmin   RoleTypes = "admin"
+	RoleSupport RoleTypes = "support"
 )
 
 type User struct {
-	id           int64
-	publicID     string
-	email        vo2.Email
-	password     vo2.Password
-	passwordHash string
-	name         string
-	role         RoleTypes
-	createAT     time.Time
-	updateAT     time.Time
-	deletedAt    *time.Time
+	id              int64
+	publicID        string
+	email           vo2.Email
+	emailVerifiedAt *time.Time
+	password        vo2.Password
+	passwordHash    string
+	name            string
+	role            RoleTypes
+	avatarURL       string
+	phone           vo2.Phone
+	phoneVerifiedAt *time.Time
+	createAT        time.Time
+	updateAT        time.Time
+	deletedAt       *time.Time
 }
 
 func BuilderUser() *User {
@@ -49,6 +61,11 @@ func (u *User) WithEmail(email string) *User {
 	return u
 }
 
+func (u *User) WithEmailVerifiedAt(emailVerifiedAt *time.Time) *User {
+	u.emailVerifiedAt = emailVerifiedAt
+	return u
+}
+
 func (u *User) WithPassword(password string) *User {
 	u.password = vo2.NewPassword(password)
 	return u
@@ -64,6 +81,21 @@ func (u *User) WithRole(role RoleTypes) *User {
 	return u
 }
 
+func (u *User) WithAvatarURL(avatarURL string) *User {
+	u.avatarURL = avatarURL
+	return u
+}
+
+func (u *User) WithPhone(phone string) *User {
+	u.phone = vo2.NewPhone(phone)
+	return u
+}
+
+func (u *User) WithPhoneVerifiedAt(phoneVerifiedAt *time.Time) *User {
+	u.phoneVerifiedAt = phoneVerifiedAt
+	return u
+}
+
 func (u *User) WithCreateAT(createAT time.Time) *User {
 	u.createAT = createAT
 	return u
@@ -89,6 +121,18 @@ func (u *User) Validate() *validator.Validator {
 	return v
 }
 
+// ValidateProfile checks the fields a user may change on its own profile.
+func (u *User) ValidateProfile() *validator.Validator {
+	v := validator.New()
+	v.Assert(validator.NotBlank(u.name), "name", validator.ErrNotBlank)
+	v.Assert(validator.MaxChars(u.name, maxNameLength), "name", fmt.Sprintf(validator.ErrMaxLength, maxNameLength))
+	if u.avatarURL != "" {
+		v.Assert(validator.MaxChars(u.avatarURL, maxAvatarURLLength), "avatar_url", fmt.Sprintf(validator.ErrMaxLength, maxAvatarURLLength))
+		v.Assert(validator.IsHTTPURL(u.avatarURL), "avatar_url", validator.ErrInvalidURL)
+	}
+	return v
+}
+
 func (u *User) AssignID(id int64) *User {
 	u.id = id
 	return u
@@ -104,6 +148,31 @@ func (u *User) AssignPublicID(publicID string) *User {
 	return u
 }
 
+func (u *User) AssignName(name string) *User {
+	u.name = name
+	return u
+}
+
+func (u *User) AssignAvatarURL(avatarURL string) *User {
+	u.avatarURL = avatarURL
+	return u
+}
+
+func (u *User) AssignEmailVerifiedAt(emailVerifiedAt *time.Time) *User {
+	u.emailVerifiedAt = emailVerifiedAt
+	return u
+}
+
+func (u *User) AssignPhone(phone string) *User {
+	u.phone = vo2.NewPhone(phone)
+	return u
+}
+
+func (u *User) AssignPhoneVerifiedAt(phoneVerifiedAt *time.Time) *User {
+	u.phoneVerifiedAt = phoneVerifiedAt
+	return u
+}
+
 func (u *User) AssignRole(role RoleTypes) *User {
 	u.role = role
 	return u
@@ -133,6 +202,12 @@ func (u *User) PublicID() string {
 func (u *User) Email() string {
 	return u.email.String()
 }
+func (u *User) EmailVerifiedAt() *time.Time {
+	return u.emailVerifiedAt
+}
+func (u *User) IsEmailVerified() bool {
+	return u.emailVerifiedAt != nil
+}
 func (u *User) Password() string {
 	return u.password.String()
 }
@@ -145,6 +220,20 @@ func (u *User) Name() string {
 func (u *User) Role() string {
 	return string(u.role)
 }
+func (u *User) AvatarURL() string {
+	return u.avatarURL
+}
+func (u *User) Phone() string {
+	return u.phone.String()
+}
+func (u *User) PhoneVerifiedAt() *time.Time {
+	return u.phoneVerifiedAt
+}
+
+// HasVerifiedPhone reports whether the user confirmed ownership of its phone.
+func (u *User) HasVerifiedPhone() bool {
+	return u.phone.String() != "" && u.phoneVerifiedAt != nil
+}
 func (u *User) CreateAT() time.Time {
 	return u.createAT
 }
@@ -0,0 +1,85 @@
+package entity
+
+import "time"
+
+// UserIdentity links an account at an external identity provider, named by
+// the provider and its subject id, to a local user.
+type UserIdentity struct {
+	id          int64
+	userID      int64
+	provider    string
+	subject     string
+	email       string
+	createdAt   time.Time
+	lastLoginAt time.Time
+}
+
+func BuilderUserIdentity() *UserIdentity {
+	return &UserIdentity{}
+}
+
+func (u *UserIdentity) Build() UserIdentity {
+	return *u
+}
+
+func (u *UserIdentity) WithID(id int64) *UserIdentity {
+	u.id = id
+	return u
+}
+
+func (u *UserIdentity) WithUserID(userID int64) *UserIdentity {
+	u.userID = userID
+	return u
+}
+
+func (u *UserIdentity) WithProvider(provider string) *UserIdentity {
+	u.provider = provider
+	return u
+}
+
+func (u *UserIdentity) WithSubject(subject string) *UserIdentity {
+	u.subject = subject
+	return u
+}
+
+func (u *UserIdentity) WithEmail(email string) *UserIdentity {
+	u.email = email
+	return u
+}
+
+func (u *UserIdentity) WithCreatedAt(createdAt time.Time) *UserIdentity {
+	u.createdAt = createdAt
+	return u
+}
+
+func (u *UserIdentity) WithLastLoginAt(lastLoginAt time.Time) *UserIdentity {
+	u.lastLoginAt = lastLoginAt
+	return u
+}
+
+func (u *UserIdentity) AssignID(id int64) *UserIdentity {
+	u.id = id
+	return u
+}
+
+func (u *UserIdentity) ID() int64 {
+	return u.id
+}
+func (u *UserIdentity) UserID() int64 {
+	return u.userID
+}
+func (u *UserIdentity) Provider() string {
+	return u.provider
+}
+func (u *UserIdentity) Subject() string {
+	return u.subject
+}
+func (u *UserIdentity) Email() string {
+	return u.email
+}
+func (u *UserIdentity) CreatedAt() time.Time {
+	return u.createdAt
+}
+func (u *UserIdentity) LastLoginAt() time.Time {
+	return u.lastLoginAt
+}
@@ -0,0 +1,76 @@
+package entity
+
+import "time"
+
+// UserMFA is the TOTP authenticator of a user. It only guards sign-ins once
+// confirmed with a first valid code; lastUsedStep is the time step of the last
+// accepted code, so a code can never be accepted twice.
+type UserMFA struct {
+	userID       int64
+	totpSecret   string
+	confirmedAt  *time.Time
+	lastUsedStep int64
+	createdAt    time.Time
+	updatedAt    time.Time
+}
+
+func BuilderUserMFA() *UserMFA {
+	return &UserMFA{}
+}
+
+func (m *UserMFA) Build() UserMFA {
+	return *m
+}
+
+func (m *UserMFA) WithUserID(userID int64) *UserMFA {
+	m.userID = userID
+	return m
+}
+
+func (m *UserMFA) WithTOTPSecret(totpSecret string) *UserMFA {
+	m.totpSecret = totpSecret
+	return m
+}
+
+func (m *UserMFA) WithConfirmedAt(confirmedAt *time.Time) *UserMFA {
+	m.confirmedAt = confirmedAt
+	return m
+}
+
+func (m *UserMFA) WithLastUsedStep(lastUsedStep int64) *UserMFA {
+	m.lastUsedStep = lastUsedStep
+	return m
+}
+
+func (m *UserMFA) WithCreatedAt(createdAt time.Time) *UserMFA {
+	m.createdAt = createdAt
+	return m
+}
+
+func (m *UserMFA) WithUpdatedAt(updatedAt time.Time) *UserMFA {
+	m.updatedAt = updatedAt
+	return m
+}
+
+func (m *UserMFA) IsConfirmed() bool {
+	return m.confirmedAt != nil
+}
+
+func (m *UserMFA) UserID() int64 {
+	return m.userID
+}
+func (m *UserMFA) TOTPSecret() string {
+	return m.totpSecret
+}
+func (m *UserMFA) ConfirmedAt() *time.Time {
+	return m.confirmedAt
+}
+func (m *UserMFA) LastUsedStep() int64 {
+	return m.lastUsedStep
+}
+func (m *UserMFA) CreatedAt() time.Time {
+	return m.createdAt
+}
+func (m *UserMFA) UpdatedAt() time.Time {
+	return m.updatedAt
+}
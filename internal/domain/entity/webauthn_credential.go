@@ -0,0 +1,144 @@
+package entity
+
+import "time"
+
+// WebAuthnCredential is a passkey of a user: the public key an authenticator
+// created for this relying party, named by its credential id.
+type WebAuthnCredential struct {
+	id             int64
+	userID         int64
+	credentialID   string
+	publicKey      []byte
+	algorithm      int64
+	signCount      uint32
+	transports     []string
+	aaguid         string
+	backupEligible bool
+	backedUp       bool
+	name           string
+	createdAt      time.Time
+	lastUsedAt     *time.Time
+}
+
+func BuilderWebAuthnCredential() *WebAuthnCredential {
+	return &WebAuthnCredential{}
+}
+
+func (w *WebAuthnCredential) Build() WebAuthnCredential {
+	return *w
+}
+
+func (w *WebAuthnCredential) WithID(id int64) *WebAuthnCredential {
+	w.id = id
+	return w
+}
+
+func (w *WebAuthnCredential) WithUserID(userID int64) *WebAuthnCredential {
+	w.userID = userID
+	return w
+}
+
+func (w *WebAuthnCredential) WithCredentialID(credentialID string) *WebAuthnCredential {
+	w.credentialID = credentialID
+	return w
+}
+
+func (w *WebAuthnCredential) WithPublicKey(publicKey []byte) *WebAuthnCredential {
+	w.publicKey = publicKey
+	return w
+}
+
+func (w *WebAuthnCredential) WithAlgorithm(algorithm int64) *WebAuthnCredential {
+	w.algorithm = algorithm
+	return w
+}
+
+func (w *WebAuthnCredential) WithSignCount(signCount uint32) *WebAuthnCredential {
+	w.signCount = signCount
+	return w
+}
+
+func (w *WebAuthnCredential) WithTransports(transports []string) *WebAuthnCredential {
+	w.transports = transports
+	return w
+}
+
+func (w *WebAuthnCredential) WithAAGUID(aaguid string) *WebAuthnCredential {
+	w.aaguid = aaguid
+	return w
+}
+
+func (w *WebAuthnCredential) WithBackupEligible(backupEligible bool) *WebAuthnCredential {
+	w.backupEligible = backupEligible
+	return w
+}
+
+func (w *WebAuthnCredential) WithBackedUp(backedUp bool) *WebAuthnCredential {
+	w.backedUp = backedUp
+	return w
+}
+
+func (w *WebAuthnCredential) WithName(name string) *WebAuthnCredential {
+	w.name = name
+	return w
+}
+
+func (w *WebAuthnCredential) WithCreatedAt(createdAt time.Time) *WebAuthnCredential {
+	w.createdAt = createdAt
+	return w
+}
+
+func (w *WebAuthnCredential) WithLastUsedAt(lastUsedAt *time.Time) *WebAuthnCredential {
+	w.lastUsedAt = lastUsedAt
+	return w
+}
+
+// IsCloneSuspected reports whether signCount, reported by the authenticator
+// on sign-in, fails to move past the stored one. Authenticators that do not
+// keep a counter always report zero.
+func (w *WebAuthnCredential) IsCloneSuspected(signCount uint32) bool {
+	if signCount == 0 && w.signCount == 0 {
+		return false
+	}
+	return signCount <= w.signCount
+}
+
+func (w *WebAuthnCredential) ID() int64 {
+	return w.id
+}
+func (w *WebAuthnCredential) UserID() int64 {
+	return w.userID
+}
+func (w *WebAuthnCredential) CredentialID() string {
+	return w.credentialID
+}
+func (w *WebAuthnCredential) PublicKey() []byte {
+	return w.publicKey
+}
+func (w *WebAuthnCredential) Algorithm() int64 {
+	return w.algorithm
+}
+func (w *WebAuthnCredential) SignCount() uint32 {
+	return w.signCount
+}
+func (w *WebAuthnCredential) Transports() []string {
+	return w.transports
+}
+func (w *WebAuthnCredential) AAGUID() string {
+	return w.aaguid
+}
+func (w *WebAuthnCredential) BackupEligible() bool {
+	return w.backupEligible
+}
+func (w *WebAuthnCredential) BackedUp() bool {
+	return w.backedUp
+}
+func (w *WebAuthnCredential) Name() string {
+	return w.name
+}
+func (w *WebAuthnCredential) CreatedAt() time.Time {
+	return w.createdAt
+}
+func (w *WebAuthnCredential) LastUsedAt() *time.Time {
+	return w.lastUsedAt
+}
@@ -9,11 +9,24 @@ const (
 	ErrForbidden           Code = "ERR_FORBIDDEN"
 	ErrConflict            Code = "ERR_CONFLICT"
 	ErrUnprocessableEntity Code = "ERR_UNPROCESSABLE"
+	ErrTooManyRequests     Code = "ERR_TOO_MANY_REQUESTS"
+	ErrAccountLocked       Code = "ERR_ACCOUNT_LOCKED"
 	ErrInternal            Code = "ERR_INTERNAL"
 )
 
 const (
-	InternalServerError        = "Internal server error"
-	ServerErrorFriendlyMessage = "Internal server error"
-	InvalidCredentialsMessage  = "Invalid credentials"
+	InternalServerError           = "Internal server error"
+	ServerErrorFriendlyMessage    = "Internal server error"
+	InvalidCredentialsMessage     = "Invalid credentials"
+	AuthenticationRequiredMessage = "Authentication required"
+	AccessDeniedMessage           = "You do not have permission to perform this action"
+	PasskeyLoginFailedMessage     = "We could not sign you in with this passkey."
 )
+
+// OAuthErrorField holds, in Error.Fields, the RFC 6749 error code answered by
+// the OAuth endpoints.
+const OAuthErrorField = "oauth_error"
+
+// RetryAfterField holds, in Error.Fields, the seconds a client must wait
+// before trying again. It is also answered as the Retry-After header.
+const RetryAfterField = "retry_after_seconds"
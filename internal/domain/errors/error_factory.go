@@ -67,3 +67,125 @@ func ErrorSetCache(err error) *Error {
 		WithOrigin("Redis.SetCache").
 		WithFriendly(ServerErrorFriendlyMessage)
 }
+
+func ErrorDeleteCache(err error) *Error {
+
+	return Wrap(err, ErrInternal, "Error deleting cache").
+		WithOrigin("Redis.DeleteCache").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+func ErrorIncrementCache(err error) *Error {
+	return Wrap(err, ErrInternal, "Error incrementing cache").
+		WithOrigin("Redis.IncrementCache").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+func ErrorDeleteCacheByPrefix(err error) *Error {
+	return Wrap(err, ErrInternal, "Error deleting cache keys by prefix").
+		WithOrigin("Redis.DeleteByPrefix").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+func ErrorRateLimiter(err error) *Error {
+	return Wrap(err, ErrInternal, "Error evaluating rate limit").
+		WithOrigin("Redis.RateLimiter").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+/*********Token Errors***************/
+func ErrorGenerateOpaqueToken(err error) *Error {
+	return Wrap(err, ErrInternal, "Error generating opaque token").
+		WithOrigin("OpaqueToken.Generate").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+/*********JWT Errors***************/
+func ErrorGenerateToken(err error) *Error {
+	return Wrap(err, ErrInternal, "Error generating token").
+		WithOrigin("JWT.Generate").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+func ErrorInvalidToken(err error) *Error {
+	return Wrap(err, ErrUnauthorized, "Invalid token").
+		WithOrigin("JWT.Validate").
+		WithFriendly(InvalidCredentialsMessage)
+}
+
+/*********Mailer Errors***************/
+func ErrorSendMail(err error) *Error {
+	return Wrap(err, ErrInternal, "Error sending mail").
+		WithOrigin("Mailer.Send").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+/*********SMS Errors***************/
+func ErrorSendSms(err error) *Error {
+	return Wrap(err, ErrInternal, "Error sending sms").
+		WithOrigin("SmsSender.Send").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+/*********OTP Errors***************/
+func ErrorGenerateOTP(err error) *Error {
+	return Wrap(err, ErrInternal, "Error generating one-time code").
+		WithOrigin("OTPStore.Issue").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+func ErrorTooManyOTPAttempts() *Error {
+	return New(ErrTooManyRequests, "Too many one-time code attempts").
+		WithOrigin("OTPStore.Verify").
+		WithFriendly("Too many attempts. Please request a new code later.")
+}
+
+/*********MFA Errors***************/
+func ErrorGenerateMFASecret(err error) *Error {
+	return Wrap(err, ErrInternal, "Error generating MFA secret").
+		WithOrigin("TOTP.Generate").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+func ErrorTooManyMFACodeAttempts() *Error {
+	return New(ErrTooManyRequests, "Too many MFA code attempts").
+		WithOrigin("MFAService.VerifyUserCode").
+		WithFriendly("Too many attempts. Please try again later.")
+}
+
+func ErrorTooManyMFAAttempts() *Error {
+	return New(ErrTooManyRequests, "Too many MFA code attempts").
+		WithOrigin("MFAService.VerifyChallenge").
+		WithFriendly("Too many attempts. Please sign in again.")
+}
+
+/*********OAuth Errors***************/
+func ErrorOAuthProviderRequest(err error) *Error {
+	return Wrap(err, ErrInternal, "Error calling identity provider").
+		WithOrigin("IdentityProvider.Request").
+		WithFriendly(ServerErrorFriendlyMessage)
+}
+
+func ErrorOAuthCodeExchange(err error) *Error {
+	return Wrap(err, ErrUnauthorized, "Error exchanging authorization code").
+		WithOrigin("IdentityProvider.Exchange").
+		WithFriendly("We could not sign you in with this provider. Please try again.")
+}
+
+func ErrorInvalidIDToken(err error) *Error {
+	return Wrap(err, ErrUnauthorized, "Invalid id token").
+		WithOrigin("IdentityProvider.Exchange").
+		WithFriendly("We could not sign you in with this provider. Please try again.")
+}
+
+func ErrorInvalidPasskeyAttestation(reason string) *Error {
+	return Newf(ErrBadRequest, "Invalid WebAuthn attestation: %v", reason).
+		WithOrigin("WebAuthn.VerifyRegistration").
+		WithFriendly("This passkey could not be registered. Please try again.")
+}
+
+func ErrorInvalidPasskeyAssertion(reason string) *Error {
+	return Newf(ErrUnauthorized, "Invalid WebAuthn assertion: %v", reason).
+		WithOrigin("WebAuthn.VerifyAssertion").
+		WithFriendly(PasskeyLoginFailedMessage)
+}
@@ -1,8 +1,371 @@
 package errors
 
+import (
+	"fmt"
+	"time"
+)
+
 func ErrorAlreadyExists(publicID string) *Error {
 
 	return Newf(ErrConflict, "User with public ID %v already exists", publicID).
 		WithOrigin("UserRepository.CreateUser").
 		WithFriendly("User with this email already exists.")
 }
+
+func ErrorInvalidCredentials() *Error {
+	return New(ErrUnauthorized, "Invalid email or password").
+		WithOrigin("LoginAuthUser.Execute").
+		WithFriendly(InvalidCredentialsMessage)
+}
+
+func ErrorInvalidRefreshToken() *Error {
+	return New(ErrUnauthorized, "Refresh token is invalid, expired or revoked").
+		WithOrigin("RefreshAuthToken.Execute").
+		WithFriendly(InvalidCredentialsMessage)
+}
+
+func ErrorRefreshTokenReused(familyID string) *Error {
+	return Newf(ErrUnauthorized, "Refresh token reuse detected, family %v revoked", familyID).
+		WithOrigin("RefreshAuthToken.Execute").
+		WithFriendly(InvalidCredentialsMessage)
+}
+
+func ErrorRevokedToken(tokenID string) *Error {
+	return Newf(ErrUnauthorized, "Token %v has been revoked", tokenID).
+		WithOrigin("AuthTokenService.VerifyAccessToken").
+		WithFriendly(InvalidCredentialsMessage)
+}
+
+func ErrorMissingBearerToken() *Error {
+	return New(ErrUnauthorized, "Missing bearer token").
+		WithOrigin("Authentication.Authenticate").
+		WithFriendly(AuthenticationRequiredMessage)
+}
+
+func ErrorMissingScope(scope string) *Error {
+	return Newf(ErrForbidden, "Missing scope %v", scope).
+		WithOrigin("Authorization.RequirePermission").
+		WithFriendly(AccessDeniedMessage)
+}
+
+func ErrorMissingPermission(permission string) *Error {
+	return Newf(ErrForbidden, "Missing permission %v", permission).
+		WithOrigin("Authorization.RequirePermission").
+		WithFriendly(AccessDeniedMessage)
+}
+
+func ErrorMissingRole(role string) *Error {
+	return Newf(ErrForbidden, "Role %v is not allowed", role).
+		WithOrigin("Authorization.RequireRole").
+		WithFriendly(AccessDeniedMessage)
+}
+
+func ErrorUserNotFound(publicID string) *Error {
+	return Newf(ErrNotFound, "User with public ID %v not found", publicID).
+		WithOrigin("UserRepository.FindUserByPublicID").
+		WithFriendly("User not found.")
+}
+
+func ErrorAddressNotFound(publicID string) *Error {
+	return Newf(ErrNotFound, "Address with public ID %v not found", publicID).
+		WithOrigin("AddressRepository.FindAddressByPublicID").
+		WithFriendly("Address not found.")
+}
+
+func ErrorAddressLimitReached(limit int) *Error {
+	return Newf(ErrUnprocessableEntity, "User cannot have more than %d addresses", limit).
+		WithOrigin("CreateUserAddresses.Execute").
+		WithFriendly(fmt.Sprintf("You can register up to %d addresses.", limit))
+}
+
+func ErrorRestoreWindowExpired(publicID string) *Error {
+	return Newf(ErrUnprocessableEntity, "Restore window of user %v has expired", publicID).
+		WithOrigin("RestoreAuthUser.Execute").
+		WithFriendly("This account can no longer be restored.")
+}
+
+// ErrorAccountLocked answers sign-in attempts made before the delay imposed by
+// previous failures is over.
+func ErrorAccountLocked(retryAfter time.Duration) *Error {
+	return New(ErrAccountLocked, "Too many failed sign-in attempts").
+		WithOrigin("LoginThrottle.Check").
+		WithRetryAfter(retryAfter).
+		WithFriendly(fmt.Sprintf("Too many failed sign-in attempts. Please try again in %d seconds.", retryAfterSeconds(retryAfter)))
+}
+
+func ErrorRateLimited(retryAfter time.Duration) *Error {
+	return New(ErrTooManyRequests, "Rate limit exceeded").
+		WithOrigin("RateLimit.Limit").
+		WithRetryAfter(retryAfter).
+		WithFriendly("Too many requests. Please try again later.")
+}
+
+func ErrorIncorrectCurrentPassword() *Error {
+	return New(ErrForbidden, "Current password does not match").
+		WithOrigin("ChangePassword.Execute").
+		WithFriendly("The current password is incorrect.")
+}
+
+func ErrorEmailNotVerified() *Error {
+	return New(ErrForbidden, "Email address not verified").
+		WithOrigin("LoginAuthUser.Execute").
+		WithFriendly("Please confirm your e-mail address before signing in.")
+}
+
+func ErrorInvalidVerificationLink() *Error {
+	return New(ErrBadRequest, "Email verification link is invalid or expired").
+		WithOrigin("VerifyEmail.Execute").
+		WithFriendly("This verification link is invalid or has expired.")
+}
+
+func ErrorTooManyVerificationEmails() *Error {
+	return New(ErrTooManyRequests, "Too many verification e-mails requested").
+		WithOrigin("ResendEmailVerification.Execute").
+		WithFriendly("Too many requests. Please try again later.")
+}
+
+func ErrorInvalidResetCode() *Error {
+	return New(ErrBadRequest, "Password reset code is invalid or expired").
+		WithOrigin("VerifyPasswordResetCode.Execute").
+		WithFriendly("This code is invalid or has expired.")
+}
+
+func ErrorInvalidPhoneVerificationCode() *Error {
+	return New(ErrBadRequest, "Phone verification code is invalid or expired").
+		WithOrigin("VerifyPhone.Execute").
+		WithFriendly("This code is invalid or has expired.")
+}
+
+func ErrorInvalidResetToken() *Error {
+	return New(ErrBadRequest, "Password reset token is invalid, expired or already used").
+		WithOrigin("ResetPassword.Execute").
+		WithFriendly("This reset link is invalid or has expired.")
+}
+
+func ErrorDataExportInProgress(publicID string) *Error {
+	return Newf(ErrConflict, "Data export %v is still in progress", publicID).
+		WithOrigin("RequestDataExport.Execute").
+		WithFriendly("An export of your data is already in progress.")
+}
+
+func ErrorDataExportNotFound(publicID string) *Error {
+	return Newf(ErrNotFound, "Data export with public ID %v not found", publicID).
+		WithOrigin("DataExportRepository.FindDataExportByPublicID").
+		WithFriendly("Data export not found.")
+}
+
+func ErrorInvalidDownloadLink(publicID string) *Error {
+	return Newf(ErrForbidden, "Download link of data export %v is invalid or expired", publicID).
+		WithOrigin("DownloadDataExport.Execute").
+		WithFriendly("This download link is invalid or has expired.")
+}
+
+func ErrorEncodeDataExport(err error) *Error {
+	return Wrap(err, ErrInternal, "Error encoding data export").
+		WithOrigin("ProcessDataExports.Execute").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUnknownOAuthProvider(provider string) *Error {
+	return Newf(ErrNotFound, "OAuth provider %v is not configured", provider).
+		WithOrigin("StartOAuthLogin.Execute").
+		WithFriendly("This sign-in provider is not available.")
+}
+
+func ErrorInvalidOAuthState() *Error {
+	return New(ErrBadRequest, "OAuth state is invalid or expired").
+		WithOrigin("CompleteOAuthLogin.Execute").
+		WithFriendly("This sign-in attempt has expired. Please start again.")
+}
+
+func ErrorOAuthDenied(reason string) *Error {
+	return Newf(ErrUnauthorized, "OAuth provider returned error %v", reason).
+		WithOrigin("CompleteOAuthLogin.Execute").
+		WithFriendly("Sign-in was cancelled or denied by the provider.")
+}
+
+func ErrorOAuthEmailRequired(provider string) *Error {
+	return Newf(ErrBadRequest, "OAuth provider %v did not share an e-mail address", provider).
+		WithOrigin("CompleteOAuthLogin.Execute").
+		WithFriendly("The provider did not share your e-mail address. Please allow access to it and try again.")
+}
+
+func ErrorOAuthAccountConflict(provider string) *Error {
+	return Newf(ErrConflict, "E-mail reported by %v belongs to an account that cannot be linked automatically", provider).
+		WithOrigin("CompleteOAuthLogin.Execute").
+		WithFriendly("An account with this e-mail already exists. Sign in with your password to link this provider.")
+}
+
+func ErrorOAuthInvalidClient() *Error {
+	return New(ErrUnauthorized, "OAuth client is unknown or failed to authenticate").
+		WithOrigin("OAuthAuthorizationServer").
+		WithField(OAuthErrorField, "invalid_client").
+		WithFriendly("Client authentication failed.")
+}
+
+func ErrorOAuthInvalidRedirectURI() *Error {
+	return New(ErrBadRequest, "Redirect URI is not registered for the OAuth client").
+		WithOrigin("OAuthAuthorizationServer").
+		WithField(OAuthErrorField, "invalid_request").
+		WithFriendly("The redirect URI is not registered for this application.")
+}
+
+func ErrorOAuthInvalidRequest(reason string) *Error {
+	return Newf(ErrBadRequest, "Invalid OAuth request: %v", reason).
+		WithOrigin("OAuthAuthorizationServer").
+		WithField(OAuthErrorField, "invalid_request").
+		WithFriendly(reason)
+}
+
+func ErrorOAuthUnsupportedResponseType(responseType string) *Error {
+	return Newf(ErrBadRequest, "Unsupported response type %v", responseType).
+		WithOrigin("OAuthAuthorizationServer").
+		WithField(OAuthErrorField, "unsupported_response_type").
+		WithFriendly("Only the authorization code flow is supported.")
+}
+
+func ErrorOAuthInvalidScope(scope string) *Error {
+	return Newf(ErrBadRequest, "Scope %v is not allowed for the OAuth client", scope).
+		WithOrigin("OAuthAuthorizationServer").
+		WithField(OAuthErrorField, "invalid_scope").
+		WithFriendly("The requested scope is invalid or not allowed for this application.")
+}
+
+func ErrorOAuthConsentRequired() *Error {
+	return New(ErrForbidden, "User has not consented to the requested scopes").
+		WithOrigin("AuthorizeOAuthClient.Execute").
+		WithField(OAuthErrorField, "consent_required").
+		WithFriendly("Your consent is required to continue.")
+}
+
+func ErrorOAuthInvalidGrant(reason string) *Error {
+	return Newf(ErrBadRequest, "Invalid grant: %v", reason).
+		WithOrigin("ExchangeOAuthToken.Execute").
+		WithField(OAuthErrorField, "invalid_grant").
+		WithFriendly("The authorization grant is invalid, expired or was already used.")
+}
+
+func ErrorOAuthUnsupportedGrantType(grantType string) *Error {
+	return Newf(ErrBadRequest, "Unsupported grant type %v", grantType).
+		WithOrigin("ExchangeOAuthToken.Execute").
+		WithField(OAuthErrorField, "unsupported_grant_type").
+		WithFriendly("This grant type is not supported.")
+}
+
+func ErrorOAuthAccessDenied() *Error {
+	return New(ErrForbidden, "User denied the authorization request").
+		WithOrigin("AuthorizeOAuthClient.Execute").
+		WithField(OAuthErrorField, "access_denied").
+		WithFriendly("The authorization request was denied.")
+}
+
+func ErrorOAuthDelegatedConsent() *Error {
+	return New(ErrForbidden, "OAuth client tokens cannot authorize other clients").
+		WithOrigin("AuthorizeOAuthClient.Execute").
+		WithField(OAuthErrorField, "access_denied").
+		WithFriendly("Sign in to authorize this application.")
+}
+
+func ErrorOAuthUnauthorizedClient(grantType string) *Error {
+	return Newf(ErrBadRequest, "OAuth client is not allowed to use grant type %v", grantType).
+		WithOrigin("OAuthAuthorizationServer").
+		WithField(OAuthErrorField, "unauthorized_client").
+		WithFriendly("This application is not allowed to use this grant type.")
+}
+
+func ErrorOAuthInvalidTarget(audience string) *Error {
+	return Newf(ErrBadRequest, "Audience %v is not allowed for the OAuth client", audience).
+		WithOrigin("ExchangeOAuthToken.Execute").
+		WithField(OAuthErrorField, "invalid_target").
+		WithFriendly("The requested audience is invalid or not allowed for this application.")
+}
+
+func ErrorOAuthClientNotFound(clientID string) *Error {
+	return Newf(ErrNotFound, "OAuth client %v not found or disabled", clientID).
+		WithOrigin("OAuthClientManagement").
+		WithFriendly("OAuth client not found.")
+}
+
+func ErrorPublicOAuthClientSecret(clientID string) *Error {
+	return Newf(ErrUnprocessableEntity, "OAuth client %v is public and has no secret", clientID).
+		WithOrigin("RotateOAuthClientSecret.Execute").
+		WithFriendly("This application is public and has no secret to rotate.")
+}
+
+func ErrorMFAAlreadyEnabled() *Error {
+	return New(ErrConflict, "MFA is already enabled").
+		WithOrigin("MFAEnrollment").
+		WithFriendly("Two-factor authentication is already enabled.")
+}
+
+func ErrorMFANotEnabled() *Error {
+	return New(ErrUnprocessableEntity, "MFA is not enabled").
+		WithOrigin("MFAEnrollment").
+		WithFriendly("Two-factor authentication is not enabled.")
+}
+
+func ErrorMFAEnrollmentNotStarted() *Error {
+	return New(ErrUnprocessableEntity, "No pending MFA enrollment to confirm").
+		WithOrigin("ConfirmMFAEnrollment.Execute").
+		WithFriendly("Start the two-factor authentication setup again.")
+}
+
+func ErrorInvalidMFACode() *Error {
+	return New(ErrBadRequest, "MFA code is invalid or already used").
+		WithOrigin("MFAService.VerifyCode").
+		WithFriendly("This code is invalid or has already been used.")
+}
+
+func ErrorInvalidMFAToken() *Error {
+	return New(ErrUnauthorized, "MFA token is invalid or expired").
+		WithOrigin("MFAService.VerifyChallenge").
+		WithFriendly("Your sign-in has expired. Please sign in again.")
+}
+
+func ErrorMFARequired() *Error {
+	return New(ErrForbidden, "MFA is required for this action").
+		WithOrigin("Authorization.RequireMFA").
+		WithFriendly("Enable two-factor authentication to perform this action.")
+}
+
+func ErrorInvalidMagicLink() *Error {
+	return New(ErrUnauthorized, "Magic link is invalid, expired or already used").
+		WithOrigin("ConsumeMagicLink.Execute").
+		WithFriendly("This sign-in link is invalid or has expired. Please request a new one.")
+}
+
+func ErrorInvalidPasskeyChallenge() *Error {
+	return New(ErrBadRequest, "WebAuthn challenge is invalid or expired").
+		WithOrigin("PasskeyCeremony").
+		WithFriendly("This passkey request has expired. Please start again.")
+}
+
+func ErrorInvalidPasskeyName(maxLength int) *Error {
+	return Newf(ErrBadRequest, "Passkey name longer than %d characters", maxLength).
+		WithOrigin("FinishPasskeyRegistration.Execute").
+		WithFriendly(fmt.Sprintf("The passkey name must have at most %d characters.", maxLength))
+}
+
+func ErrorPasskeyNotFound(credentialID string) *Error {
+	return Newf(ErrNotFound, "Passkey %v not found", credentialID).
+		WithOrigin("DeletePasskey.Execute").
+		WithFriendly("Passkey not found.")
+}
+
+func ErrorUnknownPasskey() *Error {
+	return New(ErrUnauthorized, "Passkey is not registered").
+		WithOrigin("FinishPasskeyLogin.Execute").
+		WithFriendly(PasskeyLoginFailedMessage)
+}
+
+func ErrorPasskeyCloneSuspected() *Error {
+	return New(ErrUnauthorized, "Passkey signature counter did not increase").
+		WithOrigin("FinishPasskeyLogin.Execute").
+		WithFriendly(PasskeyLoginFailedMessage)
+}
+
+func ErrorSessionNotFound(sessionID string) *Error {
+	return Newf(ErrNotFound, "Session %v not found", sessionID).
+		WithOrigin("RevokeSession.Execute").
+		WithFriendly("Session not found.")
+}
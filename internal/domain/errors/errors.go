@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"runtime"
 	"strings"
+	"time"
 )
 
 // Code: seu enum/codes (ex.: ErrCacheGet, ErrCacheSet, ErrValidation, ...)
@@ -87,6 +89,16 @@ func (e *Error) WithField(k string, v any) *Error {
 	return e
 }
 
+// WithRetryAfter tells the client, in whole seconds, how long to wait before
+// trying again.
+func (e *Error) WithRetryAfter(wait time.Duration) *Error {
+	return e.WithField(RetryAfterField, retryAfterSeconds(wait))
+}
+
+func retryAfterSeconds(wait time.Duration) int64 {
+	return int64(math.Ceil(wait.Seconds()))
+}
+
 func (e *Error) WithFriendly(msg string) *Error {
 	e.FriendlyMessage = msg
 	return e
@@ -110,6 +122,26 @@ func (e *Error) CaptureOrigin(skip int) *Error {
 	return e
 }
 
+// OAuthCode returns the RFC 6749 error code of e, derived from its Code when
+// none was set explicitly.
+func (e *Error) OAuthCode() string {
+	if code, ok := e.Fields[OAuthErrorField].(string); ok {
+		return code
+	}
+	switch e.Code {
+	case ErrBadRequest, ErrNotFound:
+		return "invalid_request"
+	case ErrUnauthorized:
+		return "invalid_client"
+	case ErrForbidden:
+		return "access_denied"
+	case ErrTooManyRequests, ErrAccountLocked:
+		return "temporarily_unavailable"
+	default:
+		return "server_error"
+	}
+}
+
 // AddMsg adiciona mensagem
 func (e *Error) AddMsg(msg string) *Error {
 	e.Messages = append(e.Messages, msg)
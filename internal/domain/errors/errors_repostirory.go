@@ -19,3 +19,369 @@ func ErrorFindUserByEmail(err error) *Error {
 		WithOrigin("UserRepository.FindUserByEmail").
 		WithFriendly("Ops... something went wrong. Please try again later.")
 }
+
+func ErrorFindUserByID(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding user by id").
+		WithOrigin("UserRepository.FindUserByID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindUserByPublicID(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding user by public id").
+		WithOrigin("UserRepository.FindUserByPublicID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindDeletedUserByEmail(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding deleted user by email").
+		WithOrigin("UserRepository.FindDeletedUserByEmail").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorSoftDeleteUser(err error) *Error {
+	return Wrap(err, ErrInternal, "Error soft deleting user").
+		WithOrigin("UserRepository.SoftDeleteUser").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorRestoreUser(err error) *Error {
+	return Wrap(err, ErrInternal, "Error restoring user").
+		WithOrigin("UserRepository.RestoreUser").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorPurgeDeletedUsers(err error) *Error {
+	return Wrap(err, ErrInternal, "Error purging deleted users").
+		WithOrigin("UserRepository.PurgeDeletedUsers").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorSearchUsers(err error) *Error {
+	return Wrap(err, ErrInternal, "Error searching users").
+		WithOrigin("UserRepository.SearchUsers").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdateUser(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating user").
+		WithOrigin("UserRepository.UpdateUser").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdateUserPassword(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating user password").
+		WithOrigin("UserRepository.UpdateUserPassword").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorMarkEmailVerified(err error) *Error {
+	return Wrap(err, ErrInternal, "Error marking email as verified").
+		WithOrigin("UserRepository.MarkEmailVerified").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdateUserPhone(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating user phone").
+		WithOrigin("UserRepository.UpdateUserPhone").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorPhoneAlreadyInUse(err error) *Error {
+	return Wrap(err, ErrConflict, "Phone already in use").
+		WithOrigin("UserRepository.UpdateUserPhone").
+		WithFriendly("This phone number is already in use by another account.")
+}
+
+func ErrorFindUserByVerifiedPhone(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding user by phone").
+		WithOrigin("UserRepository.FindUserByVerifiedPhone").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func CreateRefreshTokenError(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating refresh token").
+		WithOrigin("RefreshTokenRepository.CreateRefreshToken").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindRefreshTokenByHash(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding refresh token by hash").
+		WithOrigin("RefreshTokenRepository.FindRefreshTokenByHash").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorMarkRefreshTokenRotated(err error) *Error {
+	return Wrap(err, ErrInternal, "Error marking refresh token as rotated").
+		WithOrigin("RefreshTokenRepository.MarkRefreshTokenRotated").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorRevokeRefreshTokenFamily(err error) *Error {
+	return Wrap(err, ErrInternal, "Error revoking refresh token family").
+		WithOrigin("RefreshTokenRepository.RevokeRefreshTokenFamily").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorRevokeUserRefreshTokens(err error) *Error {
+	return Wrap(err, ErrInternal, "Error revoking user refresh tokens").
+		WithOrigin("RefreshTokenRepository.RevokeUserRefreshTokens").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindRolePermissions(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding role permissions").
+		WithOrigin("RoleRepository.FindPermissionsByRole").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func CreateAddressError(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating address").
+		WithOrigin("AddressRepository.CreateAddress").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorListAddresses(err error) *Error {
+	return Wrap(err, ErrInternal, "Error listing addresses").
+		WithOrigin("AddressRepository.ListAddressesByUserID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindAddressByPublicID(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding address by public id").
+		WithOrigin("AddressRepository.FindAddressByPublicID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdateAddress(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating address").
+		WithOrigin("AddressRepository.UpdateAddress").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorDeleteAddress(err error) *Error {
+	return Wrap(err, ErrInternal, "Error deleting address").
+		WithOrigin("AddressRepository.DeleteAddress").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorClearDefaultAddresses(err error) *Error {
+	return Wrap(err, ErrInternal, "Error clearing default addresses").
+		WithOrigin("AddressRepository.ClearDefaultAddresses").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorLockUserAddresses(err error) *Error {
+	return Wrap(err, ErrInternal, "Error locking user addresses").
+		WithOrigin("AddressRepository.LockUserAddresses").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorListRefreshTokens(err error) *Error {
+	return Wrap(err, ErrInternal, "Error listing refresh tokens").
+		WithOrigin("RefreshTokenRepository.ListRefreshTokensByUserID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindRefreshTokenFamily(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding refresh token family").
+		WithOrigin("RefreshTokenRepository.FindFamilyStartedAt").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorCreateDataExport(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating data export").
+		WithOrigin("DataExportRepository.CreateDataExport").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorListDataExports(err error) *Error {
+	return Wrap(err, ErrInternal, "Error listing data exports").
+		WithOrigin("DataExportRepository.ListDataExportsByUserID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindDataExport(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding data export").
+		WithOrigin("DataExportRepository.FindDataExportByPublicID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorClaimDataExport(err error) *Error {
+	return Wrap(err, ErrInternal, "Error claiming pending data export").
+		WithOrigin("DataExportRepository.ClaimPendingDataExport").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdateDataExport(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating data export").
+		WithOrigin("DataExportRepository.CompleteDataExport").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorCreateOneTimeToken(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating one-time token").
+		WithOrigin("OneTimeTokenRepository.CreateOneTimeToken").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorConsumeOneTimeToken(err error) *Error {
+	return Wrap(err, ErrInternal, "Error consuming one-time token").
+		WithOrigin("OneTimeTokenRepository.ConsumeOneTimeToken").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorInvalidateOneTimeTokens(err error) *Error {
+	return Wrap(err, ErrInternal, "Error invalidating one-time tokens").
+		WithOrigin("OneTimeTokenRepository.InvalidateOneTimeTokens").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorCreateIdentity(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating user identity").
+		WithOrigin("UserIdentityRepository.CreateIdentity").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorIdentityAlreadyLinked(err error) *Error {
+	return Wrap(err, ErrConflict, "Identity already linked").
+		WithOrigin("UserIdentityRepository.CreateIdentity").
+		WithFriendly("This account is already linked to another user.")
+}
+
+func ErrorFindIdentity(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding user identity").
+		WithOrigin("UserIdentityRepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorRecordIdentityLogin(err error) *Error {
+	return Wrap(err, ErrInternal, "Error recording identity login").
+		WithOrigin("UserIdentityRepository.RecordIdentityLogin").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorCreateOAuthClient(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating OAuth client").
+		WithOrigin("OAuthClientRepository.CreateClient").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindOAuthClient(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding OAuth client").
+		WithOrigin("OAuthClientRepository.FindClientByClientID").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdateOAuthClient(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating OAuth client").
+		WithOrigin("OAuthClientRepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindOAuthConsent(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding OAuth consent").
+		WithOrigin("OAuthConsentRepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorSaveOAuthConsent(err error) *Error {
+	return Wrap(err, ErrInternal, "Error saving OAuth consent").
+		WithOrigin("OAuthConsentRepository.SaveConsent").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorCreateAuthorizationCode(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating authorization code").
+		WithOrigin("AuthorizationCodeRepository.CreateAuthorizationCode").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorConsumeAuthorizationCode(err error) *Error {
+	return Wrap(err, ErrInternal, "Error consuming authorization code").
+		WithOrigin("AuthorizationCodeRepository.ConsumeAuthorizationCode").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorSaveUserMFA(err error) *Error {
+	return Wrap(err, ErrInternal, "Error saving user MFA").
+		WithOrigin("UserMFARepository.SaveUserMFA").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindUserMFA(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding user MFA").
+		WithOrigin("UserMFARepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdateUserMFA(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating user MFA").
+		WithOrigin("UserMFARepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorDeleteUserMFA(err error) *Error {
+	return Wrap(err, ErrInternal, "Error deleting user MFA").
+		WithOrigin("UserMFARepository.DeleteUserMFA").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorSaveRecoveryCodes(err error) *Error {
+	return Wrap(err, ErrInternal, "Error saving MFA recovery codes").
+		WithOrigin("MFARecoveryCodeRepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorConsumeRecoveryCode(err error) *Error {
+	return Wrap(err, ErrInternal, "Error consuming MFA recovery code").
+		WithOrigin("MFARecoveryCodeRepository.ConsumeRecoveryCode").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorCreatePasskey(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating passkey").
+		WithOrigin("WebAuthnCredentialRepository.CreateCredential").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorPasskeyAlreadyRegistered(err error) *Error {
+	return Wrap(err, ErrConflict, "Passkey already registered").
+		WithOrigin("WebAuthnCredentialRepository.CreateCredential").
+		WithFriendly("This passkey is already registered.")
+}
+
+func ErrorFindPasskey(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding passkey").
+		WithOrigin("WebAuthnCredentialRepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorUpdatePasskey(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating passkey").
+		WithOrigin("WebAuthnCredentialRepository.RecordCredentialUse").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorDeletePasskey(err error) *Error {
+	return Wrap(err, ErrInternal, "Error deleting passkey").
+		WithOrigin("WebAuthnCredentialRepository.DeleteCredential").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorCreateSession(err error) *Error {
+	return Wrap(err, ErrInternal, "Error creating session").
+		WithOrigin("SessionRepository.CreateSession").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorFindSession(err error) *Error {
+	return Wrap(err, ErrInternal, "Error finding session").
+		WithOrigin("SessionRepository").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
+
+func ErrorTouchSession(err error) *Error {
+	return Wrap(err, ErrInternal, "Error updating session activity").
+		WithOrigin("SessionRepository.TouchSession").
+		WithFriendly("Ops... something went wrong. Please try again later.")
+}
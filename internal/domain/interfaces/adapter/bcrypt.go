@@ -6,5 +6,5 @@ import (
 
 type Bcrypt interface {
 	Hash(data string) (string, *errors.Error)
-	CompareHash(hash, data string) bool
+	CompareHash(data, hash string) bool
 }
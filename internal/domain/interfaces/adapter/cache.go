@@ -9,4 +9,8 @@ import (
 type Cache interface {
 	Get(ctx context.Context, key string, target any) (bool, *errors.Error)
 	Set(ctx context.Context, key string, value any, ttlSeconds int) *errors.Error
+	Delete(ctx context.Context, key string) *errors.Error
+	DeleteIfEqual(ctx context.Context, key string, value any) (bool, *errors.Error)
+	Increment(ctx context.Context, key string, ttlSeconds int) (int64, *errors.Error)
+	DeleteByPrefix(ctx context.Context, prefix string) *errors.Error
 }
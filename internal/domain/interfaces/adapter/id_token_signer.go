@@ -0,0 +1,12 @@
+package adapter
+
+import (
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// IDTokenSigner issues OpenID Connect id_tokens with the keys published in
+// the JWKS.
+type IDTokenSigner interface {
+	Sign(claims vo.IDTokenClaims) (string, *errors.Error)
+}
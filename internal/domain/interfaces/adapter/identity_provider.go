@@ -0,0 +1,15 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// IdentityProvider signs users in through an external OAuth2/OIDC provider
+// using the authorization code flow with PKCE.
+type IdentityProvider interface {
+	AuthorizationURL(ctx context.Context, login vo.OAuthLoginState) (string, *errors.Error)
+	Exchange(ctx context.Context, code string, login vo.OAuthLoginState) (*vo.ExternalIdentity, *errors.Error)
+}
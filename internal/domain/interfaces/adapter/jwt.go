@@ -0,0 +1,12 @@
+package adapter
+
+import (
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type JWT interface {
+	Generate(claims vo.TokenClaims) (*vo.TokenClaims, *errors.Error)
+	Validate(token string) (*vo.TokenClaims, *errors.Error)
+	JWKS() vo.JSONWebKeySet
+}
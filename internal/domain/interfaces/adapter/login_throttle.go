@@ -0,0 +1,18 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+// LoginThrottle tracks failed password checks per account and per account and
+// client address. The durations returned are how long the caller must wait
+// before the next attempt, zero when it may try right away.
+type LoginThrottle interface {
+	Check(ctx context.Context, account, ip string) (time.Duration, *errors.Error)
+	RecordFailure(ctx context.Context, account, ip string) (time.Duration, *errors.Error)
+	RecordSuccess(ctx context.Context, account, ip string) *errors.Error
+	Unlock(ctx context.Context, account string) *errors.Error
+}
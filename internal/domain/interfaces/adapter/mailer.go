@@ -0,0 +1,12 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type Mailer interface {
+	Send(ctx context.Context, message vo.MailMessage) *errors.Error
+}
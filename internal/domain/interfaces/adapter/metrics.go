@@ -4,6 +4,7 @@ import "go.opentelemetry.io/otel/sdk/metric"
 
 type Prometheus interface {
 	CounterRequestStatusCode(router, protocol string, statusCode int)
+	CounterRateLimited(router, policy string)
 	ObserveInstructionDBDuration(database, table, method string, duration float64)
 	ObserveRequestDuration(router, protocol string, statusCode int, status string, duration float64)
 	Close()
@@ -0,0 +1,8 @@
+package adapter
+
+import "github.com/andreis3/auth-ms/internal/domain/errors"
+
+type OpaqueToken interface {
+	Generate() (token string, hash string, err *errors.Error)
+	Hash(token string) string
+}
@@ -0,0 +1,12 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OTPStore interface {
+	Issue(ctx context.Context, key string) (code string, err *errors.Error)
+	Verify(ctx context.Context, key, code string) (bool, *errors.Error)
+}
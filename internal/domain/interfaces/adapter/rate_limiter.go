@@ -0,0 +1,14 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// RateLimiter counts one request of key against policy and decides whether it
+// may go through.
+type RateLimiter interface {
+	Allow(ctx context.Context, key string, policy vo.RateLimitPolicy) (vo.RateLimitDecision, *errors.Error)
+}
@@ -0,0 +1,14 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type SessionCache interface {
+	Get(ctx context.Context, sessionID string) (*vo.SessionActivity, *errors.Error)
+	Set(ctx context.Context, sessionID string, activity vo.SessionActivity) *errors.Error
+	Delete(ctx context.Context, sessionID string) *errors.Error
+}
@@ -0,0 +1,10 @@
+package adapter
+
+import "time"
+
+// Signer authenticates time-limited links: the signature binds a subject to
+// an expiry so neither can be changed by the holder.
+type Signer interface {
+	Sign(subject string, expiresAt time.Time) string
+	Verify(subject string, expiresAt time.Time, signature string) bool
+}
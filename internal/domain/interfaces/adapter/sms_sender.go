@@ -0,0 +1,11 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type SmsSender interface {
+	Send(ctx context.Context, to, message string) *errors.Error
+}
@@ -0,0 +1,16 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type TokenDenylist interface {
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) *errors.Error
+	RevokeSession(ctx context.Context, sessionID string) *errors.Error
+	RevokeUserTokens(ctx context.Context, publicID, exceptSessionID string) *errors.Error
+	IsRevoked(ctx context.Context, claims vo.TokenClaims) (bool, *errors.Error)
+}
@@ -0,0 +1,18 @@
+package adapter
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+// TOTP implements the time-based one-time passwords of RFC 6238 and the
+// recovery codes that stand in for an authenticator that was lost.
+type TOTP interface {
+	GenerateSecret() (string, *errors.Error)
+	ProvisioningURI(secret, account string) string
+	// Match returns the time step of the code when it is valid at now, give or
+	// take the allowed clock skew.
+	Match(secret, code string, now time.Time) (step int64, ok bool)
+	GenerateRecoveryCodes(n int) ([]string, *errors.Error)
+}
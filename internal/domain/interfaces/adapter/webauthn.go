@@ -0,0 +1,17 @@
+package adapter
+
+import (
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// WebAuthn verifies the responses of the passkey ceremonies for the
+// configured relying party. challenge is the base64url value the ceremony
+// was started with.
+type WebAuthn interface {
+	// Challenge reads the challenge a response claims to answer, so the
+	// ceremony it belongs to can be looked up before it is verified.
+	Challenge(clientDataJSON []byte) (string, *errors.Error)
+	VerifyRegistration(challenge string, attestation vo.WebAuthnAttestation) (*vo.WebAuthnCredentialData, *errors.Error)
+	VerifyAssertion(challenge string, assertion vo.WebAuthnAssertion, publicKey []byte) (*vo.WebAuthnAssertionResult, *errors.Error)
+}
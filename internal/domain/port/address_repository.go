@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type AddressRepository interface {
+	CreateAddress(ctx context.Context, address entity.Address) (*entity.Address, *errors.Error)
+	ListAddressesByUserID(ctx context.Context, userID int64) ([]entity.Address, *errors.Error)
+	FindAddressByPublicID(ctx context.Context, userID int64, publicID string) (*entity.Address, *errors.Error)
+	UpdateAddress(ctx context.Context, address entity.Address) (*entity.Address, *errors.Error)
+	DeleteAddress(ctx context.Context, userID int64, publicID string) (bool, *errors.Error)
+	ClearDefaultAddresses(ctx context.Context, userID int64, shipping, billing bool) *errors.Error
+	LockUserAddresses(ctx context.Context, userID int64) *errors.Error
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type AuthorizationCodeRepository interface {
+	CreateAuthorizationCode(ctx context.Context, code entity.AuthorizationCode) *errors.Error
+	ConsumeAuthorizationCode(ctx context.Context, codeHash string, now time.Time) (*entity.AuthorizationCode, *errors.Error)
+}
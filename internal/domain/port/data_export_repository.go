@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type DataExportRepository interface {
+	CreateDataExport(ctx context.Context, export entity.DataExport) (*entity.DataExport, *errors.Error)
+	ListDataExportsByUserID(ctx context.Context, userID int64) ([]entity.DataExport, *errors.Error)
+	FindDataExportByPublicID(ctx context.Context, userID int64, publicID string) (*entity.DataExport, *errors.Error)
+	FindDataExportWithPayload(ctx context.Context, publicID string) (*entity.DataExport, *errors.Error)
+	ClaimPendingDataExport(ctx context.Context, staleBefore time.Time) (*entity.DataExport, *errors.Error)
+	CompleteDataExport(ctx context.Context, id int64, payload []byte, expiresAt time.Time) *errors.Error
+	FailDataExport(ctx context.Context, id int64, reason string) *errors.Error
+	ExpireDataExports(ctx context.Context, now time.Time) (int64, *errors.Error)
+}
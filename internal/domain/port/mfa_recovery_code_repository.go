@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type MFARecoveryCodeRepository interface {
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) *errors.Error
+	ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string, usedAt time.Time) (bool, *errors.Error)
+	DeleteRecoveryCodes(ctx context.Context, userID int64) *errors.Error
+}
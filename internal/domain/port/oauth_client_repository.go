@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OAuthClientRepository interface {
+	CreateClient(ctx context.Context, client entity.OAuthClient) (*entity.OAuthClient, *errors.Error)
+	FindClientByClientID(ctx context.Context, clientID string) (*entity.OAuthClient, *errors.Error)
+	UpdateClientSecret(ctx context.Context, clientID, secretHash string, updatedAt time.Time) (bool, *errors.Error)
+	DisableClient(ctx context.Context, clientID string, disabledAt time.Time) (bool, *errors.Error)
+}
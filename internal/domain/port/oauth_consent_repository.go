@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OAuthConsentRepository interface {
+	FindConsent(ctx context.Context, userID, clientID int64) (*entity.OAuthConsent, *errors.Error)
+	ListConsentsByUserID(ctx context.Context, userID int64) ([]entity.OAuthConsent, *errors.Error)
+	SaveConsent(ctx context.Context, consent entity.OAuthConsent) *errors.Error
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OneTimeTokenRepository interface {
+	CreateOneTimeToken(ctx context.Context, token entity.OneTimeToken) (*entity.OneTimeToken, *errors.Error)
+	ConsumeOneTimeToken(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, now time.Time) (*entity.OneTimeToken, *errors.Error)
+	InvalidateOneTimeTokens(ctx context.Context, userID int64, purpose entity.TokenPurpose) *errors.Error
+}
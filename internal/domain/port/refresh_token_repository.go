@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type RefreshTokenRepository interface {
+	CreateRefreshToken(ctx context.Context, token entity.RefreshToken) (*entity.RefreshToken, *errors.Error)
+	FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, *errors.Error)
+	MarkRefreshTokenRotated(ctx context.Context, id int64) *errors.Error
+	RevokeRefreshTokenFamily(ctx context.Context, familyID string) *errors.Error
+	RevokeUserRefreshTokens(ctx context.Context, publicID, exceptFamilyID string) *errors.Error
+	ListRefreshTokensByUserID(ctx context.Context, userID int64) ([]entity.RefreshToken, *errors.Error)
+	FindFamilyStartedAt(ctx context.Context, familyID string) (*time.Time, *errors.Error)
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type RoleRepository interface {
+	FindPermissionsByRole(ctx context.Context, role entity.RoleTypes) ([]entity.Permission, *errors.Error)
+}
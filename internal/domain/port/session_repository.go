@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session entity.Session) (*entity.Session, *errors.Error)
+	FindSessionByFamilyID(ctx context.Context, familyID string) (*entity.Session, *errors.Error)
+	ListActiveSessionsByUserID(ctx context.Context, userID int64) ([]entity.Session, *errors.Error)
+	ListSessionsByUserID(ctx context.Context, userID int64) ([]entity.Session, *errors.Error)
+	TouchSession(ctx context.Context, familyID, ipAddress string, seenAt time.Time) *errors.Error
+}
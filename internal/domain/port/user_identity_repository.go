@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type UserIdentityRepository interface {
+	CreateIdentity(ctx context.Context, identity entity.UserIdentity) (*entity.UserIdentity, *errors.Error)
+	FindIdentity(ctx context.Context, provider, subject string) (*entity.UserIdentity, *errors.Error)
+	ListIdentitiesByUserID(ctx context.Context, userID int64) ([]entity.UserIdentity, *errors.Error)
+	RecordIdentityLogin(ctx context.Context, id int64, email string, at time.Time) *errors.Error
+}
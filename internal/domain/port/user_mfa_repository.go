@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type UserMFARepository interface {
+	SaveUserMFA(ctx context.Context, mfa entity.UserMFA) *errors.Error
+	FindUserMFA(ctx context.Context, userID int64) (*entity.UserMFA, *errors.Error)
+	ConfirmUserMFA(ctx context.Context, userID, step int64, confirmedAt time.Time) (bool, *errors.Error)
+	RecordTOTPStep(ctx context.Context, userID, step int64) (bool, *errors.Error)
+	DeleteUserMFA(ctx context.Context, userID int64) *errors.Error
+	HasConfirmedMFA(ctx context.Context, publicID string) (bool, *errors.Error)
+}
@@ -2,12 +2,26 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/andreis3/auth-ms/internal/domain/entity"
 	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
 )
 
 type UserRepository interface {
 	CreateUser(ctx context.Context, user entity.User) (*entity.User, *errors.Error)
 	FindUserByEmail(ctx context.Context, email string) (*entity.User, *errors.Error)
+	FindUserByID(ctx context.Context, id int64) (*entity.User, *errors.Error)
+	FindUserByPublicID(ctx context.Context, publicID string) (*entity.User, *errors.Error)
+	FindDeletedUserByEmail(ctx context.Context, email string) (*entity.User, *errors.Error)
+	SoftDeleteUser(ctx context.Context, id int64, deletedAt time.Time) (bool, *errors.Error)
+	RestoreUser(ctx context.Context, id int64) (*entity.User, *errors.Error)
+	PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time, limit int) (int64, *errors.Error)
+	SearchUsers(ctx context.Context, search vo.UserSearch) ([]entity.User, *errors.Error)
+	UpdateUser(ctx context.Context, user entity.User) (*entity.User, *errors.Error)
+	UpdateUserPassword(ctx context.Context, id int64, passwordHash string, updatedAt time.Time) (bool, *errors.Error)
+	MarkEmailVerified(ctx context.Context, id int64, email string, verifiedAt time.Time) (bool, *errors.Error)
+	UpdateUserPhone(ctx context.Context, id int64, phone string, verifiedAt time.Time) (bool, *errors.Error)
+	FindUserByVerifiedPhone(ctx context.Context, phone string) (*entity.User, *errors.Error)
 }
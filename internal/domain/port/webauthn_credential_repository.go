@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type WebAuthnCredentialRepository interface {
+	CreateCredential(ctx context.Context, credential entity.WebAuthnCredential) (*entity.WebAuthnCredential, *errors.Error)
+	FindCredentialByCredentialID(ctx context.Context, credentialID string) (*entity.WebAuthnCredential, *errors.Error)
+	ListCredentialsByUserID(ctx context.Context, userID int64) ([]entity.WebAuthnCredential, *errors.Error)
+	RecordCredentialUse(ctx context.Context, id int64, signCount uint32, backedUp bool, usedAt time.Time) (bool, *errors.Error)
+	DeleteCredential(ctx context.Context, userID int64, credentialID string) (bool, *errors.Error)
+}
@@ -3,6 +3,7 @@ package validator
 import (
 	"fmt"
 	"maps"
+	"net/url"
 	"slices"
 	"sort"
 	"strconv"
@@ -11,9 +12,13 @@ import (
 )
 
 const (
-	ErrNotBlank  = "this field cannot be blank"
-	ErrMaxLength = "cannot be longer than %d characters"
-	ErrMinLength = "must be at least %d characters"
+	ErrNotBlank           = "this field cannot be blank"
+	ErrMaxLength          = "cannot be longer than %d characters"
+	ErrMinLength          = "must be at least %d characters"
+	ErrInvalidURL         = "must be a valid http or https URL"
+	ErrInvalidRedirectURI = "must be an https URL, or http on a loopback host, without fragment"
+	ErrUnknownScope       = "contains an unknown scope"
+	ErrInvalidScopeToken  = "must be non-empty, without spaces, quotes or backslashes"
 )
 
 type Validator struct {
@@ -60,6 +65,20 @@ func (v *Validator) Merge(other *Validator) {
 	}
 }
 
+// MergeWithPrefix merges other prefixing its keys, e.g. "addresses[1]." turns
+// "street" into "addresses[1].street".
+func (v *Validator) MergeWithPrefix(prefix string, other *Validator) {
+	if other == nil {
+		return
+	}
+
+	for key, messages := range other.FieldErrors {
+		for _, msg := range messages {
+			v.AddFieldError(prefix+key, msg)
+		}
+	}
+}
+
 func (v *Validator) Errors() []string {
 	errs := make([]string, 0)
 
@@ -100,7 +119,7 @@ func (v *Validator) FieldErrorsGrouped() map[string]any {
 			fieldName := field[fieldStart:]
 
 			index, err := strconv.Atoi(indexStr)
-			if err != nil {
+			if err != nil || index < 0 {
 				continue
 			}
 
@@ -113,13 +132,14 @@ func (v *Validator) FieldErrorsGrouped() map[string]any {
 		}
 	}
 
-	addressList := []map[string]any{}
+	// keep list positions aligned with the request indexes; valid entries are nil
+	maxIndex := -1
 	for i := range addressErrors {
-		if addrErr, ok := addressErrors[i]; ok {
-			addressList = append(addressList, addrErr)
-		} else {
-			addressList = append(addressList, nil)
-		}
+		maxIndex = max(maxIndex, i)
+	}
+	addressList := make([]map[string]any, maxIndex+1)
+	for i, addrErr := range addressErrors {
+		addressList[i] = addrErr
 	}
 
 	result := make(map[string]any)
@@ -147,3 +167,43 @@ func MaxChars(value string, n int) bool {
 func MinChars(value string, n int) bool {
 	return utf8.RuneCountInString(value) >= n
 }
+
+func IsHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// IsRedirectURI accepts absolute https URLs and, for native and local
+// development clients, http URLs on a loopback host. Fragments are not allowed.
+func IsRedirectURI(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		return host == "localhost" || host == "127.0.0.1" || host == "::1"
+	default:
+		return false
+	}
+}
+
+// IsScopeToken checks value against the scope-token grammar of RFC 6749
+// §3.3, so it can travel in a space-delimited scope or audience parameter.
+func IsScopeToken(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < 0x21 || r > 0x7e || r == '"' || r == '\\' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,56 @@
+package vo
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+const CEPLength = 8
+
+// CEP is a Brazilian postal code. It accepts "01310-100" or "01310100" and is
+// stored with digits only.
+type CEP struct {
+	value string
+}
+
+func NewCEP(cep string) CEP {
+	return CEP{value: cleanCEP(cep)}
+}
+
+func (c *CEP) Validate() *validator.Validator {
+	var validate validator.Validator
+	validate.Assert(validator.NotBlank(c.value), "zip_code", validator.ErrNotBlank)
+	validate.Assert(isValidCEP(c.value), "zip_code", "zip_code must have 8 digits")
+
+	return &validate
+}
+
+func cleanCEP(cep string) string {
+	return strings.NewReplacer("-", "", ".", "").Replace(strings.TrimSpace(cep))
+}
+
+func isValidCEP(cep string) bool {
+	if len(cep) != CEPLength || strings.Trim(cep, "0") == "" {
+		return false
+	}
+	for _, r := range cep {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *CEP) String() string {
+	return c.value
+}
+
+// Formatted returns the CEP as 00000-000.
+func (c *CEP) Formatted() string {
+	if len(c.value) != CEPLength {
+		return c.value
+	}
+	return c.value[:5] + "-" + c.value[5:]
+}
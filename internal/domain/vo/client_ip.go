@@ -0,0 +1,18 @@
+package vo
+
+import "context"
+
+type ctxKeyClientIP struct{}
+
+var clientIPKey = ctxKeyClientIP{}
+
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+// ClientIPFromContext returns the address of the caller, or an empty string
+// outside of a request.
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
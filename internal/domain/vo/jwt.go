@@ -3,9 +3,22 @@ package vo
 import "time"
 
 type TokenClaims struct {
-	CustomerID int64
-	FullName   string
-	Email      string
-	Token      string
-	ExpiresAt  time.Time
+	ID        string
+	PublicID  string
+	Role      string
+	Email     string
+	SessionID string
+	ClientID  string
+	Scopes    []string
+	Audience  []string
+	Token     string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// AuthTokens is the pair handed to a client after a successful sign-in or refresh.
+type AuthTokens struct {
+	Access           TokenClaims
+	RefreshToken     string
+	RefreshExpiresAt time.Time
 }
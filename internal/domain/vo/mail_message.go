@@ -0,0 +1,8 @@
+package vo
+
+// MailMessage is a plain text e-mail to a single recipient.
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
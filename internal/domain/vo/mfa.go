@@ -0,0 +1,11 @@
+package vo
+
+import "time"
+
+// MFAChallenge stands between a verified password and the tokens of the
+// session: Token proves the first factor until ExpiresAt, and is traded for
+// the tokens along with a second factor.
+type MFAChallenge struct {
+	Token     string
+	ExpiresAt time.Time
+}
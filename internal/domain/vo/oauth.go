@@ -0,0 +1,43 @@
+package vo
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"regexp"
+)
+
+const PKCEMethodS256 = "S256"
+
+var codeVerifierPattern = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
+// OAuthLoginState is what an authorization request needs to remember until
+// the provider redirects back: it is stored under State and consumed once.
+type OAuthLoginState struct {
+	Provider     string `json:"provider"`
+	State        string `json:"state"`
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	RedirectURI  string `json:"redirect_uri"`
+}
+
+// ExternalIdentity is the account reported by an identity provider after a
+// successful code exchange.
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	AvatarURL     string
+}
+
+// PKCEChallenge derives the S256 code challenge of verifier (RFC 7636).
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// IsCodeVerifier checks the length and alphabet required by RFC 7636.
+func IsCodeVerifier(verifier string) bool {
+	return codeVerifierPattern.MatchString(verifier)
+}
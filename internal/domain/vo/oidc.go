@@ -0,0 +1,21 @@
+package vo
+
+import "time"
+
+// UserInfo holds the standard OpenID Connect claims released about a user.
+// Only the claims allowed by the granted scopes are filled in.
+type UserInfo struct {
+	Subject       string `json:"sub"`
+	Email         string `json:"email,omitempty"`
+	EmailVerified *bool  `json:"email_verified,omitempty"`
+	Name          string `json:"name,omitempty"`
+	Picture       string `json:"picture,omitempty"`
+}
+
+// IDTokenClaims describes the sign-in of a user to the client in ClientID.
+type IDTokenClaims struct {
+	UserInfo
+	ClientID string
+	Nonce    string
+	AuthTime *time.Time
+}
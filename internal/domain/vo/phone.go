@@ -0,0 +1,36 @@
+package vo
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+// phoneRegex accepts E.164 numbers: a plus sign and up to 15 digits.
+var phoneRegex = regexp.MustCompile(`^\+[1-9][0-9]{7,14}$`)
+
+var phoneFormatting = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+
+type Phone struct {
+	value string
+}
+
+// NewPhone drops the usual formatting characters, so "+55 (11) 91234-5678"
+// and "+5511912345678" are the same phone.
+func NewPhone(phone string) Phone {
+	return Phone{value: phoneFormatting.Replace(strings.TrimSpace(phone))}
+}
+
+func (p *Phone) Validate() *validator.Validator {
+	var validate validator.Validator
+
+	validate.Assert(validator.NotBlank(p.value), "phone", validator.ErrNotBlank)
+	validate.Assert(phoneRegex.MatchString(p.value), "phone", "must be in international format, e.g. +5511912345678")
+
+	return &validate
+}
+
+func (p *Phone) String() string {
+	return p.value
+}
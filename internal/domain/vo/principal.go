@@ -0,0 +1,54 @@
+package vo
+
+import (
+	"context"
+	"slices"
+	"time"
+)
+
+// Principal is the authenticated caller of a request, taken from a verified
+// access token.
+type Principal struct {
+	PublicID  string
+	Role      string
+	ClientID  string
+	Scopes    []string
+	TokenID   string
+	SessionID string
+	ExpiresAt time.Time
+}
+
+func NewPrincipal(claims TokenClaims) Principal {
+	return Principal{
+		PublicID:  claims.PublicID,
+		Role:      claims.Role,
+		ClientID:  claims.ClientID,
+		Scopes:    claims.Scopes,
+		TokenID:   claims.ID,
+		SessionID: claims.SessionID,
+		ExpiresAt: claims.ExpiresAt,
+	}
+}
+
+// IsDelegated reports whether the token was issued to a third-party client,
+// whose access is limited to the granted scopes.
+func (p Principal) IsDelegated() bool {
+	return p.ClientID != ""
+}
+
+func (p Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+type ctxKeyPrincipal struct{}
+
+var principalKey = ctxKeyPrincipal{}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,30 @@
+package vo
+
+import "time"
+
+type RateLimitAlgorithm string
+
+const (
+	RateLimitSlidingWindow RateLimitAlgorithm = "sliding_window"
+	RateLimitTokenBucket   RateLimitAlgorithm = "token_bucket"
+)
+
+// RateLimitPolicy allows Limit requests per Window. A sliding window counts the
+// requests of the last Window; a token bucket holds Limit tokens refilled
+// evenly over Window, so it tolerates bursts of up to Limit requests.
+type RateLimitPolicy struct {
+	Name      string
+	Limit     int
+	Window    time.Duration
+	Algorithm RateLimitAlgorithm
+}
+
+type RateLimitDecision struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the quota is whole again.
+	Reset time.Duration
+	// RetryAfter is how long a rejected caller must wait for the next request.
+	RetryAfter time.Duration
+}
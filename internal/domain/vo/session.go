@@ -0,0 +1,21 @@
+package vo
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionActivity is what the hot path needs to know about a session: its
+// owner and when and from where it was last seen.
+type SessionActivity struct {
+	UserID     int64     `json:"user_id"`
+	IPAddress  string    `json:"ip_address"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// IsSessionID reports whether sessionID is in the canonical form of the
+// refresh token family that identifies a session.
+func IsSessionID(sessionID string) bool {
+	return len(sessionID) == 36 && uuid.Validate(sessionID) == nil
+}
@@ -0,0 +1,80 @@
+package vo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+const (
+	StreetMaxLength       = 255
+	StreetNumberMaxLength = 20
+	ComplementMaxLength   = 255
+	StreetNumberMissing   = "S/N"
+)
+
+type Street struct {
+	value string
+}
+
+func NewStreet(street string) Street {
+	return Street{value: strings.TrimSpace(street)}
+}
+
+func (s *Street) Validate() *validator.Validator {
+	var validate validator.Validator
+	validate.Assert(validator.NotBlank(s.value), "street", validator.ErrNotBlank)
+	validate.Assert(validator.MaxChars(s.value, StreetMaxLength), "street", fmt.Sprintf(validator.ErrMaxLength, StreetMaxLength))
+
+	return &validate
+}
+
+func (s *Street) String() string {
+	return s.value
+}
+
+// StreetNumber is the building number. Addresses without one use "S/N".
+type StreetNumber struct {
+	value string
+}
+
+func NewStreetNumber(number string) StreetNumber {
+	value := strings.TrimSpace(number)
+	if strings.EqualFold(value, StreetNumberMissing) {
+		value = StreetNumberMissing
+	}
+	return StreetNumber{value: value}
+}
+
+func (n *StreetNumber) Validate() *validator.Validator {
+	var validate validator.Validator
+	validate.Assert(validator.NotBlank(n.value), "number", validator.ErrNotBlank)
+	validate.Assert(validator.MaxChars(n.value, StreetNumberMaxLength), "number", fmt.Sprintf(validator.ErrMaxLength, StreetNumberMaxLength))
+
+	return &validate
+}
+
+func (n *StreetNumber) String() string {
+	return n.value
+}
+
+// Complement is optional (apartment, block, ...).
+type Complement struct {
+	value string
+}
+
+func NewComplement(complement string) Complement {
+	return Complement{value: strings.TrimSpace(complement)}
+}
+
+func (c *Complement) Validate() *validator.Validator {
+	var validate validator.Validator
+	validate.Assert(validator.MaxChars(c.value, ComplementMaxLength), "complement", fmt.Sprintf(validator.ErrMaxLength, ComplementMaxLength))
+
+	return &validate
+}
+
+func (c *Complement) String() string {
+	return c.value
+}
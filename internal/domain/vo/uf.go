@@ -0,0 +1,34 @@
+package vo
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+var brazilianStates = []string{
+	"AC", "AL", "AP", "AM", "BA", "CE", "DF", "ES", "GO", "MA", "MT", "MS", "MG", "PA",
+	"PB", "PR", "PE", "PI", "RJ", "RN", "RS", "RO", "RR", "SC", "SP", "SE", "TO",
+}
+
+// UF is the two-letter code of a Brazilian state.
+type UF struct {
+	value string
+}
+
+func NewUF(uf string) UF {
+	return UF{value: strings.ToUpper(strings.TrimSpace(uf))}
+}
+
+func (u *UF) Validate() *validator.Validator {
+	var validate validator.Validator
+	validate.Assert(validator.NotBlank(u.value), "state", validator.ErrNotBlank)
+	validate.Assert(slices.Contains(brazilianStates, u.value), "state", "state must be a valid UF")
+
+	return &validate
+}
+
+func (u *UF) String() string {
+	return u.value
+}
@@ -0,0 +1,77 @@
+package vo
+
+import (
+	"context"
+	"strings"
+)
+
+type ctxKeyUserAgent struct{}
+
+var userAgentKey = ctxKeyUserAgent{}
+
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey, userAgent)
+}
+
+// UserAgentFromContext returns the User-Agent of the caller, or an empty
+// string outside of a request.
+func UserAgentFromContext(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey).(string)
+	return userAgent
+}
+
+// userAgentBrowsers and userAgentPlatforms are matched in order: browsers
+// built on Chromium also announce Chrome and Safari, and Android announces
+// Linux, so the more specific tokens come first.
+var (
+	userAgentBrowsers = []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"SamsungBrowser/", "Samsung Internet"},
+		{"Firefox/", "Firefox"},
+		{"FxiOS/", "Firefox"},
+		{"CriOS/", "Chrome"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+	}
+	userAgentPlatforms = []struct{ token, name string }{
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+)
+
+// DeviceName describes the browser and platform of userAgent for people, as
+// in "Firefox on Windows". Agents it does not recognize are named after their
+// product token.
+func DeviceName(userAgent string) string {
+	browser := matchUserAgent(userAgent, userAgentBrowsers)
+	platform := matchUserAgent(userAgent, userAgentPlatforms)
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform
+	}
+	product, _, _ := strings.Cut(strings.TrimSpace(userAgent), " ")
+	product, _, _ = strings.Cut(product, "/")
+	if product == "" {
+		return "Unknown device"
+	}
+	return product
+}
+
+func matchUserAgent(userAgent string, candidates []struct{ token, name string }) string {
+	for _, candidate := range candidates {
+		if strings.Contains(userAgent, candidate.token) {
+			return candidate.name
+		}
+	}
+	return ""
+}
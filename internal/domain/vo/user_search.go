@@ -0,0 +1,75 @@
+package vo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+type UserSortField string
+
+const (
+	UserSortCreatedAt UserSortField = "created_at"
+	UserSortEmail     UserSortField = "email"
+)
+
+type CursorDirection string
+
+const (
+	CursorNext CursorDirection = "next"
+	CursorPrev CursorDirection = "prev"
+)
+
+// UserCursor is the keyset position of a page boundary: the sort value and id
+// of the row the next (or previous) page starts after.
+type UserCursor struct {
+	Sort      string          `json:"s"`
+	Value     string          `json:"v"`
+	ID        int64           `json:"i"`
+	Direction CursorDirection `json:"d"`
+}
+
+func (c UserCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeUserCursor(encoded string) (UserCursor, error) {
+	var cursor UserCursor
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return cursor, err
+	}
+	err = json.Unmarshal(raw, &cursor)
+	return cursor, err
+}
+
+// UserSearch holds the admin listing criteria. A nil Deleted returns both
+// active and soft deleted users.
+type UserSearch struct {
+	Role        string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Deleted     *bool
+	EmailPrefix string
+	Name        string
+	SortField   UserSortField
+	Descending  bool
+	Limit       int
+	Cursor      *UserCursor
+}
+
+// Sort is the canonical sort parameter, e.g. "-created_at".
+func (s UserSearch) Sort() string {
+	if s.Descending {
+		return "-" + string(s.SortField)
+	}
+	return string(s.SortField)
+}
+
+// Ascending reports the order rows are scanned in: a prev cursor walks the
+// requested order backwards.
+func (s UserSearch) Ascending() bool {
+	backwards := s.Cursor != nil && s.Cursor.Direction == CursorPrev
+	return s.Descending == backwards
+}
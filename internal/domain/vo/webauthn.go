@@ -0,0 +1,55 @@
+package vo
+
+// COSE algorithms accepted for passkeys, in order of preference.
+const (
+	COSEAlgorithmES256 int64 = -7
+	COSEAlgorithmEdDSA int64 = -8
+	COSEAlgorithmRS256 int64 = -257
+)
+
+var COSEAlgorithms = []int64{COSEAlgorithmES256, COSEAlgorithmEdDSA, COSEAlgorithmRS256}
+
+const (
+	WebAuthnCeremonyRegistration = "registration"
+	WebAuthnCeremonyLogin        = "login"
+)
+
+// WebAuthnSession is what an issued challenge stands for until the ceremony
+// finishes. UserID is set for registrations only: a passkey login does not
+// know the user until the authenticator tells.
+type WebAuthnSession struct {
+	Ceremony string `json:"ceremony"`
+	UserID   int64  `json:"user_id,omitempty"`
+}
+
+// WebAuthnAttestation is the response of navigator.credentials.create.
+type WebAuthnAttestation struct {
+	ClientDataJSON    []byte
+	AttestationObject []byte
+}
+
+// WebAuthnAssertion is the response of navigator.credentials.get.
+type WebAuthnAssertion struct {
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+// WebAuthnCredentialData is the credential an authenticator created, as
+// attested during registration. PublicKey is kept in its COSE encoding.
+type WebAuthnCredentialData struct {
+	CredentialID   []byte
+	PublicKey      []byte
+	Algorithm      int64
+	SignCount      uint32
+	AAGUID         string
+	BackupEligible bool
+	BackedUp       bool
+}
+
+// WebAuthnAssertionResult carries the authenticator state reported with a
+// valid assertion.
+type WebAuthnAssertionResult struct {
+	SignCount uint32
+	BackedUp  bool
+}
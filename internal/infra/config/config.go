@@ -8,24 +8,93 @@ import (
 
 // Conf holds the application configuration loaded from environment variables.
 type Configs struct {
-	ServerPort              string        `mapstructure:"SERVER_PORT"`                 // HTTP server port
-	PostgresHost            string        `mapstructure:"POSTGRES_HOST"`               // PostgreSQL database host
-	PostgresPort            string        `mapstructure:"POSTGRES_PORT"`               // PostgreSQL database port
-	PostgresUser            string        `mapstructure:"POSTGRES_USER"`               // PostgreSQL database user
-	PostgresPassword        string        `mapstructure:"POSTGRES_PASSWORD"`           // PostgreSQL database password
-	PostgresDBName          string        `mapstructure:"POSTGRES_DB_NAME"`            // PostgreSQL database name
-	PostgresMaxConnections  int32         `mapstructure:"POSTGRES_MAX_CONNECTIONS"`    // Maximum number of database connections
-	PostgresMinConnections  int32         `mapstructure:"POSTGRES_MIN_CONNECTIONS"`    // Minimum number of database connections
-	PostgresMaxConnLifetime time.Duration `mapstructure:"POSTGRES_MAX_CONN_LIFETIME"`  // Maximum lifetime of a database connection
-	PostgresMaxConnIdleTime time.Duration `mapstructure:"POSTGRES_MAX_CONN_IDLE_TIME"` // Maximum idle time for a database connection
-	RedisHost               string        `mapstructure:"REDIS_HOST"`                  // Redis host
-	RedisPort               string        `mapstructure:"REDIS_PORT"`                  // Redis port
-	RedisPassword           string        `mapstructure:"REDIS_PASSWORD"`              // Redis password
-	RedisDB                 int           `mapstructure:"REDIS_DB"`                    // Redis database number
-	ApplicationName         string        `mapstructure:"APPLICATION_NAME"`            // name of application
-	JWTSecret               string        `mapstructure:"JWT_SECRET"`                  // JWT secret
-	JWTExpiry               time.Duration `mapstructure:"JWT_EXPIRY"`                  // JWT expiry
-	Env                     string        `mapstructure:"ENV"`                         // Environment
+	ServerPort                    string        `mapstructure:"SERVER_PORT"`                      // HTTP server port
+	PostgresHost                  string        `mapstructure:"POSTGRES_HOST"`                    // PostgreSQL database host
+	PostgresPort                  string        `mapstructure:"POSTGRES_PORT"`                    // PostgreSQL database port
+	PostgresUser                  string        `mapstructure:"POSTGRES_USER"`                    // PostgreSQL database user
+	PostgresPassword              string        `mapstructure:"POSTGRES_PASSWORD"`                // PostgreSQL database password
+	PostgresDBName                string        `mapstructure:"POSTGRES_DB_NAME"`                 // PostgreSQL database name
+	PostgresMaxConnections        int32         `mapstructure:"POSTGRES_MAX_CONNECTIONS"`         // Maximum number of database connections
+	PostgresMinConnections        int32         `mapstructure:"POSTGRES_MIN_CONNECTIONS"`         // Minimum number of database connections
+	PostgresMaxConnLifetime       time.Duration `mapstructure:"POSTGRES_MAX_CONN_LIFETIME"`       // Maximum lifetime of a database connection
+	PostgresMaxConnIdleTime       time.Duration `mapstructure:"POSTGRES_MAX_CONN_IDLE_TIME"`      // Maximum idle time for a database connection
+	RedisHost                     string        `mapstructure:"REDIS_HOST"`                       // Redis host
+	RedisPort                     string        `mapstructure:"REDIS_PORT"`                       // Redis port
+	RedisPassword                 string        `mapstructure:"REDIS_PASSWORD"`                   // Redis password
+	RedisDB                       int           `mapstructure:"REDIS_DB"`                         // Redis database number
+	ApplicationName               string        `mapstructure:"APPLICATION_NAME"`                 // name of application
+	JWTSecret                     string        `mapstructure:"JWT_SECRET"`                       // JWT secret
+	JWTExpiry                     time.Duration `mapstructure:"JWT_EXPIRY"`                       // JWT expiry
+	JWTAlgorithm                  string        `mapstructure:"JWT_ALGORITHM"`                    // JWT signing algorithm (HS256, RS256, ES256, EdDSA)
+	JWTKeysDir                    string        `mapstructure:"JWT_KEYS_DIR"`                     // Directory of PEM signing keys, named <kid>.pem
+	JWTSigningKeyID               string        `mapstructure:"JWT_SIGNING_KEY_ID"`               // kid of the key used for signing
+	JWTKeyRotationInterval        time.Duration `mapstructure:"JWT_KEY_ROTATION_INTERVAL"`        // Rotation interval for generated keys, 0 disables it
+	RefreshTokenExpiry            time.Duration `mapstructure:"REFRESH_TOKEN_EXPIRY"`             // Refresh token expiry
+	AccountDeletionGrace          time.Duration `mapstructure:"ACCOUNT_DELETION_GRACE_PERIOD"`    // Window in which a deleted account can be restored
+	AccountPurgeInterval          time.Duration `mapstructure:"ACCOUNT_PURGE_INTERVAL"`           // Interval of the purge job, 0 disables it
+	LinkSigningSecret             string        `mapstructure:"LINK_SIGNING_SECRET"`              // HMAC secret of time-limited links
+	DataExportTTL                 time.Duration `mapstructure:"DATA_EXPORT_TTL"`                  // How long a finished data export stays downloadable
+	DataExportLinkTTL             time.Duration `mapstructure:"DATA_EXPORT_LINK_TTL"`             // Lifetime of a data export download link
+	DataExportPollInterval        time.Duration `mapstructure:"DATA_EXPORT_POLL_INTERVAL"`        // Interval of the data export worker, 0 disables it
+	DataExportClaimTimeout        time.Duration `mapstructure:"DATA_EXPORT_CLAIM_TIMEOUT"`        // After this long a claimed export is assumed abandoned and claimed again
+	MailerDriver                  string        `mapstructure:"MAILER_DRIVER"`                    // Mail delivery: smtp, file or memory
+	MailFrom                      string        `mapstructure:"MAIL_FROM"`                        // Sender address of outgoing mail
+	MailerFileDir                 string        `mapstructure:"MAILER_FILE_DIR"`                  // Directory written by the file mailer
+	SMTPHost                      string        `mapstructure:"SMTP_HOST"`                        // SMTP relay host
+	SMTPPort                      string        `mapstructure:"SMTP_PORT"`                        // SMTP relay port
+	SMTPUsername                  string        `mapstructure:"SMTP_USERNAME"`                    // SMTP username, empty disables authentication
+	SMTPPassword                  string        `mapstructure:"SMTP_PASSWORD"`                    // SMTP password
+	SMTPTimeout                   time.Duration `mapstructure:"SMTP_TIMEOUT"`                     // Upper bound of one delivery, from dialing the relay to QUIT
+	PasswordResetTTL              time.Duration `mapstructure:"PASSWORD_RESET_TTL"`               // Lifetime of a password reset token
+	PasswordResetURL              string        `mapstructure:"PASSWORD_RESET_URL"`               // Page that receives the reset token as ?token=
+	EmailVerificationRequired     bool          `mapstructure:"EMAIL_VERIFICATION_REQUIRED"`      // Reject logins of users who did not confirm their e-mail
+	EmailVerificationURL          string        `mapstructure:"EMAIL_VERIFICATION_URL"`           // Link mailed to new users, receives ?user=&expires=&signature=
+	EmailVerificationTTL          time.Duration `mapstructure:"EMAIL_VERIFICATION_TTL"`           // Lifetime of an e-mail verification link
+	EmailVerificationResendLimit  int           `mapstructure:"EMAIL_VERIFICATION_RESEND_LIMIT"`  // Resends allowed per address and window
+	EmailVerificationResendWindow time.Duration `mapstructure:"EMAIL_VERIFICATION_RESEND_WINDOW"` // Window of the resend limit
+	SmsDriver                     string        `mapstructure:"SMS_DRIVER"`                       // SMS delivery: console or file
+	SmsFilePath                   string        `mapstructure:"SMS_FILE_PATH"`                    // File written by the file SMS sender
+	OTPTTL                        time.Duration `mapstructure:"OTP_TTL"`                          // Lifetime of a one-time code sent by SMS
+	OTPMaxAttempts                int           `mapstructure:"OTP_MAX_ATTEMPTS"`                 // Wrong guesses allowed before a one-time code is burned
+	OAuthCallbackBaseURL          string        `mapstructure:"OAUTH_CALLBACK_BASE_URL"`          // Public base of the social login callbacks, /{provider}/callback is appended
+	OAuthStateTTL                 time.Duration `mapstructure:"OAUTH_STATE_TTL"`                  // How long a social login may take before its state expires
+	OAuthGoogleClientID           string        `mapstructure:"OAUTH_GOOGLE_CLIENT_ID"`           // Google client ID, empty disables the provider
+	OAuthGoogleClientSecret       string        `mapstructure:"OAUTH_GOOGLE_CLIENT_SECRET"`       // Google client secret
+	OAuthGoogleIssuer             string        `mapstructure:"OAUTH_GOOGLE_ISSUER"`              // OIDC issuer used for Google, overridable with a local fake provider
+	OAuthFacebookClientID         string        `mapstructure:"OAUTH_FACEBOOK_CLIENT_ID"`         // Facebook app ID, empty disables the provider
+	OAuthFacebookClientSecret     string        `mapstructure:"OAUTH_FACEBOOK_CLIENT_SECRET"`     // Facebook app secret
+	OAuthFacebookDialogURL        string        `mapstructure:"OAUTH_FACEBOOK_DIALOG_URL"`        // Facebook login dialog
+	OAuthFacebookGraphURL         string        `mapstructure:"OAUTH_FACEBOOK_GRAPH_URL"`         // Facebook Graph API base
+	OAuthConsentURL               string        `mapstructure:"OAUTH_CONSENT_URL"`                // Frontend page that signs the user in and asks for consent, receives the authorization request
+	OAuthAuthorizationCodeTTL     time.Duration `mapstructure:"OAUTH_AUTHORIZATION_CODE_TTL"`     // Lifetime of an authorization code issued to a client
+	OAuthServiceTokenTTL          time.Duration `mapstructure:"OAUTH_SERVICE_TOKEN_TTL"`          // Lifetime of client_credentials tokens, at most JWT_EXPIRY so revocations cover them
+	OIDCIssuer                    string        `mapstructure:"OIDC_ISSUER"`                      // Public base URL of this server, issuer of id_tokens and base of the discovery endpoints
+	OIDCIDTokenTTL                time.Duration `mapstructure:"OIDC_ID_TOKEN_TTL"`                // Lifetime of an id_token
+	MFAIssuer                     string        `mapstructure:"MFA_ISSUER"`                       // Issuer shown by authenticator apps next to the account
+	MFAChallengeTTL               time.Duration `mapstructure:"MFA_CHALLENGE_TTL"`                // How long a login may wait for its second factor
+	MFAMaxAttempts                int           `mapstructure:"MFA_MAX_ATTEMPTS"`                 // Wrong codes allowed before an MFA challenge is dropped
+	MFATOTPSkew                   int           `mapstructure:"MFA_TOTP_SKEW"`                    // Time steps of clock drift accepted on each side of a TOTP code
+	MFARecoveryCodes              int           `mapstructure:"MFA_RECOVERY_CODES"`               // Recovery codes issued per enrollment
+	WebAuthnRPID                  string        `mapstructure:"WEBAUTHN_RP_ID"`                   // Relying party ID passkeys are bound to, the registrable domain of the frontend
+	WebAuthnRPName                string        `mapstructure:"WEBAUTHN_RP_NAME"`                 // Relying party name shown by authenticators
+	WebAuthnOrigins               string        `mapstructure:"WEBAUTHN_ORIGINS"`                 // Comma-separated origins allowed to run passkey ceremonies
+	WebAuthnChallengeTTL          time.Duration `mapstructure:"WEBAUTHN_CHALLENGE_TTL"`           // How long a passkey ceremony may take before its challenge expires
+	MagicLinkURL                  string        `mapstructure:"MAGIC_LINK_URL"`                   // Frontend page that receives the sign-in link and redeems it with the browser nonce
+	MagicLinkTTL                  time.Duration `mapstructure:"MAGIC_LINK_TTL"`                   // Lifetime of an e-mailed sign-in link
+	TrustProxyHeaders             bool          `mapstructure:"TRUST_PROXY_HEADERS"`              // Take the client address from X-Forwarded-For, only behind a reverse proxy that sets it
+	LoginLockoutThreshold         int           `mapstructure:"LOGIN_LOCKOUT_THRESHOLD"`          // Failed sign-ins from one address before the account is locked for it
+	LoginAccountLockoutThreshold  int           `mapstructure:"LOGIN_ACCOUNT_LOCKOUT_THRESHOLD"`  // Failed sign-ins from any address before the account is locked everywhere
+	LoginLockoutDuration          time.Duration `mapstructure:"LOGIN_LOCKOUT_DURATION"`           // How long a lockout lasts, also the window failures are counted in
+	LoginBackoffBase              time.Duration `mapstructure:"LOGIN_BACKOFF_BASE"`               // Delay after the second failure, doubled on every further one
+	RateLimitEnabled              bool          `mapstructure:"RATE_LIMIT_ENABLED"`               // Throttle requests per route policy
+	RateLimitAlgorithm            string        `mapstructure:"RATE_LIMIT_ALGORITHM"`             // sliding_window or token_bucket
+	RateLimitRequests             int           `mapstructure:"RATE_LIMIT_REQUESTS"`              // Requests per window allowed by the default policy, keyed by address
+	RateLimitWindow               time.Duration `mapstructure:"RATE_LIMIT_WINDOW"`                // Window of the default policy
+	RateLimitAuthRequests         int           `mapstructure:"RATE_LIMIT_AUTH_REQUESTS"`         // Requests per window allowed by the policy of credential endpoints
+	RateLimitAuthWindow           time.Duration `mapstructure:"RATE_LIMIT_AUTH_WINDOW"`           // Window of the policy of credential endpoints
+	SessionCacheTTL               time.Duration `mapstructure:"SESSION_CACHE_TTL"`                // How long the activity of a session stays cached in Redis
+	SessionTouchInterval          time.Duration `mapstructure:"SESSION_TOUCH_INTERVAL"`           // Minimum time between writes of the last-seen time of a session
+	Env                           string        `mapstructure:"ENV"`                              // Environment
 }
 
 // LoadConfig loads the application configuration from either a .env file or environment variables.
@@ -41,6 +110,66 @@ func LoadConfig() *Configs {
 	viper.SetDefault("POSTGRES_MAX_CONN_LIFETIME", "5m")
 	viper.SetDefault("POSTGRES_MAX_CONN_IDLE_TIME", "1m")
 	viper.SetDefault("REDIS_DB", 0)
+	viper.SetDefault("JWT_EXPIRY", "15m")
+	viper.SetDefault("JWT_ALGORITHM", "RS256")
+	viper.SetDefault("JWT_KEY_ROTATION_INTERVAL", "0s")
+	viper.SetDefault("REFRESH_TOKEN_EXPIRY", "720h")
+	viper.SetDefault("ACCOUNT_DELETION_GRACE_PERIOD", "720h")
+	viper.SetDefault("ACCOUNT_PURGE_INTERVAL", "1h")
+	viper.SetDefault("DATA_EXPORT_TTL", "168h")
+	viper.SetDefault("DATA_EXPORT_LINK_TTL", "15m")
+	viper.SetDefault("DATA_EXPORT_POLL_INTERVAL", "10s")
+	viper.SetDefault("DATA_EXPORT_CLAIM_TIMEOUT", "15m")
+	viper.SetDefault("MAILER_DRIVER", "file")
+	viper.SetDefault("MAIL_FROM", "no-reply@localhost")
+	viper.SetDefault("MAILER_FILE_DIR", "./tmp/mail")
+	viper.SetDefault("SMTP_PORT", "587")
+	viper.SetDefault("SMTP_TIMEOUT", "10s")
+	viper.SetDefault("PASSWORD_RESET_TTL", "30m")
+	viper.SetDefault("PASSWORD_RESET_URL", "http://localhost:3000/reset-password")
+	viper.SetDefault("EMAIL_VERIFICATION_REQUIRED", false)
+	viper.SetDefault("EMAIL_VERIFICATION_URL", "http://localhost:8080/auth/verify-email")
+	viper.SetDefault("EMAIL_VERIFICATION_TTL", "24h")
+	viper.SetDefault("EMAIL_VERIFICATION_RESEND_LIMIT", 3)
+	viper.SetDefault("EMAIL_VERIFICATION_RESEND_WINDOW", "1h")
+	viper.SetDefault("SMS_DRIVER", "console")
+	viper.SetDefault("SMS_FILE_PATH", "./tmp/sms.log")
+	viper.SetDefault("OTP_TTL", "5m")
+	viper.SetDefault("OTP_MAX_ATTEMPTS", 5)
+	viper.SetDefault("OAUTH_CALLBACK_BASE_URL", "http://localhost:8080/auth/oauth")
+	viper.SetDefault("OAUTH_STATE_TTL", "10m")
+	viper.SetDefault("OAUTH_GOOGLE_ISSUER", "https://accounts.google.com")
+	viper.SetDefault("OAUTH_FACEBOOK_DIALOG_URL", "https://www.facebook.com/v19.0/dialog/oauth")
+	viper.SetDefault("OAUTH_FACEBOOK_GRAPH_URL", "https://graph.facebook.com/v19.0")
+	viper.SetDefault("OAUTH_CONSENT_URL", "http://localhost:3000/oauth/consent")
+	viper.SetDefault("OAUTH_AUTHORIZATION_CODE_TTL", "1m")
+	viper.SetDefault("OAUTH_SERVICE_TOKEN_TTL", "5m")
+	viper.SetDefault("OIDC_ISSUER", "http://localhost:8080")
+	viper.SetDefault("OIDC_ID_TOKEN_TTL", "5m")
+	viper.SetDefault("MFA_ISSUER", "auth-ms")
+	viper.SetDefault("MFA_CHALLENGE_TTL", "5m")
+	viper.SetDefault("MFA_MAX_ATTEMPTS", 5)
+	viper.SetDefault("MFA_TOTP_SKEW", 1)
+	viper.SetDefault("MFA_RECOVERY_CODES", 10)
+	viper.SetDefault("WEBAUTHN_RP_ID", "localhost")
+	viper.SetDefault("WEBAUTHN_RP_NAME", "auth-ms")
+	viper.SetDefault("WEBAUTHN_ORIGINS", "http://localhost:3000")
+	viper.SetDefault("WEBAUTHN_CHALLENGE_TTL", "5m")
+	viper.SetDefault("MAGIC_LINK_URL", "http://localhost:3000/magic-link")
+	viper.SetDefault("MAGIC_LINK_TTL", "10m")
+	viper.SetDefault("TRUST_PROXY_HEADERS", false)
+	viper.SetDefault("LOGIN_LOCKOUT_THRESHOLD", 5)
+	viper.SetDefault("LOGIN_ACCOUNT_LOCKOUT_THRESHOLD", 20)
+	viper.SetDefault("LOGIN_LOCKOUT_DURATION", "15m")
+	viper.SetDefault("LOGIN_BACKOFF_BASE", "1s")
+	viper.SetDefault("RATE_LIMIT_ENABLED", true)
+	viper.SetDefault("RATE_LIMIT_ALGORITHM", "sliding_window")
+	viper.SetDefault("RATE_LIMIT_REQUESTS", 100)
+	viper.SetDefault("RATE_LIMIT_WINDOW", "1m")
+	viper.SetDefault("RATE_LIMIT_AUTH_REQUESTS", 10)
+	viper.SetDefault("RATE_LIMIT_AUTH_WINDOW", "1m")
+	viper.SetDefault("SESSION_CACHE_TTL", "1h")
+	viper.SetDefault("SESSION_TOUCH_INTERVAL", "1m")
 	viper.SetDefault("ENV", "production")
 
 	if err := viper.ReadInConfig(); err != nil {
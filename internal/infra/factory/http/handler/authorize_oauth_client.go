@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type AuthorizeOAuthClient struct {
+	db      *db2.Postgres
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewAuthorizeOAuthClient(database *db2.Postgres, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *AuthorizeOAuthClient {
+	return &AuthorizeOAuthClient{database, log, metrics, tracer, conf}
+}
+
+func (f *AuthorizeOAuthClient) NewAuthorizeOAuthClient() *handler.AuthorizeOAuthClientHandler {
+	uc := command.NewAuthorizeOAuthClient(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewOAuthClientRepository(f.db, f.metrics, f.tracer),
+		repository.NewOAuthConsentRepository(f.db, f.metrics, f.tracer),
+		repository.NewAuthorizationCodeRepository(f.db, f.metrics, f.tracer),
+		repository.NewRefreshTokenRepository(f.db, f.metrics, f.tracer),
+		service2.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log),
+		security.NewOpaqueToken(),
+		f.conf.OAuthAuthorizationCodeTTL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewAuthorizeOAuthClientHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type ChangePassword struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewChangePassword(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ChangePassword {
+	return &ChangePassword{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ChangePassword) NewChangePassword() *handler.ChangePasswordHandler {
+	unitOfWork := uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer)
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewChangePassword(
+		unitOfWork,
+		userRepository,
+		repository.NewRefreshTokenRepository(f.db, f.metrics, f.tracer),
+		service2.NewUserService(userRepository, f.tracer, f.log),
+		service.NewTokenDenylist(f.redis, f.conf, f.tracer, f.metrics),
+		service.NewLoginThrottle(f.redis, f.conf, f.tracer, f.metrics),
+		security.NewBcrypt(),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewChangePasswordHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/oauth"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/shared"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type CompleteOAuthLogin struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewCompleteOAuthLogin(database *db2.Postgres, redis *db2.Redis, keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *CompleteOAuthLogin {
+	return &CompleteOAuthLogin{database, redis, keyring, log, metrics, tracer, conf}
+}
+
+func (f *CompleteOAuthLogin) NewCompleteOAuthLogin() *handler.CompleteOAuthLoginHandler {
+	uc := command.NewCompleteOAuthLogin(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		repository.NewUserIdentityRepository(f.db, f.metrics, f.tracer),
+		service.NewAuthTokenService(f.db, f.redis, f.keyring, f.conf, f.log, f.tracer, f.metrics),
+		service.NewMFAService(f.conf, f.db, f.redis, f.log, f.tracer, f.metrics),
+		oauth.MakeIdentityProviders(f.conf),
+		cache.NewCache(f.redis.Client(), f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		f.conf.EmailVerificationRequired,
+		f.log,
+		f.tracer,
+		shared.Utils{},
+	)
+	return handler.NewCompleteOAuthLoginHandler(uc, f.conf.OAuthCallbackBaseURL, f.metrics, f.log, f.tracer)
+}
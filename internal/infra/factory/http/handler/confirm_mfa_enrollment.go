@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	security2 "github.com/andreis3/auth-ms/internal/infra/factory/security"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type ConfirmMFAEnrollment struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewConfirmMFAEnrollment(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ConfirmMFAEnrollment {
+	return &ConfirmMFAEnrollment{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ConfirmMFAEnrollment) NewConfirmMFAEnrollment() *handler.ConfirmMFAEnrollmentHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewConfirmMFAEnrollment(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewUserMFARepository(f.db, f.metrics, f.tracer),
+		repository.NewRefreshTokenRepository(f.db, f.metrics, f.tracer),
+		service2.NewUserService(userRepository, f.tracer, f.log),
+		service.NewMFAService(f.conf, f.db, f.redis, f.log, f.tracer, f.metrics),
+		service.NewTokenDenylist(f.redis, f.conf, f.tracer, f.metrics),
+		security2.MakeTOTP(f.conf),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewConfirmMFAEnrollmentHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	security2 "github.com/andreis3/auth-ms/internal/infra/factory/security"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type ConsumeMagicLink struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewConsumeMagicLink(database *db2.Postgres, redis *db2.Redis, keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ConsumeMagicLink {
+	return &ConsumeMagicLink{database, redis, keyring, log, metrics, tracer, conf}
+}
+
+func (f *ConsumeMagicLink) NewConsumeMagicLink() *handler.ConsumeMagicLinkHandler {
+	uc := command.NewConsumeMagicLink(
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		repository.NewOneTimeTokenRepository(f.db, f.metrics, f.tracer),
+		service.NewAuthTokenService(f.db, f.redis, f.keyring, f.conf, f.log, f.tracer, f.metrics),
+		service.NewMFAService(f.conf, f.db, f.redis, f.log, f.tracer, f.metrics),
+		security.NewOpaqueToken(),
+		security2.MakeSigner(f.conf),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewConsumeMagicLinkHandler(uc, f.metrics, f.log, f.tracer)
+}
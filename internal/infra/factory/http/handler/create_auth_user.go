@@ -9,6 +9,7 @@ import (
 	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
 	"github.com/andreis3/auth-ms/internal/infra/config"
 	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	service2 "github.com/andreis3/auth-ms/internal/infra/factory/service"
 	"github.com/andreis3/auth-ms/internal/infra/shared"
 )
 
@@ -27,13 +28,14 @@ func NewCreateAuthUser(database *db2.Postgres, redis *db2.Redis, log adapter2.Lo
 
 func (f *CreateAuthUser) NewCreateAuthUser() *handler.CreateAuthUserHandler {
 	crypto := security.NewBcrypt()
-	cmd := newCreateAuthUser(f.db, crypto, f.log, f.tracer, f.metrics)
+	cmd := newCreateAuthUser(f.db, crypto, f.conf, f.log, f.tracer, f.metrics)
 	return handler.NewCreateAuthUserHandler(cmd, f.metrics, f.log, f.tracer)
 }
 
 func newCreateAuthUser(
 	db *db2.Postgres,
 	crypto adapter2.Bcrypt,
+	conf *config.Configs,
 	log adapter2.Logger,
 	tracer adapter2.Tracer,
 	metrics adapter2.Prometheus,
@@ -44,6 +46,7 @@ func newCreateAuthUser(
 	return command.NewCreateAuthUser(
 		userRepository,
 		userService,
+		service2.NewEmailVerificationService(conf, log, tracer),
 		crypto,
 		log,
 		tracer,
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/shared"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type CreateUserAddresses struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewCreateUserAddresses(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *CreateUserAddresses {
+	return &CreateUserAddresses{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *CreateUserAddresses) NewCreateUserAddresses() *handler.CreateUserAddressesHandler {
+	addressRepository := repository.NewAddressRepository(f.db, f.metrics, f.tracer)
+	userService := service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log)
+	unitOfWork := uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer)
+	uc := command.NewCreateUserAddresses(unitOfWork, addressRepository, userService, f.log, f.tracer, shared.Utils{})
+	return handler.NewCreateUserAddressesHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type DeleteCurrentUser struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewDeleteCurrentUser(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *DeleteCurrentUser {
+	return &DeleteCurrentUser{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *DeleteCurrentUser) NewDeleteCurrentUser() *handler.DeleteCurrentUserHandler {
+	unitOfWork := uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer)
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewDeleteCurrentUser(
+		unitOfWork,
+		userRepository,
+		repository.NewRefreshTokenRepository(f.db, f.metrics, f.tracer),
+		service2.NewUserService(userRepository, f.tracer, f.log),
+		service.NewTokenDenylist(f.redis, f.conf, f.tracer, f.metrics),
+		f.conf.AccountDeletionGrace,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewDeleteCurrentUserHandler(uc, f.metrics, f.log, f.tracer)
+}
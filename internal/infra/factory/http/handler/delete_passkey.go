@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type DeletePasskey struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewDeletePasskey(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *DeletePasskey {
+	return &DeletePasskey{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *DeletePasskey) NewDeletePasskey() *handler.DeletePasskeyHandler {
+	credentialRepository := repository.NewWebAuthnCredentialRepository(f.db, f.metrics, f.tracer)
+	userService := service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log)
+	uc := command.NewDeletePasskey(credentialRepository, userService, f.log, f.tracer)
+	return handler.NewDeletePasskeyHandler(uc, f.metrics, f.log, f.tracer)
+}
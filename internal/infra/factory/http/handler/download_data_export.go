@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/security"
+)
+
+type DownloadDataExport struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewDownloadDataExport(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *DownloadDataExport {
+	return &DownloadDataExport{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *DownloadDataExport) NewDownloadDataExport() *handler.DownloadDataExportHandler {
+	dataExportRepository := repository.NewDataExportRepository(f.db, f.metrics, f.tracer)
+	uc := query.NewDownloadDataExport(dataExportRepository, security.MakeSigner(f.conf), f.log, f.tracer)
+	return handler.NewDownloadDataExportHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type ExchangeOAuthToken struct {
+	db      *db2.Postgres
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewExchangeOAuthToken(database *db2.Postgres, keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ExchangeOAuthToken {
+	return &ExchangeOAuthToken{database, keyring, log, metrics, tracer, conf}
+}
+
+func (f *ExchangeOAuthToken) NewExchangeOAuthToken() *handler.ExchangeOAuthTokenHandler {
+	uc := command.NewExchangeOAuthToken(
+		repository.NewOAuthClientRepository(f.db, f.metrics, f.tracer),
+		repository.NewAuthorizationCodeRepository(f.db, f.metrics, f.tracer),
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		security.NewJWT(f.keyring, f.conf.JWTExpiry),
+		security.NewJWT(f.keyring, f.conf.OAuthServiceTokenTTL),
+		security.NewIDTokenSigner(f.keyring, f.conf.OIDCIssuer, f.conf.OIDCIDTokenTTL),
+		security.NewOpaqueToken(),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewExchangeOAuthTokenHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	security2 "github.com/andreis3/auth-ms/internal/infra/factory/security"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type FinishPasskeyLogin struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewFinishPasskeyLogin(database *db2.Postgres, redis *db2.Redis, keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *FinishPasskeyLogin {
+	return &FinishPasskeyLogin{database, redis, keyring, log, metrics, tracer, conf}
+}
+
+func (f *FinishPasskeyLogin) NewFinishPasskeyLogin() *handler.FinishPasskeyLoginHandler {
+	uc := command.NewFinishPasskeyLogin(
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		repository.NewWebAuthnCredentialRepository(f.db, f.metrics, f.tracer),
+		service.NewAuthTokenService(f.db, f.redis, f.keyring, f.conf, f.log, f.tracer, f.metrics),
+		security2.MakeWebAuthn(f.conf),
+		cache.NewCache(f.redis.Client(), f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		f.conf.EmailVerificationRequired,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewFinishPasskeyLoginHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	security2 "github.com/andreis3/auth-ms/internal/infra/factory/security"
+)
+
+type FinishPasskeyRegistration struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewFinishPasskeyRegistration(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *FinishPasskeyRegistration {
+	return &FinishPasskeyRegistration{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *FinishPasskeyRegistration) NewFinishPasskeyRegistration() *handler.FinishPasskeyRegistrationHandler {
+	uc := command.NewFinishPasskeyRegistration(
+		repository.NewWebAuthnCredentialRepository(f.db, f.metrics, f.tracer),
+		service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log),
+		security2.MakeWebAuthn(f.conf),
+		cache.NewCache(f.redis.Client(), f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewFinishPasskeyRegistrationHandler(uc, f.metrics, f.log, f.tracer)
+}
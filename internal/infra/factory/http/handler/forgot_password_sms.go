@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/factory/sms"
+)
+
+type ForgotPasswordSms struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewForgotPasswordSms(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ForgotPasswordSms {
+	return &ForgotPasswordSms{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ForgotPasswordSms) NewForgotPasswordSms() *handler.ForgotPasswordSmsHandler {
+	uc := command.NewForgotPasswordSms(
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		service.NewOTPStore(f.redis, f.conf, f.tracer, f.metrics),
+		sms.MakeSmsSender(f.conf),
+		f.conf.OTPTTL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewForgotPasswordSmsHandler(uc, f.metrics, f.log, f.tracer)
+}
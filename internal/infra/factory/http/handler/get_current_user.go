@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type GetCurrentUser struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewGetCurrentUser(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *GetCurrentUser {
+	return &GetCurrentUser{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *GetCurrentUser) NewGetCurrentUser() *handler.GetCurrentUserHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := query.NewGetCurrentUser(userRepository, f.log, f.tracer)
+	return handler.NewGetCurrentUserHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/query"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/security"
+)
+
+type GetDataExport struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewGetDataExport(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *GetDataExport {
+	return &GetDataExport{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *GetDataExport) NewGetDataExport() *handler.GetDataExportHandler {
+	dataExportRepository := repository.NewDataExportRepository(f.db, f.metrics, f.tracer)
+	userService := service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log)
+	uc := query.NewGetDataExport(dataExportRepository, userService, security.MakeSigner(f.conf), f.conf.DataExportLinkTTL, f.log, f.tracer)
+	return handler.NewGetDataExportHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+type GetJWKS struct {
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewGetJWKS(keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *GetJWKS {
+	return &GetJWKS{keyring, log, metrics, tracer, conf}
+}
+
+func (f *GetJWKS) NewGetJWKS() *handler.GetJWKSHandler {
+	cmd := command.NewGetJWKS(security.NewJWT(f.keyring, f.conf.JWTExpiry), f.tracer)
+	return handler.NewGetJWKSHandler(cmd, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+type GetOpenIDConfiguration struct {
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewGetOpenIDConfiguration(keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *GetOpenIDConfiguration {
+	return &GetOpenIDConfiguration{keyring, log, metrics, tracer, conf}
+}
+
+func (f *GetOpenIDConfiguration) NewGetOpenIDConfiguration() *handler.GetOpenIDConfigurationHandler {
+	uc := query.NewGetOpenIDConfiguration(security.NewJWT(f.keyring, f.conf.JWTExpiry), f.conf.OIDCIssuer, f.tracer)
+	return handler.NewGetOpenIDConfigurationHandler(uc, f.metrics, f.log, f.tracer)
+}
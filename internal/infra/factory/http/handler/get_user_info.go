@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type GetUserInfo struct {
+	db      *db2.Postgres
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+}
+
+func NewGetUserInfo(database *db2.Postgres, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer) *GetUserInfo {
+	return &GetUserInfo{database, log, metrics, tracer}
+}
+
+func (f *GetUserInfo) NewGetUserInfo() *handler.GetUserInfoHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := query.NewGetUserInfo(userRepository, f.log, f.tracer)
+	return handler.NewGetUserInfoHandler(uc, f.metrics, f.log, f.tracer)
+}
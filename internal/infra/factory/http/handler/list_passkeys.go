@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/query"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type ListPasskeys struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewListPasskeys(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ListPasskeys {
+	return &ListPasskeys{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ListPasskeys) NewListPasskeys() *handler.ListPasskeysHandler {
+	credentialRepository := repository.NewWebAuthnCredentialRepository(f.db, f.metrics, f.tracer)
+	userService := service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log)
+	uc := query.NewListPasskeys(credentialRepository, userService, f.log, f.tracer)
+	return handler.NewListPasskeysHandler(uc, f.metrics, f.log, f.tracer)
+}
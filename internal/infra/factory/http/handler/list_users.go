@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/query"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type ListUsers struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewListUsers(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ListUsers {
+	return &ListUsers{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ListUsers) NewListUsers() *handler.ListUsersHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := query.NewListUsers(userRepository, f.log, f.tracer)
+	return handler.NewListUsersHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type LoginAuthUser struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewLoginAuthUser(database *db2.Postgres, redis *db2.Redis, keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *LoginAuthUser {
+	return &LoginAuthUser{database, redis, keyring, log, metrics, tracer, conf}
+}
+
+func (f *LoginAuthUser) NewLoginAuthUser() *handler.LoginAuthUserHandler {
+	crypto := security.NewBcrypt()
+	cmd := newLoginAuthUser(f.db, f.redis, f.keyring, f.conf, crypto, f.log, f.tracer, f.metrics)
+	return handler.NewLoginAuthUserHandler(cmd, f.metrics, f.log, f.tracer)
+}
+
+func newLoginAuthUser(
+	db *db2.Postgres,
+	redis *db2.Redis,
+	keyring *security.Keyring,
+	conf *config.Configs,
+	crypto adapter2.Bcrypt,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *command.LoginAuthUser {
+	userRepository := repository.NewUserRepository(db, metrics, tracer)
+	authTokenService := service.NewAuthTokenService(db, redis, keyring, conf, log, tracer, metrics)
+	return command.NewLoginAuthUser(
+		userRepository,
+		authTokenService,
+		service.NewMFAService(conf, db, redis, log, tracer, metrics),
+		service.NewLoginThrottle(redis, conf, tracer, metrics),
+		crypto,
+		conf.EmailVerificationRequired,
+		log,
+		tracer,
+	)
+}
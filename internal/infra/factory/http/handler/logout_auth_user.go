@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type LogoutAuthUser struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewLogoutAuthUser(database *db2.Postgres, redis *db2.Redis, keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *LogoutAuthUser {
+	return &LogoutAuthUser{database, redis, keyring, log, metrics, tracer, conf}
+}
+
+func (f *LogoutAuthUser) NewLogoutAuthUser() *handler.LogoutAuthUserHandler {
+	cmd := newLogoutAuthUser(f.db, f.redis, f.keyring, f.conf, f.log, f.tracer, f.metrics)
+	return handler.NewLogoutAuthUserHandler(cmd, f.metrics, f.log, f.tracer)
+}
+
+func newLogoutAuthUser(
+	db *db2.Postgres,
+	redis *db2.Redis,
+	keyring *security.Keyring,
+	conf *config.Configs,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *command.LogoutAuthUser {
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db, metrics, tracer)
+	authTokenService := service.NewAuthTokenService(db, redis, keyring, conf, log, tracer, metrics)
+	return command.NewLogoutAuthUser(
+		refreshTokenRepository,
+		authTokenService,
+		service.NewTokenDenylist(redis, conf, tracer, metrics),
+		log,
+		tracer,
+	)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type PrepareOAuthAuthorization struct {
+	db      *db2.Postgres
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewPrepareOAuthAuthorization(database *db2.Postgres, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *PrepareOAuthAuthorization {
+	return &PrepareOAuthAuthorization{database, log, metrics, tracer, conf}
+}
+
+func (f *PrepareOAuthAuthorization) NewPrepareOAuthAuthorization() *handler.PrepareOAuthAuthorizationHandler {
+	uc := command.NewPrepareOAuthAuthorization(
+		repository.NewOAuthClientRepository(f.db, f.metrics, f.tracer),
+		f.conf.OAuthConsentURL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewPrepareOAuthAuthorizationHandler(uc, f.metrics, f.log, f.tracer)
+}
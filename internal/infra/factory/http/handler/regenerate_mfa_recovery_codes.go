@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type RegenerateMFARecoveryCodes struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewRegenerateMFARecoveryCodes(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *RegenerateMFARecoveryCodes {
+	return &RegenerateMFARecoveryCodes{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *RegenerateMFARecoveryCodes) NewRegenerateMFARecoveryCodes() *handler.RegenerateMFARecoveryCodesHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewRegenerateMFARecoveryCodes(
+		service2.NewUserService(userRepository, f.tracer, f.log),
+		service.NewMFAService(f.conf, f.db, f.redis, f.log, f.tracer, f.metrics),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewRegenerateMFARecoveryCodesHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/shared"
+)
+
+type RegisterOAuthClient struct {
+	db      *db2.Postgres
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewRegisterOAuthClient(database *db2.Postgres, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *RegisterOAuthClient {
+	return &RegisterOAuthClient{database, log, metrics, tracer, conf}
+}
+
+func (f *RegisterOAuthClient) NewRegisterOAuthClient() *handler.RegisterOAuthClientHandler {
+	uc := command.NewRegisterOAuthClient(
+		repository.NewOAuthClientRepository(f.db, f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		shared.Utils{},
+		f.log,
+		f.tracer,
+	)
+	return handler.NewRegisterOAuthClientHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,32 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/shared"
+)
+
+type RequestDataExport struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewRequestDataExport(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *RequestDataExport {
+	return &RequestDataExport{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *RequestDataExport) NewRequestDataExport() *handler.RequestDataExportHandler {
+	dataExportRepository := repository.NewDataExportRepository(f.db, f.metrics, f.tracer)
+	userService := service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log)
+	uc := command.NewRequestDataExport(dataExportRepository, userService, f.log, f.tracer, shared.Utils{})
+	return handler.NewRequestDataExportHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/mailer"
+	security2 "github.com/andreis3/auth-ms/internal/infra/factory/security"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type RequestMagicLink struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewRequestMagicLink(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *RequestMagicLink {
+	return &RequestMagicLink{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *RequestMagicLink) NewRequestMagicLink() *handler.RequestMagicLinkHandler {
+	uc := command.NewRequestMagicLink(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		repository.NewOneTimeTokenRepository(f.db, f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		security2.MakeSigner(f.conf),
+		mailer.MakeMailer(f.conf),
+		f.conf.MagicLinkURL,
+		f.conf.MagicLinkTTL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewRequestMagicLinkHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type ResendEmailVerification struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewResendEmailVerification(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ResendEmailVerification {
+	return &ResendEmailVerification{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ResendEmailVerification) NewResendEmailVerification() *handler.ResendEmailVerificationHandler {
+	uc := command.NewResendEmailVerification(
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		service.NewEmailVerificationService(f.conf, f.log, f.tracer),
+		cache.NewCache(f.redis.Client(), f.metrics, f.tracer),
+		f.conf.EmailVerificationResendLimit,
+		f.conf.EmailVerificationResendWindow,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewResendEmailVerificationHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type ResetPassword struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewResetPassword(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ResetPassword {
+	return &ResetPassword{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ResetPassword) NewResetPassword() *handler.ResetPasswordHandler {
+	uc := command.NewResetPassword(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		repository.NewOneTimeTokenRepository(f.db, f.metrics, f.tracer),
+		repository.NewRefreshTokenRepository(f.db, f.metrics, f.tracer),
+		service.NewTokenDenylist(f.redis, f.conf, f.tracer, f.metrics),
+		security.NewOpaqueToken(),
+		security.NewBcrypt(),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewResetPasswordHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type ResetUserMFA struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewResetUserMFA(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *ResetUserMFA {
+	return &ResetUserMFA{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *ResetUserMFA) NewResetUserMFA() *handler.ResetUserMFAHandler {
+	uc := command.NewResetUserMFA(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		repository.NewUserMFARepository(f.db, f.metrics, f.tracer),
+		repository.NewMFARecoveryCodeRepository(f.db, f.metrics, f.tracer),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewResetUserMFAHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type RestoreAuthUser struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewRestoreAuthUser(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *RestoreAuthUser {
+	return &RestoreAuthUser{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *RestoreAuthUser) NewRestoreAuthUser() *handler.RestoreAuthUserHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewRestoreAuthUser(userRepository, service.NewLoginThrottle(f.redis, f.conf, f.tracer, f.metrics), security.NewBcrypt(), f.conf.AccountDeletionGrace, f.log, f.tracer)
+	return handler.NewRestoreAuthUserHandler(uc, f.metrics, f.log, f.tracer)
+}
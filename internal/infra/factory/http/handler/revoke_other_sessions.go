@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type RevokeOtherSessions struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewRevokeOtherSessions(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *RevokeOtherSessions {
+	return &RevokeOtherSessions{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *RevokeOtherSessions) NewRevokeOtherSessions() *handler.RevokeOtherSessionsHandler {
+	uc := command.NewRevokeOtherSessions(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewRefreshTokenRepository(f.db, f.metrics, f.tracer),
+		service2.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log),
+		service.NewTokenDenylist(f.redis, f.conf, f.tracer, f.metrics),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewRevokeOtherSessionsHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type RotateOAuthClientSecret struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewRotateOAuthClientSecret(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *RotateOAuthClientSecret {
+	return &RotateOAuthClientSecret{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *RotateOAuthClientSecret) NewRotateOAuthClientSecret() *handler.RotateOAuthClientSecretHandler {
+	uc := command.NewRotateOAuthClientSecret(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewOAuthClientRepository(f.db, f.metrics, f.tracer),
+		service.NewTokenDenylist(f.redis, f.conf, f.tracer, f.metrics),
+		security.NewOpaqueToken(),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewRotateOAuthClientSecretHandler(uc, f.metrics, f.log, f.tracer)
+}
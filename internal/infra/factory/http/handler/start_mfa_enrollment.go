@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	security2 "github.com/andreis3/auth-ms/internal/infra/factory/security"
+)
+
+type StartMFAEnrollment struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewStartMFAEnrollment(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *StartMFAEnrollment {
+	return &StartMFAEnrollment{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *StartMFAEnrollment) NewStartMFAEnrollment() *handler.StartMFAEnrollmentHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewStartMFAEnrollment(
+		repository.NewUserMFARepository(f.db, f.metrics, f.tracer),
+		service2.NewUserService(userRepository, f.tracer, f.log),
+		security2.MakeTOTP(f.conf),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewStartMFAEnrollmentHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/oauth"
+)
+
+type StartOAuthLogin struct {
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewStartOAuthLogin(redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *StartOAuthLogin {
+	return &StartOAuthLogin{redis, log, metrics, tracer, conf}
+}
+
+func (f *StartOAuthLogin) NewStartOAuthLogin() *handler.StartOAuthLoginHandler {
+	uc := command.NewStartOAuthLogin(
+		oauth.MakeIdentityProviders(f.conf),
+		cache.NewCache(f.redis.Client(), f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		f.conf.OAuthCallbackBaseURL,
+		f.conf.OAuthStateTTL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewStartOAuthLoginHandler(uc, f.conf.OAuthCallbackBaseURL, f.metrics, f.log, f.tracer)
+}
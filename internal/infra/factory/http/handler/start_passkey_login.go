@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type StartPasskeyLogin struct {
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewStartPasskeyLogin(redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *StartPasskeyLogin {
+	return &StartPasskeyLogin{redis, log, metrics, tracer, conf}
+}
+
+func (f *StartPasskeyLogin) NewStartPasskeyLogin() *handler.StartPasskeyLoginHandler {
+	uc := command.NewStartPasskeyLogin(
+		cache.NewCache(f.redis.Client(), f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		f.conf.WebAuthnRPID,
+		f.conf.WebAuthnChallengeTTL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewStartPasskeyLoginHandler(uc, f.metrics, f.log, f.tracer)
+}
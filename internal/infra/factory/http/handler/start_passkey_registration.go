@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type StartPasskeyRegistration struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewStartPasskeyRegistration(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *StartPasskeyRegistration {
+	return &StartPasskeyRegistration{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *StartPasskeyRegistration) NewStartPasskeyRegistration() *handler.StartPasskeyRegistrationHandler {
+	uc := command.NewStartPasskeyRegistration(
+		repository.NewWebAuthnCredentialRepository(f.db, f.metrics, f.tracer),
+		service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log),
+		cache.NewCache(f.redis.Client(), f.metrics, f.tracer),
+		security.NewOpaqueToken(),
+		f.conf.WebAuthnRPID,
+		f.conf.WebAuthnRPName,
+		f.conf.WebAuthnChallengeTTL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewStartPasskeyRegistrationHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type UnlockUser struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewUnlockUser(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *UnlockUser {
+	return &UnlockUser{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *UnlockUser) NewUnlockUser() *handler.UnlockUserHandler {
+	uc := command.NewUnlockUser(
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		service.NewLoginThrottle(f.redis, f.conf, f.tracer, f.metrics),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewUnlockUserHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+type UpdateCurrentUser struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewUpdateCurrentUser(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *UpdateCurrentUser {
+	return &UpdateCurrentUser{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *UpdateCurrentUser) NewUpdateCurrentUser() *handler.UpdateCurrentUserHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewUpdateCurrentUser(userRepository, f.log, f.tracer)
+	return handler.NewUpdateCurrentUserHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type UpdateUserAddress struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewUpdateUserAddress(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *UpdateUserAddress {
+	return &UpdateUserAddress{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *UpdateUserAddress) NewUpdateUserAddress() *handler.UpdateUserAddressHandler {
+	addressRepository := repository.NewAddressRepository(f.db, f.metrics, f.tracer)
+	userService := service.NewUserService(repository.NewUserRepository(f.db, f.metrics, f.tracer), f.tracer, f.log)
+	unitOfWork := uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer)
+	uc := command.NewUpdateUserAddress(unitOfWork, addressRepository, userService, f.log, f.tracer)
+	return handler.NewUpdateUserAddressHandler(uc, f.metrics, f.log, f.tracer)
+}
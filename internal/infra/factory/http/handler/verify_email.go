@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type VerifyEmail struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewVerifyEmail(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *VerifyEmail {
+	return &VerifyEmail{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *VerifyEmail) NewVerifyEmail() *handler.VerifyEmailHandler {
+	uc := command.NewVerifyEmail(
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		service.NewEmailVerificationService(f.conf, f.log, f.tracer),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewVerifyEmailHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type VerifyMFALogin struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	keyring *security.Keyring
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewVerifyMFALogin(database *db2.Postgres, redis *db2.Redis, keyring *security.Keyring, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *VerifyMFALogin {
+	return &VerifyMFALogin{database, redis, keyring, log, metrics, tracer, conf}
+}
+
+func (f *VerifyMFALogin) NewVerifyMFALogin() *handler.VerifyMFALoginHandler {
+	uc := command.NewVerifyMFALogin(
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		service.NewAuthTokenService(f.db, f.redis, f.keyring, f.conf, f.log, f.tracer, f.metrics),
+		service.NewMFAService(f.conf, f.db, f.redis, f.log, f.tracer, f.metrics),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewVerifyMFALoginHandler(uc, f.metrics, f.log, f.tracer)
+}
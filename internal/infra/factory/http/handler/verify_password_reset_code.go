@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+	"github.com/andreis3/auth-ms/internal/infra/uow"
+)
+
+type VerifyPasswordResetCode struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewVerifyPasswordResetCode(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *VerifyPasswordResetCode {
+	return &VerifyPasswordResetCode{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *VerifyPasswordResetCode) NewVerifyPasswordResetCode() *handler.VerifyPasswordResetCodeHandler {
+	uc := command.NewVerifyPasswordResetCode(
+		uow.NewUnitOfWork(f.db.Pool, f.metrics, f.tracer),
+		repository.NewUserRepository(f.db, f.metrics, f.tracer),
+		repository.NewOneTimeTokenRepository(f.db, f.metrics, f.tracer),
+		service.NewOTPStore(f.redis, f.conf, f.tracer, f.metrics),
+		security.NewOpaqueToken(),
+		f.conf.PasswordResetTTL,
+		f.log,
+		f.tracer,
+	)
+	return handler.NewVerifyPasswordResetCodeHandler(uc, f.metrics, f.log, f.tracer)
+}
@@ -0,0 +1,37 @@
+package handler
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/handler"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+type VerifyPhone struct {
+	db      *db2.Postgres
+	redis   *db2.Redis
+	log     adapter2.Logger
+	metrics adapter2.Prometheus
+	tracer  adapter2.Tracer
+	conf    *config.Configs
+}
+
+func NewVerifyPhone(database *db2.Postgres, redis *db2.Redis, log adapter2.Logger, metrics adapter2.Prometheus, tracer adapter2.Tracer, conf *config.Configs) *VerifyPhone {
+	return &VerifyPhone{database, redis, log, metrics, tracer, conf}
+}
+
+func (f *VerifyPhone) NewVerifyPhone() *handler.VerifyPhoneHandler {
+	userRepository := repository.NewUserRepository(f.db, f.metrics, f.tracer)
+	uc := command.NewVerifyPhone(
+		userRepository,
+		service2.NewUserService(userRepository, f.tracer, f.log),
+		service.NewOTPStore(f.redis, f.conf, f.tracer, f.metrics),
+		f.log,
+		f.tracer,
+	)
+	return handler.NewVerifyPhoneHandler(uc, f.metrics, f.log, f.tracer)
+}
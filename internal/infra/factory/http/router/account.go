@@ -0,0 +1,86 @@
+package router
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/routes"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+func MakeAccountRouter(
+	postgres *db2.Postgres,
+	redis *db2.Redis,
+	keyring *security.Keyring,
+	log adapter2.Logger,
+	prometheus adapter2.Prometheus,
+	tracer adapter2.Tracer,
+	conf *config.Configs) *routes.Account {
+
+	loggingMiddleware := middlewares.NewLoggingMiddleware(log, tracer)
+	rateLimitMiddleware := newRateLimitMiddleware(redis, log, prometheus, tracer, conf)
+	authenticationMiddleware := middlewares.NewAuthenticationMiddleware(
+		service.NewAuthTokenService(postgres, redis, keyring, conf, log, tracer, prometheus), log, tracer)
+	authorizationMiddleware := middlewares.NewAuthorizationMiddleware(
+		service.NewAuthorizationService(postgres, redis, log, tracer, prometheus), log, tracer)
+
+	getCurrentUserHandler := handler.NewGetCurrentUser(postgres, redis, log, prometheus, tracer, conf)
+	updateCurrentUserHandler := handler.NewUpdateCurrentUser(postgres, redis, log, prometheus, tracer, conf)
+	deleteCurrentUserHandler := handler.NewDeleteCurrentUser(postgres, redis, log, prometheus, tracer, conf)
+	changePasswordHandler := handler.NewChangePassword(postgres, redis, log, prometheus, tracer, conf)
+	listUserAddressesHandler := handler.NewListUserAddresses(postgres, redis, log, prometheus, tracer, conf)
+	createUserAddressesHandler := handler.NewCreateUserAddresses(postgres, redis, log, prometheus, tracer, conf)
+	updateUserAddressHandler := handler.NewUpdateUserAddress(postgres, redis, log, prometheus, tracer, conf)
+	deleteUserAddressHandler := handler.NewDeleteUserAddress(postgres, redis, log, prometheus, tracer, conf)
+	requestDataExportHandler := handler.NewRequestDataExport(postgres, redis, log, prometheus, tracer, conf)
+	listDataExportsHandler := handler.NewListDataExports(postgres, redis, log, prometheus, tracer, conf)
+	getDataExportHandler := handler.NewGetDataExport(postgres, redis, log, prometheus, tracer, conf)
+	downloadDataExportHandler := handler.NewDownloadDataExport(postgres, redis, log, prometheus, tracer, conf)
+	updatePhoneHandler := handler.NewUpdatePhone(postgres, redis, log, prometheus, tracer, conf)
+	verifyPhoneHandler := handler.NewVerifyPhone(postgres, redis, log, prometheus, tracer, conf)
+	startMFAEnrollmentHandler := handler.NewStartMFAEnrollment(postgres, redis, log, prometheus, tracer, conf)
+	confirmMFAEnrollmentHandler := handler.NewConfirmMFAEnrollment(postgres, redis, log, prometheus, tracer, conf)
+	regenerateMFARecoveryCodesHandler := handler.NewRegenerateMFARecoveryCodes(postgres, redis, log, prometheus, tracer, conf)
+	disableMFAHandler := handler.NewDisableMFA(postgres, redis, log, prometheus, tracer, conf)
+	startPasskeyRegistrationHandler := handler.NewStartPasskeyRegistration(postgres, redis, log, prometheus, tracer, conf)
+	finishPasskeyRegistrationHandler := handler.NewFinishPasskeyRegistration(postgres, redis, log, prometheus, tracer, conf)
+	listPasskeysHandler := handler.NewListPasskeys(postgres, redis, log, prometheus, tracer, conf)
+	deletePasskeyHandler := handler.NewDeletePasskey(postgres, redis, log, prometheus, tracer, conf)
+	listSessionsHandler := handler.NewListSessions(postgres, redis, log, prometheus, tracer, conf)
+	revokeSessionHandler := handler.NewRevokeSession(postgres, redis, log, prometheus, tracer, conf)
+	revokeOtherSessionsHandler := handler.NewRevokeOtherSessions(postgres, redis, log, prometheus, tracer, conf)
+	return routes.NewAccount(
+		getCurrentUserHandler,
+		updateCurrentUserHandler,
+		deleteCurrentUserHandler,
+		changePasswordHandler,
+		listUserAddressesHandler,
+		createUserAddressesHandler,
+		updateUserAddressHandler,
+		deleteUserAddressHandler,
+		requestDataExportHandler,
+		listDataExportsHandler,
+		getDataExportHandler,
+		downloadDataExportHandler,
+		updatePhoneHandler,
+		verifyPhoneHandler,
+		startMFAEnrollmentHandler,
+		confirmMFAEnrollmentHandler,
+		regenerateMFARecoveryCodesHandler,
+		disableMFAHandler,
+		startPasskeyRegistrationHandler,
+		finishPasskeyRegistrationHandler,
+		listPasskeysHandler,
+		deletePasskeyHandler,
+		listSessionsHandler,
+		revokeSessionHandler,
+		revokeOtherSessionsHandler,
+		loggingMiddleware,
+		rateLimitMiddleware,
+		authenticationMiddleware,
+		authorizationMiddleware,
+	)
+}
@@ -0,0 +1,50 @@
+package router
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/routes"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+func MakeAdminRouter(
+	postgres *db2.Postgres,
+	redis *db2.Redis,
+	keyring *security.Keyring,
+	log adapter2.Logger,
+	prometheus adapter2.Prometheus,
+	tracer adapter2.Tracer,
+	conf *config.Configs) *routes.Admin {
+
+	loggingMiddleware := middlewares.NewLoggingMiddleware(log, tracer)
+	rateLimitMiddleware := newRateLimitMiddleware(redis, log, prometheus, tracer, conf)
+	authenticationMiddleware := middlewares.NewAuthenticationMiddleware(
+		service.NewAuthTokenService(postgres, redis, keyring, conf, log, tracer, prometheus), log, tracer)
+	authorizationMiddleware := middlewares.NewAuthorizationMiddleware(
+		service.NewAuthorizationService(postgres, redis, log, tracer, prometheus), log, tracer)
+
+	listUsersHandler := handler.NewListUsers(postgres, redis, log, prometheus, tracer, conf)
+	registerOAuthClientHandler := handler.NewRegisterOAuthClient(postgres, log, prometheus, tracer, conf)
+	registerServiceClientHandler := handler.NewRegisterServiceClient(postgres, log, prometheus, tracer, conf)
+	rotateOAuthClientSecretHandler := handler.NewRotateOAuthClientSecret(postgres, redis, log, prometheus, tracer, conf)
+	disableOAuthClientHandler := handler.NewDisableOAuthClient(postgres, redis, log, prometheus, tracer, conf)
+	resetUserMFAHandler := handler.NewResetUserMFA(postgres, redis, log, prometheus, tracer, conf)
+	unlockUserHandler := handler.NewUnlockUser(postgres, redis, log, prometheus, tracer, conf)
+	return routes.NewAdmin(
+		listUsersHandler,
+		registerOAuthClientHandler,
+		registerServiceClientHandler,
+		rotateOAuthClientSecretHandler,
+		disableOAuthClientHandler,
+		resetUserMFAHandler,
+		unlockUserHandler,
+		loggingMiddleware,
+		rateLimitMiddleware,
+		authenticationMiddleware,
+		authorizationMiddleware,
+	)
+}
@@ -3,6 +3,7 @@ package router
 import (
 	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
 	"github.com/andreis3/auth-ms/internal/adapter/input/http/routes"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
 	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
 	"github.com/andreis3/auth-ms/internal/infra/config"
 	db2 "github.com/andreis3/auth-ms/internal/infra/db"
@@ -12,17 +13,54 @@ import (
 func MakeCreateAuthUserRouter(
 	postgres *db2.Postgres,
 	redis *db2.Redis,
+	keyring *security.Keyring,
 	log adapter2.Logger,
 	prometheus adapter2.Prometheus,
 	tracer adapter2.Tracer,
 	conf *config.Configs) *routes.User {
 
 	loggingMiddleware := middlewares.NewLoggingMiddleware(log, tracer)
+	rateLimitMiddleware := newRateLimitMiddleware(redis, log, prometheus, tracer, conf)
 
 	createAuthUserHandler := handler.NewCreateAuthUser(postgres, redis, log, prometheus, tracer, conf)
+	loginAuthUserHandler := handler.NewLoginAuthUser(postgres, redis, keyring, log, prometheus, tracer, conf)
+	verifyMFALoginHandler := handler.NewVerifyMFALogin(postgres, redis, keyring, log, prometheus, tracer, conf)
+	startPasskeyLoginHandler := handler.NewStartPasskeyLogin(redis, log, prometheus, tracer, conf)
+	finishPasskeyLoginHandler := handler.NewFinishPasskeyLogin(postgres, redis, keyring, log, prometheus, tracer, conf)
+	requestMagicLinkHandler := handler.NewRequestMagicLink(postgres, redis, log, prometheus, tracer, conf)
+	consumeMagicLinkHandler := handler.NewConsumeMagicLink(postgres, redis, keyring, log, prometheus, tracer, conf)
+	refreshAuthTokenHandler := handler.NewRefreshAuthToken(postgres, redis, keyring, log, prometheus, tracer, conf)
+	logoutAuthUserHandler := handler.NewLogoutAuthUser(postgres, redis, keyring, log, prometheus, tracer, conf)
+	restoreAuthUserHandler := handler.NewRestoreAuthUser(postgres, redis, log, prometheus, tracer, conf)
+	forgotPasswordHandler := handler.NewForgotPassword(postgres, redis, log, prometheus, tracer, conf)
+	resetPasswordHandler := handler.NewResetPassword(postgres, redis, log, prometheus, tracer, conf)
+	forgotPasswordSmsHandler := handler.NewForgotPasswordSms(postgres, redis, log, prometheus, tracer, conf)
+	verifyPasswordResetCodeHandler := handler.NewVerifyPasswordResetCode(postgres, redis, log, prometheus, tracer, conf)
+	verifyEmailHandler := handler.NewVerifyEmail(postgres, redis, log, prometheus, tracer, conf)
+	resendEmailVerificationHandler := handler.NewResendEmailVerification(postgres, redis, log, prometheus, tracer, conf)
+	startOAuthLoginHandler := handler.NewStartOAuthLogin(redis, log, prometheus, tracer, conf)
+	completeOAuthLoginHandler := handler.NewCompleteOAuthLogin(postgres, redis, keyring, log, prometheus, tracer, conf)
 	customerRoutes := routes.NewUser(
 		createAuthUserHandler,
+		loginAuthUserHandler,
+		verifyMFALoginHandler,
+		startPasskeyLoginHandler,
+		finishPasskeyLoginHandler,
+		requestMagicLinkHandler,
+		consumeMagicLinkHandler,
+		refreshAuthTokenHandler,
+		logoutAuthUserHandler,
+		restoreAuthUserHandler,
+		forgotPasswordHandler,
+		resetPasswordHandler,
+		forgotPasswordSmsHandler,
+		verifyPasswordResetCodeHandler,
+		verifyEmailHandler,
+		resendEmailVerificationHandler,
+		startOAuthLoginHandler,
+		completeOAuthLoginHandler,
 		loggingMiddleware,
+		rateLimitMiddleware,
 	)
 	return customerRoutes
 }
@@ -0,0 +1,41 @@
+package router
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/routes"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+func MakeOAuthRouter(
+	postgres *db2.Postgres,
+	redis *db2.Redis,
+	keyring *security.Keyring,
+	log adapter2.Logger,
+	prometheus adapter2.Prometheus,
+	tracer adapter2.Tracer,
+	conf *config.Configs) *routes.OAuth {
+
+	loggingMiddleware := middlewares.NewLoggingMiddleware(log, tracer)
+	rateLimitMiddleware := newRateLimitMiddleware(redis, log, prometheus, tracer, conf)
+	authenticationMiddleware := middlewares.NewAuthenticationMiddleware(
+		service.NewAuthTokenService(postgres, redis, keyring, conf, log, tracer, prometheus), log, tracer)
+
+	prepareOAuthAuthorizationHandler := handler.NewPrepareOAuthAuthorization(postgres, log, prometheus, tracer, conf)
+	authorizeOAuthClientHandler := handler.NewAuthorizeOAuthClient(postgres, log, prometheus, tracer, conf)
+	exchangeOAuthTokenHandler := handler.NewExchangeOAuthToken(postgres, keyring, log, prometheus, tracer, conf)
+	getUserInfoHandler := handler.NewGetUserInfo(postgres, log, prometheus, tracer)
+	return routes.NewOAuth(
+		prepareOAuthAuthorizationHandler,
+		authorizeOAuthClientHandler,
+		exchangeOAuthTokenHandler,
+		getUserInfoHandler,
+		loggingMiddleware,
+		rateLimitMiddleware,
+		authenticationMiddleware,
+	)
+}
@@ -0,0 +1,41 @@
+package router
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/service"
+)
+
+func newRateLimitMiddleware(
+	redis *db2.Redis,
+	log adapter2.Logger,
+	prometheus adapter2.Prometheus,
+	tracer adapter2.Tracer,
+	conf *config.Configs) *middlewares.RateLimit {
+
+	algorithm := vo.RateLimitAlgorithm(conf.RateLimitAlgorithm)
+	return middlewares.NewRateLimitMiddleware(
+		service.NewRateLimiter(redis, log, tracer, prometheus),
+		[]vo.RateLimitPolicy{
+			{
+				Name:      middlewares.RateLimitPolicyDefault,
+				Limit:     conf.RateLimitRequests,
+				Window:    conf.RateLimitWindow,
+				Algorithm: algorithm,
+			},
+			{
+				Name:      middlewares.RateLimitPolicyAuth,
+				Limit:     conf.RateLimitAuthRequests,
+				Window:    conf.RateLimitAuthWindow,
+				Algorithm: algorithm,
+			},
+		},
+		conf.RateLimitEnabled,
+		prometheus,
+		log,
+		tracer,
+	)
+}
@@ -0,0 +1,28 @@
+package router
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/routes"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	"github.com/andreis3/auth-ms/internal/infra/factory/http/handler"
+)
+
+func MakeWellKnownRouter(
+	keyring *security.Keyring,
+	log adapter2.Logger,
+	prometheus adapter2.Prometheus,
+	tracer adapter2.Tracer,
+	conf *config.Configs) *routes.WellKnown {
+
+	loggingMiddleware := middlewares.NewLoggingMiddleware(log, tracer)
+
+	getJWKSHandler := handler.NewGetJWKS(keyring, log, prometheus, tracer, conf)
+	getOpenIDConfigurationHandler := handler.NewGetOpenIDConfiguration(keyring, log, prometheus, tracer, conf)
+	return routes.NewWellKnown(
+		getJWKSHandler,
+		getOpenIDConfigurationHandler,
+		loggingMiddleware,
+	)
+}
@@ -0,0 +1,33 @@
+package job
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+func MakeProcessDataExports(
+	postgres *db2.Postgres,
+	log adapter2.Logger,
+	prometheus adapter2.Prometheus,
+	tracer adapter2.Tracer,
+	conf *config.Configs,
+) *command.ProcessDataExports {
+	return command.NewProcessDataExports(
+		repository.NewDataExportRepository(postgres, prometheus, tracer),
+		repository.NewUserRepository(postgres, prometheus, tracer),
+		repository.NewAddressRepository(postgres, prometheus, tracer),
+		repository.NewSessionRepository(postgres, prometheus, tracer),
+		repository.NewRefreshTokenRepository(postgres, prometheus, tracer),
+		repository.NewUserIdentityRepository(postgres, prometheus, tracer),
+		repository.NewOAuthConsentRepository(postgres, prometheus, tracer),
+		repository.NewWebAuthnCredentialRepository(postgres, prometheus, tracer),
+		repository.NewUserMFARepository(postgres, prometheus, tracer),
+		conf.DataExportTTL,
+		conf.DataExportClaimTimeout,
+		log,
+		tracer,
+	)
+}
@@ -0,0 +1,20 @@
+package job
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/app/command"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+func MakePurgeDeletedUsers(
+	postgres *db2.Postgres,
+	log adapter2.Logger,
+	prometheus adapter2.Prometheus,
+	tracer adapter2.Tracer,
+	conf *config.Configs,
+) *command.PurgeDeletedUsers {
+	userRepository := repository.NewUserRepository(postgres, prometheus, tracer)
+	return command.NewPurgeDeletedUsers(userRepository, conf.AccountDeletionGrace, log, tracer)
+}
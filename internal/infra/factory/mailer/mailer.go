@@ -0,0 +1,26 @@
+package mailer
+
+import (
+	"sync"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/mailer"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+// memoryMailer is shared by the whole process so every handler writes to,
+// and tests read from, the same outbox.
+var memoryMailer = sync.OnceValue(mailer.NewMemoryMailer)
+
+// MakeMailer builds the mailer selected by MAILER_DRIVER; unknown drivers fall
+// back to the file mailer so no mail is sent by accident.
+func MakeMailer(conf *config.Configs) adapter.Mailer {
+	switch conf.MailerDriver {
+	case "smtp":
+		return mailer.NewSMTPMailer(conf.SMTPHost, conf.SMTPPort, conf.SMTPUsername, conf.SMTPPassword, conf.MailFrom, conf.SMTPTimeout)
+	case "memory":
+		return memoryMailer()
+	default:
+		return mailer.NewFileMailer(conf.MailerFileDir)
+	}
+}
@@ -0,0 +1,38 @@
+package oauth
+
+import (
+	"sync"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/oauth"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+const (
+	ProviderGoogle   = "google"
+	ProviderFacebook = "facebook"
+)
+
+var (
+	providersOnce sync.Once
+	providers     map[string]adapter.IdentityProvider
+)
+
+// MakeIdentityProviders returns the social login providers that have a client
+// ID configured. They are built once so discovery documents and signing keys
+// stay cached across requests.
+func MakeIdentityProviders(conf *config.Configs) map[string]adapter.IdentityProvider {
+	providersOnce.Do(func() {
+		providers = make(map[string]adapter.IdentityProvider)
+		if conf.OAuthGoogleClientID != "" {
+			providers[ProviderGoogle] = oauth.NewOIDCProvider(ProviderGoogle,
+				conf.OAuthGoogleIssuer, conf.OAuthGoogleClientID, conf.OAuthGoogleClientSecret)
+		}
+		if conf.OAuthFacebookClientID != "" {
+			providers[ProviderFacebook] = oauth.NewFacebookProvider(ProviderFacebook,
+				conf.OAuthFacebookDialogURL, conf.OAuthFacebookGraphURL,
+				conf.OAuthFacebookClientID, conf.OAuthFacebookClientSecret)
+		}
+	})
+	return providers
+}
@@ -0,0 +1,19 @@
+package security
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+// MakeKeyring builds the token keyring once per process: a shared secret for
+// HS256, the PEM files of JWT_KEYS_DIR when set, or a generated key otherwise.
+func MakeKeyring(conf *config.Configs) (*security.Keyring, error) {
+	switch {
+	case conf.JWTAlgorithm == security.AlgorithmHS256:
+		return security.NewSecretKeyring(conf.JWTSecret), nil
+	case conf.JWTKeysDir != "":
+		return security.NewPEMKeyring(conf.JWTAlgorithm, conf.JWTKeysDir, conf.JWTSigningKeyID)
+	default:
+		return security.NewGeneratedKeyring(conf.JWTAlgorithm)
+	}
+}
@@ -0,0 +1,29 @@
+package security
+
+import (
+	"crypto/rand"
+	"sync"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+// fallbackSecret is shared by every signer of the process, so a link signed by
+// one handler verifies in another.
+var fallbackSecret = sync.OnceValue(func() []byte {
+	return []byte(rand.Text())
+})
+
+// MakeSigner builds the signer of time-limited links. Without LINK_SIGNING_SECRET
+// (or JWT_SECRET) a random key is used, so links only work on the instance
+// that issued them and until it restarts.
+func MakeSigner(conf *config.Configs) *security.HMACSigner {
+	switch {
+	case conf.LinkSigningSecret != "":
+		return security.NewHMACSigner([]byte(conf.LinkSigningSecret))
+	case conf.JWTSecret != "":
+		return security.NewHMACSigner([]byte(conf.JWTSecret))
+	default:
+		return security.NewHMACSigner(fallbackSecret())
+	}
+}
@@ -0,0 +1,10 @@
+package security
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+func MakeTOTP(conf *config.Configs) *security.TOTP {
+	return security.NewTOTP(conf.MFAIssuer, conf.MFATOTPSkew)
+}
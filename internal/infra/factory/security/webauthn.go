@@ -0,0 +1,18 @@
+package security
+
+import (
+	"strings"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+func MakeWebAuthn(conf *config.Configs) *security.WebAuthn {
+	origins := make([]string, 0)
+	for _, origin := range strings.Split(conf.WebAuthnOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return security.NewWebAuthn(conf.WebAuthnRPID, origins)
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/shared"
+)
+
+// NewAuthTokenService wires the token service shared by the auth commands and
+// the authentication middleware.
+func NewAuthTokenService(
+	db *db2.Postgres,
+	redis *db2.Redis,
+	keyring *security.Keyring,
+	conf *config.Configs,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *service2.AuthTokenService {
+	refreshTokenRepository := repository.NewRefreshTokenRepository(db, metrics, tracer)
+	jwt := security.NewJWT(keyring, conf.JWTExpiry)
+	return service2.NewAuthTokenService(
+		refreshTokenRepository,
+		NewSessionService(db, redis, conf, log, tracer, metrics),
+		jwt,
+		NewTokenDenylist(redis, conf, tracer, metrics),
+		security.NewOpaqueToken(),
+		shared.Utils{},
+		conf.RefreshTokenExpiry,
+		tracer,
+		log,
+	)
+}
+
+func NewTokenDenylist(
+	redis *db2.Redis,
+	conf *config.Configs,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *cache.TokenDenylist {
+	return cache.NewTokenDenylist(cache.NewCache(redis.Client(), metrics, tracer), conf.JWTExpiry)
+}
+
+func NewSessionService(
+	db *db2.Postgres,
+	redis *db2.Redis,
+	conf *config.Configs,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *service2.SessionService {
+	return service2.NewSessionService(
+		repository.NewSessionRepository(db, metrics, tracer),
+		cache.NewSessionCache(cache.NewCache(redis.Client(), metrics, tracer), conf.SessionCacheTTL),
+		conf.SessionTouchInterval,
+		tracer,
+		log,
+	)
+}
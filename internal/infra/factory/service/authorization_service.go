@@ -0,0 +1,25 @@
+package service
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+func NewAuthorizationService(
+	db *db2.Postgres,
+	redis *db2.Redis,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *service2.AuthorizationService {
+	return service2.NewAuthorizationService(
+		repository.NewRoleRepository(db, metrics, tracer),
+		repository.NewUserMFARepository(db, metrics, tracer),
+		cache.NewCache(redis.Client(), metrics, tracer),
+		tracer,
+		log,
+	)
+}
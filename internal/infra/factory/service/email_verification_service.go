@@ -0,0 +1,24 @@
+package service
+
+import (
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	"github.com/andreis3/auth-ms/internal/infra/factory/mailer"
+	"github.com/andreis3/auth-ms/internal/infra/factory/security"
+)
+
+func NewEmailVerificationService(
+	conf *config.Configs,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+) *service2.EmailVerificationService {
+	return service2.NewEmailVerificationService(
+		security.MakeSigner(conf),
+		mailer.MakeMailer(conf),
+		conf.EmailVerificationURL,
+		conf.EmailVerificationTTL,
+		tracer,
+		log,
+	)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/adapter/output/repository"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	service2 "github.com/andreis3/auth-ms/internal/app/service"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	security2 "github.com/andreis3/auth-ms/internal/infra/factory/security"
+)
+
+func NewMFAService(
+	conf *config.Configs,
+	db *db2.Postgres,
+	redis *db2.Redis,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *service2.MFAService {
+	return service2.NewMFAService(
+		repository.NewUserMFARepository(db, metrics, tracer),
+		repository.NewMFARecoveryCodeRepository(db, metrics, tracer),
+		security2.MakeTOTP(conf),
+		cache.NewCache(redis.Client(), metrics, tracer),
+		security.NewOpaqueToken(),
+		conf.MFAChallengeTTL,
+		conf.MFAMaxAttempts,
+		conf.MFARecoveryCodes,
+		tracer,
+		log,
+	)
+}
@@ -0,0 +1,17 @@
+package service
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+func NewOTPStore(
+	redis *db2.Redis,
+	conf *config.Configs,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *cache.OTPStore {
+	return cache.NewOTPStore(cache.NewCache(redis.Client(), metrics, tracer), conf.OTPTTL, conf.OTPMaxAttempts)
+}
@@ -0,0 +1,22 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+)
+
+// memoryRateLimiter is shared by every router, so the fallback counts a
+// caller once per process rather than once per route module.
+var memoryRateLimiter = sync.OnceValue(cache.NewMemoryRateLimiter)
+
+func NewRateLimiter(
+	redis *db2.Redis,
+	log adapter2.Logger,
+	tracer adapter2.Tracer,
+	metrics adapter2.Prometheus,
+) *cache.RateLimiter {
+	return cache.NewRateLimiter(redis.Client(), memoryRateLimiter(), log, metrics, tracer)
+}
@@ -0,0 +1,18 @@
+package sms
+
+import (
+	"github.com/andreis3/auth-ms/internal/adapter/output/sms"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/config"
+)
+
+// MakeSmsSender builds the sender selected by SMS_DRIVER; unknown drivers
+// fall back to the console sender.
+func MakeSmsSender(conf *config.Configs) adapter.SmsSender {
+	switch conf.SmsDriver {
+	case "file":
+		return sms.NewFileSender(conf.SmsFilePath)
+	default:
+		return sms.NewConsoleSender()
+	}
+}
@@ -24,6 +24,7 @@ var (
 type Prometheus struct {
 	provider                     *metric.MeterProvider
 	counterRequestStatusCode     api.Int64Counter
+	counterRateLimited           api.Int64Counter
 	histogramInstructionDuration api.Float64Histogram
 	histogramRequestDuration     api.Float64Histogram
 }
@@ -38,6 +39,9 @@ func NewPrometheus() *Prometheus {
 	counterRequestStatusCode, _ := meter.Int64Counter("proxy_requests_total",
 		api.WithDescription("Total number of proxy requests"))
 
+	counterRateLimited, _ := meter.Int64Counter("rate_limited_requests_total",
+		api.WithDescription("Total number of requests rejected by a rate limit"))
+
 	histogramInstructionDuration, _ := meter.Float64Histogram("histogram_instruction_db",
 		api.WithDescription("Histogram of instruction db"),
 		api.WithExplicitBucketBoundaries(
@@ -57,6 +61,7 @@ func NewPrometheus() *Prometheus {
 	return &Prometheus{
 		provider:                     meterProviderInstance,
 		counterRequestStatusCode:     counterRequestStatusCode,
+		counterRateLimited:           counterRateLimited,
 		histogramInstructionDuration: histogramInstructionDuration,
 		histogramRequestDuration:     histogramRequestDuration,
 	}
@@ -71,6 +76,14 @@ func (p *Prometheus) CounterRequestStatusCode(router, protocol string, statusCod
 	p.counterRequestStatusCode.Add(context.Background(), 1, opt)
 }
 
+func (p *Prometheus) CounterRateLimited(router, policy string) {
+	opt := api.WithAttributes(
+		attribute.Key("router").String(router),
+		attribute.Key("policy").String(policy),
+	)
+	p.counterRateLimited.Add(context.Background(), 1, opt)
+}
+
 func (p *Prometheus) ObserveInstructionDBDuration(database, table, method string, duration float64) {
 	opt := api.WithAttributes(
 		attribute.Key("database").String(database),
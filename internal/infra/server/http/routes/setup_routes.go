@@ -4,6 +4,7 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	routes2 "github.com/andreis3/auth-ms/internal/adapter/input/http/routes"
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
 	adapter2 "github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
 	"github.com/andreis3/auth-ms/internal/infra/config"
 	db2 "github.com/andreis3/auth-ms/internal/infra/db"
@@ -14,6 +15,7 @@ type RegisterRoutesDeps struct {
 	Mux        *chi.Mux
 	PostgresDB *db2.Postgres
 	Redis      *db2.Redis
+	Keyring    *security.Keyring
 	Log        adapter2.Logger
 	Prometheus adapter2.Prometheus
 	Conf       *config.Configs
@@ -34,6 +36,10 @@ func BuildRoutes(deps *RegisterRoutesDeps) []ModuleRoutes {
 	return []ModuleRoutes{
 		routes2.NewHealthCheck(),
 		routes2.NewMetrics(),
-		router.MakeCreateAuthUserRouter(deps.PostgresDB, deps.Redis, deps.Log, deps.Prometheus, deps.Tracer, deps.Conf),
+		router.MakeWellKnownRouter(deps.Keyring, deps.Log, deps.Prometheus, deps.Tracer, deps.Conf),
+		router.MakeCreateAuthUserRouter(deps.PostgresDB, deps.Redis, deps.Keyring, deps.Log, deps.Prometheus, deps.Tracer, deps.Conf),
+		router.MakeAccountRouter(deps.PostgresDB, deps.Redis, deps.Keyring, deps.Log, deps.Prometheus, deps.Tracer, deps.Conf),
+		router.MakeAdminRouter(deps.PostgresDB, deps.Redis, deps.Keyring, deps.Log, deps.Prometheus, deps.Tracer, deps.Conf),
+		router.MakeOAuthRouter(deps.PostgresDB, deps.Redis, deps.Keyring, deps.Log, deps.Prometheus, deps.Tracer, deps.Conf),
 	}
 }
@@ -13,9 +13,14 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	security2 "github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/app/command"
 	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
 	"github.com/andreis3/auth-ms/internal/infra/config"
 	db2 "github.com/andreis3/auth-ms/internal/infra/db"
+	"github.com/andreis3/auth-ms/internal/infra/factory/job"
+	"github.com/andreis3/auth-ms/internal/infra/factory/security"
 	"github.com/andreis3/auth-ms/internal/infra/logger"
 	observability2 "github.com/andreis3/auth-ms/internal/infra/observability"
 	"github.com/andreis3/auth-ms/internal/infra/server/http/routes"
@@ -28,6 +33,7 @@ type Server struct {
 	Log        logger.Logger
 	Prometheus *observability2.Prometheus
 	Tracer     adapter.Tracer
+	stopJobs   context.CancelFunc
 }
 
 func NewServer(conf *config.Configs, log logger.Logger) *Server {
@@ -38,8 +44,26 @@ func NewServer(conf *config.Configs, log logger.Logger) *Server {
 
 	redis := db2.NewRedis(*conf)
 
+	keyring, err := security.MakeKeyring(conf)
+	if err != nil {
+		log.CriticalText("[Server] ", "KEYRING_ERROR", err.Error())
+		os.Exit(util.ExitFailure)
+	}
+
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	if conf.JWTKeyRotationInterval > 0 {
+		go rotateKeys(jobsCtx, keyring, conf, log)
+	}
+
 	tracer, _ := observability2.InitOtelTracer(context.Background(), "customers-ms")
 
+	if conf.AccountPurgeInterval > 0 {
+		go purgeDeletedUsers(jobsCtx, job.MakePurgeDeletedUsers(pool, &log, prometheus, tracer, conf), conf, log)
+	}
+	if conf.DataExportPollInterval > 0 {
+		go processDataExports(jobsCtx, job.MakeProcessDataExports(pool, &log, prometheus, tracer, conf), conf, log)
+	}
+
 	mux := chi.NewRouter()
 
 	// OpenTelemetry Middleware
@@ -47,10 +71,13 @@ func NewServer(conf *config.Configs, log logger.Logger) *Server {
 		return otelhttp.NewHandler(next, "customers-ms")
 	})
 
+	mux.Use(middlewares.NewClientIPMiddleware(conf.TrustProxyHeaders).ClientIP())
+
 	setupRoutesInput := routes.RegisterRoutesDeps{
 		Mux:        mux,
 		PostgresDB: pool,
 		Redis:      redis,
+		Keyring:    keyring,
 		Log:        &log,
 		Prometheus: prometheus,
 		Conf:       conf,
@@ -72,6 +99,72 @@ func NewServer(conf *config.Configs, log logger.Logger) *Server {
 		Postgres:   pool,
 		Log:        log,
 		Prometheus: prometheus,
+		stopJobs:   stopJobs,
+	}
+}
+
+// rotateKeys keeps the previous signing key verifying after each rotation for
+// as long as the longest-lived token it signed, access token or id_token.
+func rotateKeys(ctx context.Context, keyring *security2.Keyring, conf *config.Configs, log logger.Logger) {
+	ticker := time.NewTicker(conf.JWTKeyRotationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			kid, err := keyring.Rotate(max(conf.JWTExpiry, conf.OIDCIDTokenTTL))
+			if err != nil {
+				log.ErrorText("[Server] ", "KEY_ROTATION", err.Error())
+				continue
+			}
+			log.InfoText("[Server] ", "KEY_ROTATION", fmt.Sprintf("Signing key rotated to %s", kid))
+		}
+	}
+}
+
+// purgeDeletedUsers anonymizes accounts whose deletion grace period is over.
+func purgeDeletedUsers(ctx context.Context, purge *command.PurgeDeletedUsers, conf *config.Configs, log logger.Logger) {
+	ticker := time.NewTicker(conf.AccountPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := purge.Execute(ctx)
+			if err != nil {
+				log.ErrorText("[Server] ", "ACCOUNT_PURGE", err.Error())
+				continue
+			}
+			if purged > 0 {
+				log.InfoText("[Server] ", "ACCOUNT_PURGE", fmt.Sprintf("%d deleted accounts purged", purged))
+			}
+		}
+	}
+}
+
+// processDataExports builds the bundles of requested data exports.
+func processDataExports(ctx context.Context, process *command.ProcessDataExports, conf *config.Configs, log logger.Logger) {
+	ticker := time.NewTicker(conf.DataExportPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			processed, err := process.Execute(ctx)
+			if err != nil {
+				log.ErrorText("[Server] ", "DATA_EXPORT", err.Error())
+				continue
+			}
+			if processed > 0 {
+				log.InfoText("[Server] ", "DATA_EXPORT", fmt.Sprintf("%d data exports processed", processed))
+			}
+		}
 	}
 }
 
@@ -90,6 +183,7 @@ func (s *Server) GracefulShutdown() {
 	defer cancel()
 
 	s.Log.InfoText("[Server] ", "SERVER_SHUTDOWN", "Server is shutting down...")
+	s.stopJobs()
 
 	if err := s.HTTPServer.Shutdown(ctx); err != nil {
 		s.Log.ErrorText("[Server] ", "SERVER_SHUTDOWN", err.Error())
@@ -0,0 +1,45 @@
+package mservice
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type AuthTokenServiceMock struct{ mock.Mock }
+
+func (s *AuthTokenServiceMock) IssueTokens(ctx context.Context, user *entity.User, familyID string) (*vo.AuthTokens, *errors.Error) {
+	args := s.Called(ctx, user, familyID)
+
+	var tokens *vo.AuthTokens
+	if v := args.Get(0); v != nil {
+		tokens = v.(*vo.AuthTokens)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return tokens, err
+}
+
+func (s *AuthTokenServiceMock) VerifyAccessToken(ctx context.Context, token string) (*vo.TokenClaims, *errors.Error) {
+	args := s.Called(ctx, token)
+
+	var claims *vo.TokenClaims
+	if v := args.Get(0); v != nil {
+		claims = v.(*vo.TokenClaims)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return claims, err
+}
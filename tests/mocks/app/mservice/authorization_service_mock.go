@@ -0,0 +1,34 @@
+package mservice
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type AuthorizationServiceMock struct{ mock.Mock }
+
+func (s *AuthorizationServiceMock) HasPermission(ctx context.Context, role entity.RoleTypes, permission entity.Permission) (bool, *errors.Error) {
+	args := s.Called(ctx, role, permission)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Bool(0), err
+}
+
+func (s *AuthorizationServiceMock) HasMFA(ctx context.Context, publicID string) (bool, *errors.Error) {
+	args := s.Called(ctx, publicID)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Bool(0), err
+}
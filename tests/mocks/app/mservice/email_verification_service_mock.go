@@ -0,0 +1,28 @@
+package mservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type EmailVerificationServiceMock struct{ mock.Mock }
+
+func (s *EmailVerificationServiceMock) SendVerification(ctx context.Context, user *entity.User) *errors.Error {
+	args := s.Called(ctx, user)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (s *EmailVerificationServiceMock) IsValidLink(user *entity.User, expiresAt time.Time, signature string) bool {
+	args := s.Called(user, expiresAt, signature)
+	return args.Bool(0)
+}
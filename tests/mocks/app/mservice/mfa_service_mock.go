@@ -0,0 +1,67 @@
+package mservice
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type MFAServiceMock struct{ mock.Mock }
+
+func (s *MFAServiceMock) StartChallenge(ctx context.Context, user *entity.User) (*vo.MFAChallenge, *errors.Error) {
+	args := s.Called(ctx, user)
+
+	var challenge *vo.MFAChallenge
+	if v := args.Get(0); v != nil {
+		challenge = v.(*vo.MFAChallenge)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return challenge, err
+}
+
+func (s *MFAServiceMock) VerifyChallenge(ctx context.Context, mfaToken, code string) (int64, *errors.Error) {
+	args := s.Called(ctx, mfaToken, code)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Get(0).(int64), err
+}
+
+func (s *MFAServiceMock) VerifyUserCode(ctx context.Context, userID int64, code string) (bool, *errors.Error) {
+	args := s.Called(ctx, userID, code)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Bool(0), err
+}
+
+func (s *MFAServiceMock) IssueRecoveryCodes(ctx context.Context, userID int64) ([]string, *errors.Error) {
+	args := s.Called(ctx, userID)
+
+	var codes []string
+	if v := args.Get(0); v != nil {
+		codes = v.([]string)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return codes, err
+}
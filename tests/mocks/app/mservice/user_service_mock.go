@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
+	"github.com/andreis3/auth-ms/internal/domain/entity"
 	"github.com/andreis3/auth-ms/internal/domain/errors"
 )
 
@@ -19,3 +20,19 @@ func (s *UserServiceMock) ValidateEmailAvailability(ctx context.Context, email s
 
 	return nil
 }
+
+func (s *UserServiceMock) FindCurrentUser(ctx context.Context) (*entity.User, *errors.Error) {
+	args := s.Called(ctx)
+
+	var u *entity.User
+	if v := args.Get(0); v != nil {
+		u = v.(*entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
@@ -19,7 +19,7 @@ func (b *BcryptMock) Hash(data string) (string, *errors.Error) {
 	return args.String(0), err
 }
 
-func (b *BcryptMock) CompareHash(hash, data string) bool {
-	args := b.Called(hash, data)
+func (b *BcryptMock) CompareHash(data, hash string) bool {
+	args := b.Called(data, hash)
 	return args.Bool(0)
 }
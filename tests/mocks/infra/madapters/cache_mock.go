@@ -0,0 +1,74 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type CacheMock struct{ mock.Mock }
+
+func (m *CacheMock) Get(ctx context.Context, key string, target any) (bool, *errors.Error) {
+	args := m.Called(ctx, key, target)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Bool(0), err
+}
+
+func (m *CacheMock) Set(ctx context.Context, key string, value any, ttlSeconds int) *errors.Error {
+	args := m.Called(ctx, key, value, ttlSeconds)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (m *CacheMock) Delete(ctx context.Context, key string) *errors.Error {
+	args := m.Called(ctx, key)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (m *CacheMock) DeleteIfEqual(ctx context.Context, key string, value any) (bool, *errors.Error) {
+	args := m.Called(ctx, key, value)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Bool(0), err
+}
+
+func (m *CacheMock) Increment(ctx context.Context, key string, ttlSeconds int) (int64, *errors.Error) {
+	args := m.Called(ctx, key, ttlSeconds)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Get(0).(int64), err
+}
+
+func (m *CacheMock) DeleteByPrefix(ctx context.Context, prefix string) *errors.Error {
+	args := m.Called(ctx, prefix)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package madapters
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type IDTokenSignerMock struct{ mock.Mock }
+
+func (s *IDTokenSignerMock) Sign(claims vo.IDTokenClaims) (string, *errors.Error) {
+	args := s.Called(claims)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.String(0), err
+}
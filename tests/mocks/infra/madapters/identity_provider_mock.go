@@ -0,0 +1,39 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type IdentityProviderMock struct{ mock.Mock }
+
+func (p *IdentityProviderMock) AuthorizationURL(ctx context.Context, login vo.OAuthLoginState) (string, *errors.Error) {
+	args := p.Called(ctx, login)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.String(0), err
+}
+
+func (p *IdentityProviderMock) Exchange(ctx context.Context, code string, login vo.OAuthLoginState) (*vo.ExternalIdentity, *errors.Error) {
+	args := p.Called(ctx, code, login)
+
+	var identity *vo.ExternalIdentity
+	if v := args.Get(0); v != nil {
+		identity = v.(*vo.ExternalIdentity)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return identity, err
+}
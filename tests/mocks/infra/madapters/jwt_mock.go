@@ -0,0 +1,47 @@
+package madapters
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type JWTMock struct{ mock.Mock }
+
+func (j *JWTMock) Generate(claims vo.TokenClaims) (*vo.TokenClaims, *errors.Error) {
+	args := j.Called(claims)
+
+	var output *vo.TokenClaims
+	if v := args.Get(0); v != nil {
+		output = v.(*vo.TokenClaims)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return output, err
+}
+
+func (j *JWTMock) Validate(token string) (*vo.TokenClaims, *errors.Error) {
+	args := j.Called(token)
+
+	var output *vo.TokenClaims
+	if v := args.Get(0); v != nil {
+		output = v.(*vo.TokenClaims)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return output, err
+}
+
+func (j *JWTMock) JWKS() vo.JSONWebKeySet {
+	args := j.Called()
+	return args.Get(0).(vo.JSONWebKeySet)
+}
@@ -0,0 +1,54 @@
+package madapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type LoginThrottleMock struct{ mock.Mock }
+
+func (t *LoginThrottleMock) Check(ctx context.Context, account, ip string) (time.Duration, *errors.Error) {
+	args := t.Called(ctx, account, ip)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Get(0).(time.Duration), err
+}
+
+func (t *LoginThrottleMock) RecordFailure(ctx context.Context, account, ip string) (time.Duration, *errors.Error) {
+	args := t.Called(ctx, account, ip)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Get(0).(time.Duration), err
+}
+
+func (t *LoginThrottleMock) RecordSuccess(ctx context.Context, account, ip string) *errors.Error {
+	args := t.Called(ctx, account, ip)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (t *LoginThrottleMock) Unlock(ctx context.Context, account string) *errors.Error {
+	args := t.Called(ctx, account)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type MailerMock struct{ mock.Mock }
+
+func (m *MailerMock) Send(ctx context.Context, message vo.MailMessage) *errors.Error {
+	args := m.Called(ctx, message)
+
+	var err *errors.Error
+	if v := args.Get(0); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return err
+}
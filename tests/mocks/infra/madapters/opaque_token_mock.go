@@ -0,0 +1,25 @@
+package madapters
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OpaqueTokenMock struct{ mock.Mock }
+
+func (o *OpaqueTokenMock) Generate() (string, string, *errors.Error) {
+	args := o.Called()
+
+	var err *errors.Error
+	if v := args.Get(2); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.String(0), args.String(1), err
+}
+
+func (o *OpaqueTokenMock) Hash(token string) string {
+	args := o.Called(token)
+	return args.String(0)
+}
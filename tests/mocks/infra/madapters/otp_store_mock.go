@@ -0,0 +1,33 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OTPStoreMock struct{ mock.Mock }
+
+func (m *OTPStoreMock) Issue(ctx context.Context, key string) (string, *errors.Error) {
+	args := m.Called(ctx, key)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.String(0), err
+}
+
+func (m *OTPStoreMock) Verify(ctx context.Context, key, code string) (bool, *errors.Error) {
+	args := m.Called(ctx, key, code)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Bool(0), err
+}
@@ -13,6 +13,10 @@ func (p *PrometheusMock) CounterRequestStatusCode(router, protocol string, statu
 	p.Called(router, protocol, statusCode)
 }
 
+func (p *PrometheusMock) CounterRateLimited(router, policy string) {
+	p.Called(router, policy)
+}
+
 func (p *PrometheusMock) ObserveInstructionDBDuration(database, table, method string, duration float64) {
 	p.Called(database, table, method, duration)
 }
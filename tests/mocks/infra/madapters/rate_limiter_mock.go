@@ -0,0 +1,23 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type RateLimiterMock struct{ mock.Mock }
+
+func (l *RateLimiterMock) Allow(ctx context.Context, key string, policy vo.RateLimitPolicy) (vo.RateLimitDecision, *errors.Error) {
+	args := l.Called(ctx, key, policy)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Get(0).(vo.RateLimitDecision), err
+}
@@ -0,0 +1,48 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type SessionCacheMock struct{ mock.Mock }
+
+func (m *SessionCacheMock) Get(ctx context.Context, sessionID string) (*vo.SessionActivity, *errors.Error) {
+	args := m.Called(ctx, sessionID)
+
+	var activity *vo.SessionActivity
+	if v := args.Get(0); v != nil {
+		activity = v.(*vo.SessionActivity)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return activity, err
+}
+
+func (m *SessionCacheMock) Set(ctx context.Context, sessionID string, activity vo.SessionActivity) *errors.Error {
+	args := m.Called(ctx, sessionID, activity)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (m *SessionCacheMock) Delete(ctx context.Context, sessionID string) *errors.Error {
+	args := m.Called(ctx, sessionID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
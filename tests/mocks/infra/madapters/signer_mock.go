@@ -0,0 +1,19 @@
+package madapters
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type SignerMock struct{ mock.Mock }
+
+func (s *SignerMock) Sign(subject string, expiresAt time.Time) string {
+	args := s.Called(subject, expiresAt)
+	return args.String(0)
+}
+
+func (s *SignerMock) Verify(subject string, expiresAt time.Time, signature string) bool {
+	args := s.Called(subject, expiresAt, signature)
+	return args.Bool(0)
+}
@@ -0,0 +1,22 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type SmsSenderMock struct{ mock.Mock }
+
+func (m *SmsSenderMock) Send(ctx context.Context, to, message string) *errors.Error {
+	args := m.Called(ctx, to, message)
+
+	var err *errors.Error
+	if v := args.Get(0); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return err
+}
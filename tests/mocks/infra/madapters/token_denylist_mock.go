@@ -0,0 +1,54 @@
+package madapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type TokenDenylistMock struct{ mock.Mock }
+
+func (d *TokenDenylistMock) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) *errors.Error {
+	args := d.Called(ctx, tokenID, expiresAt)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (d *TokenDenylistMock) RevokeSession(ctx context.Context, sessionID string) *errors.Error {
+	args := d.Called(ctx, sessionID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (d *TokenDenylistMock) RevokeUserTokens(ctx context.Context, publicID, exceptSessionID string) *errors.Error {
+	args := d.Called(ctx, publicID, exceptSessionID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (d *TokenDenylistMock) IsRevoked(ctx context.Context, claims vo.TokenClaims) (bool, *errors.Error) {
+	args := d.Called(ctx, claims)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.Bool(0), err
+}
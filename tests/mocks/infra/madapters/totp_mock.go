@@ -0,0 +1,48 @@
+package madapters
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type TOTPMock struct{ mock.Mock }
+
+func (t *TOTPMock) GenerateSecret() (string, *errors.Error) {
+	args := t.Called()
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.String(0), err
+}
+
+func (t *TOTPMock) ProvisioningURI(secret, account string) string {
+	args := t.Called(secret, account)
+	return args.String(0)
+}
+
+func (t *TOTPMock) Match(secret, code string, now time.Time) (int64, bool) {
+	args := t.Called(secret, code, now)
+	return args.Get(0).(int64), args.Bool(1)
+}
+
+func (t *TOTPMock) GenerateRecoveryCodes(n int) ([]string, *errors.Error) {
+	args := t.Called(n)
+
+	var codes []string
+	if v := args.Get(0); v != nil {
+		codes = v.([]string)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return codes, err
+}
@@ -0,0 +1,23 @@
+package madapters
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+// UnitOfWorkMock runs the callback inline so the repository mocks inside the
+// transaction are exercised; a configured return error overrides the callback result.
+type UnitOfWorkMock struct{ mock.Mock }
+
+func (u *UnitOfWorkMock) WithTransaction(ctx context.Context, fn func(ctx context.Context) *errors.Error) *errors.Error {
+	args := u.Called(ctx)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return fn(ctx)
+}
@@ -0,0 +1,53 @@
+package madapters
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+type WebAuthnMock struct{ mock.Mock }
+
+func (w *WebAuthnMock) Challenge(clientDataJSON []byte) (string, *errors.Error) {
+	args := w.Called(clientDataJSON)
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return args.String(0), err
+}
+
+func (w *WebAuthnMock) VerifyRegistration(challenge string, attestation vo.WebAuthnAttestation) (*vo.WebAuthnCredentialData, *errors.Error) {
+	args := w.Called(challenge, attestation)
+
+	var data *vo.WebAuthnCredentialData
+	if v := args.Get(0); v != nil {
+		data = v.(*vo.WebAuthnCredentialData)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return data, err
+}
+
+func (w *WebAuthnMock) VerifyAssertion(challenge string, assertion vo.WebAuthnAssertion, publicKey []byte) (*vo.WebAuthnAssertionResult, *errors.Error) {
+	args := w.Called(challenge, assertion, publicKey)
+
+	var result *vo.WebAuthnAssertionResult
+	if v := args.Get(0); v != nil {
+		result = v.(*vo.WebAuthnAssertionResult)
+	}
+
+	var err *errors.Error
+	if v := args.Get(1); v != nil {
+		err = v.(*errors.Error)
+	}
+
+	return result, err
+}
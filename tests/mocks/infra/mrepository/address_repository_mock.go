@@ -0,0 +1,107 @@
+package mrepository
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type AddressRepositoryMock struct{ mock.Mock }
+
+func (r *AddressRepositoryMock) CreateAddress(ctx context.Context, address entity.Address) (*entity.Address, *errors.Error) {
+	args := r.Called(ctx, address)
+
+	var a *entity.Address
+	if v := args.Get(0); v != nil {
+		a = v.(*entity.Address)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return a, e
+}
+
+func (r *AddressRepositoryMock) ListAddressesByUserID(ctx context.Context, userID int64) ([]entity.Address, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var a []entity.Address
+	if v := args.Get(0); v != nil {
+		a = v.([]entity.Address)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return a, e
+}
+
+func (r *AddressRepositoryMock) FindAddressByPublicID(ctx context.Context, userID int64, publicID string) (*entity.Address, *errors.Error) {
+	args := r.Called(ctx, userID, publicID)
+
+	var a *entity.Address
+	if v := args.Get(0); v != nil {
+		a = v.(*entity.Address)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return a, e
+}
+
+func (r *AddressRepositoryMock) UpdateAddress(ctx context.Context, address entity.Address) (*entity.Address, *errors.Error) {
+	args := r.Called(ctx, address)
+
+	var a *entity.Address
+	if v := args.Get(0); v != nil {
+		a = v.(*entity.Address)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return a, e
+}
+
+func (r *AddressRepositoryMock) DeleteAddress(ctx context.Context, userID int64, publicID string) (bool, *errors.Error) {
+	args := r.Called(ctx, userID, publicID)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *AddressRepositoryMock) ClearDefaultAddresses(ctx context.Context, userID int64, shipping, billing bool) *errors.Error {
+	args := r.Called(ctx, userID, shipping, billing)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *AddressRepositoryMock) LockUserAddresses(ctx context.Context, userID int64) *errors.Error {
+	args := r.Called(ctx, userID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type AuthorizationCodeRepositoryMock struct{ mock.Mock }
+
+func (r *AuthorizationCodeRepositoryMock) CreateAuthorizationCode(ctx context.Context, code entity.AuthorizationCode) *errors.Error {
+	args := r.Called(ctx, code)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *AuthorizationCodeRepositoryMock) ConsumeAuthorizationCode(ctx context.Context, codeHash string, now time.Time) (*entity.AuthorizationCode, *errors.Error) {
+	args := r.Called(ctx, codeHash, now)
+
+	var c *entity.AuthorizationCode
+	if v := args.Get(0); v != nil {
+		c = v.(*entity.AuthorizationCode)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
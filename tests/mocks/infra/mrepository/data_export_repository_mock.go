@@ -0,0 +1,96 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type DataExportRepositoryMock struct{ mock.Mock }
+
+func (r *DataExportRepositoryMock) CreateDataExport(ctx context.Context, export entity.DataExport) (*entity.DataExport, *errors.Error) {
+	args := r.Called(ctx, export)
+	return dataExportResult(args)
+}
+
+func (r *DataExportRepositoryMock) ListDataExportsByUserID(ctx context.Context, userID int64) ([]entity.DataExport, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var d []entity.DataExport
+	if v := args.Get(0); v != nil {
+		d = v.([]entity.DataExport)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return d, e
+}
+
+func (r *DataExportRepositoryMock) FindDataExportByPublicID(ctx context.Context, userID int64, publicID string) (*entity.DataExport, *errors.Error) {
+	args := r.Called(ctx, userID, publicID)
+	return dataExportResult(args)
+}
+
+func (r *DataExportRepositoryMock) FindDataExportWithPayload(ctx context.Context, publicID string) (*entity.DataExport, *errors.Error) {
+	args := r.Called(ctx, publicID)
+	return dataExportResult(args)
+}
+
+func (r *DataExportRepositoryMock) ClaimPendingDataExport(ctx context.Context, staleBefore time.Time) (*entity.DataExport, *errors.Error) {
+	args := r.Called(ctx, staleBefore)
+	return dataExportResult(args)
+}
+
+func (r *DataExportRepositoryMock) CompleteDataExport(ctx context.Context, id int64, payload []byte, expiresAt time.Time) *errors.Error {
+	args := r.Called(ctx, id, payload, expiresAt)
+
+	var e *errors.Error
+	if v := args.Get(0); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return e
+}
+
+func (r *DataExportRepositoryMock) FailDataExport(ctx context.Context, id int64, reason string) *errors.Error {
+	args := r.Called(ctx, id, reason)
+
+	var e *errors.Error
+	if v := args.Get(0); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return e
+}
+
+func (r *DataExportRepositoryMock) ExpireDataExports(ctx context.Context, now time.Time) (int64, *errors.Error) {
+	args := r.Called(ctx, now)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Get(0).(int64), e
+}
+
+func dataExportResult(args mock.Arguments) (*entity.DataExport, *errors.Error) {
+	var d *entity.DataExport
+	if v := args.Get(0); v != nil {
+		d = v.(*entity.DataExport)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return d, e
+}
@@ -0,0 +1,43 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type MFARecoveryCodeRepositoryMock struct{ mock.Mock }
+
+func (r *MFARecoveryCodeRepositoryMock) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes []string) *errors.Error {
+	args := r.Called(ctx, userID, codeHashes)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *MFARecoveryCodeRepositoryMock) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash string, usedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, userID, codeHash, usedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *MFARecoveryCodeRepositoryMock) DeleteRecoveryCodes(ctx context.Context, userID int64) *errors.Error {
+	args := r.Called(ctx, userID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OAuthClientRepositoryMock struct{ mock.Mock }
+
+func (r *OAuthClientRepositoryMock) CreateClient(ctx context.Context, client entity.OAuthClient) (*entity.OAuthClient, *errors.Error) {
+	args := r.Called(ctx, client)
+
+	var c *entity.OAuthClient
+	if v := args.Get(0); v != nil {
+		c = v.(*entity.OAuthClient)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
+
+func (r *OAuthClientRepositoryMock) FindClientByClientID(ctx context.Context, clientID string) (*entity.OAuthClient, *errors.Error) {
+	args := r.Called(ctx, clientID)
+
+	var c *entity.OAuthClient
+	if v := args.Get(0); v != nil {
+		c = v.(*entity.OAuthClient)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
+
+func (r *OAuthClientRepositoryMock) UpdateClientSecret(ctx context.Context, clientID, secretHash string, updatedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, clientID, secretHash, updatedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *OAuthClientRepositoryMock) DisableClient(ctx context.Context, clientID string, disabledAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, clientID, disabledAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
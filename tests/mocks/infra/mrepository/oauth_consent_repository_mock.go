@@ -0,0 +1,54 @@
+package mrepository
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OAuthConsentRepositoryMock struct{ mock.Mock }
+
+func (r *OAuthConsentRepositoryMock) FindConsent(ctx context.Context, userID, clientID int64) (*entity.OAuthConsent, *errors.Error) {
+	args := r.Called(ctx, userID, clientID)
+
+	var c *entity.OAuthConsent
+	if v := args.Get(0); v != nil {
+		c = v.(*entity.OAuthConsent)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
+
+func (r *OAuthConsentRepositoryMock) SaveConsent(ctx context.Context, consent entity.OAuthConsent) *errors.Error {
+	args := r.Called(ctx, consent)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *OAuthConsentRepositoryMock) ListConsentsByUserID(ctx context.Context, userID int64) ([]entity.OAuthConsent, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var c []entity.OAuthConsent
+	if v := args.Get(0); v != nil {
+		c = v.([]entity.OAuthConsent)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
@@ -0,0 +1,56 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type OneTimeTokenRepositoryMock struct{ mock.Mock }
+
+func (r *OneTimeTokenRepositoryMock) CreateOneTimeToken(ctx context.Context, token entity.OneTimeToken) (*entity.OneTimeToken, *errors.Error) {
+	args := r.Called(ctx, token)
+
+	var t *entity.OneTimeToken
+	if v := args.Get(0); v != nil {
+		t = v.(*entity.OneTimeToken)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return t, e
+}
+
+func (r *OneTimeTokenRepositoryMock) ConsumeOneTimeToken(ctx context.Context, purpose entity.TokenPurpose, tokenHash string, now time.Time) (*entity.OneTimeToken, *errors.Error) {
+	args := r.Called(ctx, purpose, tokenHash, now)
+
+	var t *entity.OneTimeToken
+	if v := args.Get(0); v != nil {
+		t = v.(*entity.OneTimeToken)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return t, e
+}
+
+func (r *OneTimeTokenRepositoryMock) InvalidateOneTimeTokens(ctx context.Context, userID int64, purpose entity.TokenPurpose) *errors.Error {
+	args := r.Called(ctx, userID, purpose)
+
+	var e *errors.Error
+	if v := args.Get(0); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return e
+}
@@ -0,0 +1,107 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type RefreshTokenRepositoryMock struct{ mock.Mock }
+
+func (r *RefreshTokenRepositoryMock) CreateRefreshToken(ctx context.Context, token entity.RefreshToken) (*entity.RefreshToken, *errors.Error) {
+	args := r.Called(ctx, token)
+
+	var t *entity.RefreshToken
+	if v := args.Get(0); v != nil {
+		t = v.(*entity.RefreshToken)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return t, e
+}
+
+func (r *RefreshTokenRepositoryMock) FindRefreshTokenByHash(ctx context.Context, tokenHash string) (*entity.RefreshToken, *errors.Error) {
+	args := r.Called(ctx, tokenHash)
+
+	var t *entity.RefreshToken
+	if v := args.Get(0); v != nil {
+		t = v.(*entity.RefreshToken)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return t, e
+}
+
+func (r *RefreshTokenRepositoryMock) MarkRefreshTokenRotated(ctx context.Context, id int64) *errors.Error {
+	args := r.Called(ctx, id)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepositoryMock) RevokeRefreshTokenFamily(ctx context.Context, familyID string) *errors.Error {
+	args := r.Called(ctx, familyID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepositoryMock) RevokeUserRefreshTokens(ctx context.Context, publicID, exceptFamilyID string) *errors.Error {
+	args := r.Called(ctx, publicID, exceptFamilyID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepositoryMock) ListRefreshTokensByUserID(ctx context.Context, userID int64) ([]entity.RefreshToken, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var t []entity.RefreshToken
+	if v := args.Get(0); v != nil {
+		t = v.([]entity.RefreshToken)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return t, e
+}
+
+func (r *RefreshTokenRepositoryMock) FindFamilyStartedAt(ctx context.Context, familyID string) (*time.Time, *errors.Error) {
+	args := r.Called(ctx, familyID)
+
+	var t *time.Time
+	if v := args.Get(0); v != nil {
+		t = v.(*time.Time)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return t, e
+}
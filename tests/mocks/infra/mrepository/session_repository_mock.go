@@ -0,0 +1,87 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type SessionRepositoryMock struct{ mock.Mock }
+
+func (r *SessionRepositoryMock) CreateSession(ctx context.Context, session entity.Session) (*entity.Session, *errors.Error) {
+	args := r.Called(ctx, session)
+
+	var s *entity.Session
+	if v := args.Get(0); v != nil {
+		s = v.(*entity.Session)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return s, e
+}
+
+func (r *SessionRepositoryMock) FindSessionByFamilyID(ctx context.Context, familyID string) (*entity.Session, *errors.Error) {
+	args := r.Called(ctx, familyID)
+
+	var s *entity.Session
+	if v := args.Get(0); v != nil {
+		s = v.(*entity.Session)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return s, e
+}
+
+func (r *SessionRepositoryMock) ListActiveSessionsByUserID(ctx context.Context, userID int64) ([]entity.Session, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var s []entity.Session
+	if v := args.Get(0); v != nil {
+		s = v.([]entity.Session)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return s, e
+}
+
+func (r *SessionRepositoryMock) TouchSession(ctx context.Context, familyID, ipAddress string, seenAt time.Time) *errors.Error {
+	args := r.Called(ctx, familyID, ipAddress, seenAt)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *SessionRepositoryMock) ListSessionsByUserID(ctx context.Context, userID int64) ([]entity.Session, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var s []entity.Session
+	if v := args.Get(0); v != nil {
+		s = v.([]entity.Session)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return s, e
+}
@@ -0,0 +1,71 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type UserIdentityRepositoryMock struct{ mock.Mock }
+
+func (r *UserIdentityRepositoryMock) CreateIdentity(ctx context.Context, identity entity.UserIdentity) (*entity.UserIdentity, *errors.Error) {
+	args := r.Called(ctx, identity)
+
+	var i *entity.UserIdentity
+	if v := args.Get(0); v != nil {
+		i = v.(*entity.UserIdentity)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return i, e
+}
+
+func (r *UserIdentityRepositoryMock) FindIdentity(ctx context.Context, provider, subject string) (*entity.UserIdentity, *errors.Error) {
+	args := r.Called(ctx, provider, subject)
+
+	var i *entity.UserIdentity
+	if v := args.Get(0); v != nil {
+		i = v.(*entity.UserIdentity)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return i, e
+}
+
+func (r *UserIdentityRepositoryMock) RecordIdentityLogin(ctx context.Context, id int64, email string, at time.Time) *errors.Error {
+	args := r.Called(ctx, id, email, at)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *UserIdentityRepositoryMock) ListIdentitiesByUserID(ctx context.Context, userID int64) ([]entity.UserIdentity, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var i []entity.UserIdentity
+	if v := args.Get(0); v != nil {
+		i = v.([]entity.UserIdentity)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return i, e
+}
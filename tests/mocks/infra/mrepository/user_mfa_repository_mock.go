@@ -0,0 +1,82 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type UserMFARepositoryMock struct{ mock.Mock }
+
+func (r *UserMFARepositoryMock) SaveUserMFA(ctx context.Context, mfa entity.UserMFA) *errors.Error {
+	args := r.Called(ctx, mfa)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *UserMFARepositoryMock) FindUserMFA(ctx context.Context, userID int64) (*entity.UserMFA, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var m *entity.UserMFA
+	if v := args.Get(0); v != nil {
+		m = v.(*entity.UserMFA)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return m, e
+}
+
+func (r *UserMFARepositoryMock) ConfirmUserMFA(ctx context.Context, userID int64, step int64, confirmedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, userID, step, confirmedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *UserMFARepositoryMock) RecordTOTPStep(ctx context.Context, userID int64, step int64) (bool, *errors.Error) {
+	args := r.Called(ctx, userID, step)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *UserMFARepositoryMock) DeleteUserMFA(ctx context.Context, userID int64) *errors.Error {
+	args := r.Called(ctx, userID)
+
+	if v := args.Get(0); v != nil {
+		return v.(*errors.Error)
+	}
+
+	return nil
+}
+
+func (r *UserMFARepositoryMock) HasConfirmedMFA(ctx context.Context, publicID string) (bool, *errors.Error) {
+	args := r.Called(ctx, publicID)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
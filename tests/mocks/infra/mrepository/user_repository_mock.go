@@ -2,11 +2,13 @@ package mrepository
 
 import (
 	"context"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	"github.com/andreis3/auth-ms/internal/domain/entity"
 	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
 )
 
 type UserRepositoryMock struct{ mock.Mock }
@@ -42,3 +44,170 @@ func (r *UserRepositoryMock) FindUserByEmail(ctx context.Context, email string)
 
 	return u, e
 }
+
+func (r *UserRepositoryMock) FindUserByID(ctx context.Context, id int64) (*entity.User, *errors.Error) {
+	args := r.Called(ctx, id)
+
+	var u *entity.User
+	if v := args.Get(0); v != nil {
+		u = v.(*entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
+
+func (r *UserRepositoryMock) FindUserByPublicID(ctx context.Context, publicID string) (*entity.User, *errors.Error) {
+	args := r.Called(ctx, publicID)
+
+	var u *entity.User
+	if v := args.Get(0); v != nil {
+		u = v.(*entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
+
+func (r *UserRepositoryMock) UpdateUser(ctx context.Context, user entity.User) (*entity.User, *errors.Error) {
+	args := r.Called(ctx, user)
+
+	var u *entity.User
+	if v := args.Get(0); v != nil {
+		u = v.(*entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
+
+func (r *UserRepositoryMock) SearchUsers(ctx context.Context, search vo.UserSearch) ([]entity.User, *errors.Error) {
+	args := r.Called(ctx, search)
+
+	var u []entity.User
+	if v := args.Get(0); v != nil {
+		u = v.([]entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
+
+func (r *UserRepositoryMock) FindDeletedUserByEmail(ctx context.Context, email string) (*entity.User, *errors.Error) {
+	args := r.Called(ctx, email)
+
+	var u *entity.User
+	if v := args.Get(0); v != nil {
+		u = v.(*entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
+
+func (r *UserRepositoryMock) SoftDeleteUser(ctx context.Context, id int64, deletedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, id, deletedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *UserRepositoryMock) RestoreUser(ctx context.Context, id int64) (*entity.User, *errors.Error) {
+	args := r.Called(ctx, id)
+
+	var u *entity.User
+	if v := args.Get(0); v != nil {
+		u = v.(*entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
+
+func (r *UserRepositoryMock) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time, limit int) (int64, *errors.Error) {
+	args := r.Called(ctx, deletedBefore, limit)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Get(0).(int64), e
+}
+
+func (r *UserRepositoryMock) UpdateUserPassword(ctx context.Context, id int64, passwordHash string, updatedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, id, passwordHash, updatedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *UserRepositoryMock) UpdateUserPhone(ctx context.Context, id int64, phone string, verifiedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, id, phone, verifiedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *UserRepositoryMock) FindUserByVerifiedPhone(ctx context.Context, phone string) (*entity.User, *errors.Error) {
+	args := r.Called(ctx, phone)
+
+	var u *entity.User
+	if v := args.Get(0); v != nil {
+		u = v.(*entity.User)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return u, e
+}
+
+func (r *UserRepositoryMock) MarkEmailVerified(ctx context.Context, id int64, email string, verifiedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, id, email, verifiedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
@@ -0,0 +1,83 @@
+package mrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+)
+
+type WebAuthnCredentialRepositoryMock struct{ mock.Mock }
+
+func (r *WebAuthnCredentialRepositoryMock) CreateCredential(ctx context.Context, credential entity.WebAuthnCredential) (*entity.WebAuthnCredential, *errors.Error) {
+	args := r.Called(ctx, credential)
+
+	var c *entity.WebAuthnCredential
+	if v := args.Get(0); v != nil {
+		c = v.(*entity.WebAuthnCredential)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
+
+func (r *WebAuthnCredentialRepositoryMock) FindCredentialByCredentialID(ctx context.Context, credentialID string) (*entity.WebAuthnCredential, *errors.Error) {
+	args := r.Called(ctx, credentialID)
+
+	var c *entity.WebAuthnCredential
+	if v := args.Get(0); v != nil {
+		c = v.(*entity.WebAuthnCredential)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
+
+func (r *WebAuthnCredentialRepositoryMock) ListCredentialsByUserID(ctx context.Context, userID int64) ([]entity.WebAuthnCredential, *errors.Error) {
+	args := r.Called(ctx, userID)
+
+	var c []entity.WebAuthnCredential
+	if v := args.Get(0); v != nil {
+		c = v.([]entity.WebAuthnCredential)
+	}
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return c, e
+}
+
+func (r *WebAuthnCredentialRepositoryMock) RecordCredentialUse(ctx context.Context, id int64, signCount uint32, backedUp bool, usedAt time.Time) (bool, *errors.Error) {
+	args := r.Called(ctx, id, signCount, backedUp, usedAt)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
+
+func (r *WebAuthnCredentialRepositoryMock) DeleteCredential(ctx context.Context, userID int64, credentialID string) (bool, *errors.Error) {
+	args := r.Called(ctx, userID, credentialID)
+
+	var e *errors.Error
+	if v := args.Get(1); v != nil {
+		e = v.(*errors.Error)
+	}
+
+	return args.Bool(0), e
+}
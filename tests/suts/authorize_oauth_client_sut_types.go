@@ -0,0 +1,51 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type AuthorizeOAuthClientSut struct {
+	UnitOfWork  *madapters.UnitOfWorkMock
+	ClientRepo  *mrepository.OAuthClientRepositoryMock
+	ConsentRepo *mrepository.OAuthConsentRepositoryMock
+	CodeRepo    *mrepository.AuthorizationCodeRepositoryMock
+	RefreshRepo *mrepository.RefreshTokenRepositoryMock
+	UserService *mservice.UserServiceMock
+	OpaqueToken *madapters.OpaqueTokenMock
+	CodeTTL     time.Duration
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.AuthorizeOAuthClient
+}
+
+func MakeAuthorizeOAuthClientSut() *AuthorizeOAuthClientSut {
+	return &AuthorizeOAuthClientSut{
+		UnitOfWork:  new(madapters.UnitOfWorkMock),
+		ClientRepo:  new(mrepository.OAuthClientRepositoryMock),
+		ConsentRepo: new(mrepository.OAuthConsentRepositoryMock),
+		CodeRepo:    new(mrepository.AuthorizationCodeRepositoryMock),
+		RefreshRepo: new(mrepository.RefreshTokenRepositoryMock),
+		UserService: new(mservice.UserServiceMock),
+		OpaqueToken: new(madapters.OpaqueTokenMock),
+		CodeTTL:     time.Minute,
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *AuthorizeOAuthClientSut) Build() *command.AuthorizeOAuthClient {
+	s.Cmd = command.NewAuthorizeOAuthClient(s.UnitOfWork, s.ClientRepo, s.ConsentRepo, s.CodeRepo, s.RefreshRepo,
+		s.UserService, s.OpaqueToken, s.CodeTTL, s.Log, s.Tracer)
+	return s.Cmd
+}
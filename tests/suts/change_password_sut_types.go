@@ -0,0 +1,46 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ChangePasswordSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	UserRepo    *mrepository.UserRepositoryMock
+	RefreshRepo *mrepository.RefreshTokenRepositoryMock
+	Service     *mservice.UserServiceMock
+	Denylist    *madapters.TokenDenylistMock
+	Throttle    *madapters.LoginThrottleMock
+	Bcrypt      *madapters.BcryptMock
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.ChangePassword
+}
+
+func MakeChangePasswordSut() *ChangePasswordSut {
+	return &ChangePasswordSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		UserRepo:    new(mrepository.UserRepositoryMock),
+		RefreshRepo: new(mrepository.RefreshTokenRepositoryMock),
+		Service:     new(mservice.UserServiceMock),
+		Denylist:    new(madapters.TokenDenylistMock),
+		Throttle:    new(madapters.LoginThrottleMock),
+		Bcrypt:      new(madapters.BcryptMock),
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ChangePasswordSut) Build() *command.ChangePassword {
+	s.Cmd = command.NewChangePassword(s.Uow, s.UserRepo, s.RefreshRepo, s.Service, s.Denylist, s.Throttle, s.Bcrypt, s.Log, s.Tracer)
+	return s.Cmd
+}
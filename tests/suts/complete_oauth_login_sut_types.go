@@ -0,0 +1,54 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type CompleteOAuthLoginSut struct {
+	Uow                  *madapters.UnitOfWorkMock
+	UserRepo             *mrepository.UserRepositoryMock
+	IdentityRepo         *mrepository.UserIdentityRepositoryMock
+	TokenService         *mservice.AuthTokenServiceMock
+	MFAService           *mservice.MFAServiceMock
+	Provider             *madapters.IdentityProviderMock
+	Cache                *madapters.CacheMock
+	OpaqueToken          *madapters.OpaqueTokenMock
+	RequireVerifiedEmail bool
+	Log                  *madapters.LoggerMock
+	Tracer               *madapters.TracerMock
+	Span                 *madapters.SpanMock
+	Sc                   *madapters.SpanContextMock
+	Utils                *madapters.UtilsMock
+	Cmd                  *command.CompleteOAuthLogin
+}
+
+func MakeCompleteOAuthLoginSut() *CompleteOAuthLoginSut {
+	return &CompleteOAuthLoginSut{
+		Uow:          new(madapters.UnitOfWorkMock),
+		UserRepo:     new(mrepository.UserRepositoryMock),
+		IdentityRepo: new(mrepository.UserIdentityRepositoryMock),
+		TokenService: new(mservice.AuthTokenServiceMock),
+		MFAService:   new(mservice.MFAServiceMock),
+		Provider:     new(madapters.IdentityProviderMock),
+		Cache:        new(madapters.CacheMock),
+		OpaqueToken:  new(madapters.OpaqueTokenMock),
+		Log:          new(madapters.LoggerMock),
+		Tracer:       new(madapters.TracerMock),
+		Span:         new(madapters.SpanMock),
+		Sc:           new(madapters.SpanContextMock),
+		Utils:        new(madapters.UtilsMock),
+	}
+}
+
+func (s *CompleteOAuthLoginSut) Build() *command.CompleteOAuthLogin {
+	providers := map[string]adapter.IdentityProvider{"google": s.Provider}
+	s.Cmd = command.NewCompleteOAuthLogin(s.Uow, s.UserRepo, s.IdentityRepo, s.TokenService, s.MFAService, providers,
+		s.Cache, s.OpaqueToken, s.RequireVerifiedEmail, s.Log, s.Tracer, s.Utils)
+	return s.Cmd
+}
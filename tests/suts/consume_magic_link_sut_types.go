@@ -0,0 +1,44 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ConsumeMagicLinkSut struct {
+	UserRepo     *mrepository.UserRepositoryMock
+	TokenRepo    *mrepository.OneTimeTokenRepositoryMock
+	TokenService *mservice.AuthTokenServiceMock
+	MFAService   *mservice.MFAServiceMock
+	OpaqueToken  *madapters.OpaqueTokenMock
+	Signer       *madapters.SignerMock
+	Log          *madapters.LoggerMock
+	Tracer       *madapters.TracerMock
+	Span         *madapters.SpanMock
+	Sc           *madapters.SpanContextMock
+	Cmd          *command.ConsumeMagicLink
+}
+
+func MakeConsumeMagicLinkSut() *ConsumeMagicLinkSut {
+	return &ConsumeMagicLinkSut{
+		UserRepo:     new(mrepository.UserRepositoryMock),
+		TokenRepo:    new(mrepository.OneTimeTokenRepositoryMock),
+		TokenService: new(mservice.AuthTokenServiceMock),
+		MFAService:   new(mservice.MFAServiceMock),
+		OpaqueToken:  new(madapters.OpaqueTokenMock),
+		Signer:       new(madapters.SignerMock),
+		Log:          new(madapters.LoggerMock),
+		Tracer:       new(madapters.TracerMock),
+		Span:         new(madapters.SpanMock),
+		Sc:           new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ConsumeMagicLinkSut) Build() *command.ConsumeMagicLink {
+	s.Cmd = command.NewConsumeMagicLink(s.UserRepo, s.TokenRepo, s.TokenService, s.MFAService, s.OpaqueToken, s.Signer, s.Log, s.Tracer)
+	return s.Cmd
+}
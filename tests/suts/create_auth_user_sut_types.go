@@ -10,31 +10,33 @@ import (
 )
 
 type CreateAuthUserSut struct {
-	Repo    *mrepository.UserRepositoryMock
-	Service *mservice.UserServiceMock
-	Bcrypt  *madapters.BcryptMock
-	Log     *madapters.LoggerMock
-	Tracer  *madapters.TracerMock
-	Span    *madapters.SpanMock
-	Sc      *madapters.SpanContextMock
-	Utils   *madapters.UtilsMock
-	Cmd     *command.CreateAuthUser
+	Repo              *mrepository.UserRepositoryMock
+	Service           *mservice.UserServiceMock
+	EmailVerification *mservice.EmailVerificationServiceMock
+	Bcrypt            *madapters.BcryptMock
+	Log               *madapters.LoggerMock
+	Tracer            *madapters.TracerMock
+	Span              *madapters.SpanMock
+	Sc                *madapters.SpanContextMock
+	Utils             *madapters.UtilsMock
+	Cmd               *command.CreateAuthUser
 }
 
 func MakeCreateAuthUserSut() *CreateAuthUserSut {
 	return &CreateAuthUserSut{
-		Repo:    new(mrepository.UserRepositoryMock),
-		Service: new(mservice.UserServiceMock),
-		Bcrypt:  new(madapters.BcryptMock),
-		Log:     new(madapters.LoggerMock),
-		Tracer:  new(madapters.TracerMock),
-		Span:    new(madapters.SpanMock),
-		Sc:      new(madapters.SpanContextMock),
-		Utils:   new(madapters.UtilsMock),
+		Repo:              new(mrepository.UserRepositoryMock),
+		Service:           new(mservice.UserServiceMock),
+		EmailVerification: new(mservice.EmailVerificationServiceMock),
+		Bcrypt:            new(madapters.BcryptMock),
+		Log:               new(madapters.LoggerMock),
+		Tracer:            new(madapters.TracerMock),
+		Span:              new(madapters.SpanMock),
+		Sc:                new(madapters.SpanContextMock),
+		Utils:             new(madapters.UtilsMock),
 	}
 }
 
 func (s *CreateAuthUserSut) Build() *command.CreateAuthUser {
-	s.Cmd = command.NewCreateAuthUser(s.Repo, s.Service, s.Bcrypt, s.Log, s.Tracer, s.Utils)
+	s.Cmd = command.NewCreateAuthUser(s.Repo, s.Service, s.EmailVerification, s.Bcrypt, s.Log, s.Tracer, s.Utils)
 	return s.Cmd
 }
@@ -0,0 +1,40 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type CreateUserAddressesSut struct {
+	Uow     *madapters.UnitOfWorkMock
+	Repo    *mrepository.AddressRepositoryMock
+	Service *mservice.UserServiceMock
+	Log     *madapters.LoggerMock
+	Tracer  *madapters.TracerMock
+	Span    *madapters.SpanMock
+	Sc      *madapters.SpanContextMock
+	Utils   *madapters.UtilsMock
+	Cmd     *command.CreateUserAddresses
+}
+
+func MakeCreateUserAddressesSut() *CreateUserAddressesSut {
+	return &CreateUserAddressesSut{
+		Uow:     new(madapters.UnitOfWorkMock),
+		Repo:    new(mrepository.AddressRepositoryMock),
+		Service: new(mservice.UserServiceMock),
+		Log:     new(madapters.LoggerMock),
+		Tracer:  new(madapters.TracerMock),
+		Span:    new(madapters.SpanMock),
+		Sc:      new(madapters.SpanContextMock),
+		Utils:   new(madapters.UtilsMock),
+	}
+}
+
+func (s *CreateUserAddressesSut) Build() *command.CreateUserAddresses {
+	s.Cmd = command.NewCreateUserAddresses(s.Uow, s.Repo, s.Service, s.Log, s.Tracer, s.Utils)
+	return s.Cmd
+}
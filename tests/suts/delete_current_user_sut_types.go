@@ -0,0 +1,46 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type DeleteCurrentUserSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	UserRepo    *mrepository.UserRepositoryMock
+	RefreshRepo *mrepository.RefreshTokenRepositoryMock
+	Service     *mservice.UserServiceMock
+	Denylist    *madapters.TokenDenylistMock
+	GracePeriod time.Duration
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.DeleteCurrentUser
+}
+
+func MakeDeleteCurrentUserSut() *DeleteCurrentUserSut {
+	return &DeleteCurrentUserSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		UserRepo:    new(mrepository.UserRepositoryMock),
+		RefreshRepo: new(mrepository.RefreshTokenRepositoryMock),
+		Service:     new(mservice.UserServiceMock),
+		Denylist:    new(madapters.TokenDenylistMock),
+		GracePeriod: 720 * time.Hour,
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *DeleteCurrentUserSut) Build() *command.DeleteCurrentUser {
+	s.Cmd = command.NewDeleteCurrentUser(s.Uow, s.UserRepo, s.RefreshRepo, s.Service, s.Denylist, s.GracePeriod, s.Log, s.Tracer)
+	return s.Cmd
+}
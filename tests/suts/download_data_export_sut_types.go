@@ -0,0 +1,35 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/query"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type DownloadDataExportSut struct {
+	Repo   *mrepository.DataExportRepositoryMock
+	Signer *madapters.SignerMock
+	Log    *madapters.LoggerMock
+	Tracer *madapters.TracerMock
+	Span   *madapters.SpanMock
+	Sc     *madapters.SpanContextMock
+	Query  *query.DownloadDataExport
+}
+
+func MakeDownloadDataExportSut() *DownloadDataExportSut {
+	return &DownloadDataExportSut{
+		Repo:   new(mrepository.DataExportRepositoryMock),
+		Signer: new(madapters.SignerMock),
+		Log:    new(madapters.LoggerMock),
+		Tracer: new(madapters.TracerMock),
+		Span:   new(madapters.SpanMock),
+		Sc:     new(madapters.SpanContextMock),
+	}
+}
+
+func (s *DownloadDataExportSut) Build() *query.DownloadDataExport {
+	s.Query = query.NewDownloadDataExport(s.Repo, s.Signer, s.Log, s.Tracer)
+	return s.Query
+}
@@ -0,0 +1,46 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ExchangeOAuthTokenSut struct {
+	ClientRepo  *mrepository.OAuthClientRepositoryMock
+	CodeRepo    *mrepository.AuthorizationCodeRepositoryMock
+	UserRepo    *mrepository.UserRepositoryMock
+	JWT         *madapters.JWTMock
+	ServiceJWT  *madapters.JWTMock
+	IDToken     *madapters.IDTokenSignerMock
+	OpaqueToken *madapters.OpaqueTokenMock
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.ExchangeOAuthToken
+}
+
+func MakeExchangeOAuthTokenSut() *ExchangeOAuthTokenSut {
+	return &ExchangeOAuthTokenSut{
+		ClientRepo:  new(mrepository.OAuthClientRepositoryMock),
+		CodeRepo:    new(mrepository.AuthorizationCodeRepositoryMock),
+		UserRepo:    new(mrepository.UserRepositoryMock),
+		JWT:         new(madapters.JWTMock),
+		ServiceJWT:  new(madapters.JWTMock),
+		IDToken:     new(madapters.IDTokenSignerMock),
+		OpaqueToken: new(madapters.OpaqueTokenMock),
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ExchangeOAuthTokenSut) Build() *command.ExchangeOAuthToken {
+	s.Cmd = command.NewExchangeOAuthToken(s.ClientRepo, s.CodeRepo, s.UserRepo, s.JWT, s.ServiceJWT, s.IDToken,
+		s.OpaqueToken, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,46 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type FinishPasskeyLoginSut struct {
+	Repo                 *mrepository.UserRepositoryMock
+	CredentialRepo       *mrepository.WebAuthnCredentialRepositoryMock
+	TokenService         *mservice.AuthTokenServiceMock
+	WebAuthn             *madapters.WebAuthnMock
+	Cache                *madapters.CacheMock
+	OpaqueToken          *madapters.OpaqueTokenMock
+	RequireVerifiedEmail bool
+	Log                  *madapters.LoggerMock
+	Tracer               *madapters.TracerMock
+	Span                 *madapters.SpanMock
+	Sc                   *madapters.SpanContextMock
+	Cmd                  *command.FinishPasskeyLogin
+}
+
+func MakeFinishPasskeyLoginSut() *FinishPasskeyLoginSut {
+	return &FinishPasskeyLoginSut{
+		Repo:           new(mrepository.UserRepositoryMock),
+		CredentialRepo: new(mrepository.WebAuthnCredentialRepositoryMock),
+		TokenService:   new(mservice.AuthTokenServiceMock),
+		WebAuthn:       new(madapters.WebAuthnMock),
+		Cache:          new(madapters.CacheMock),
+		OpaqueToken:    new(madapters.OpaqueTokenMock),
+		Log:            new(madapters.LoggerMock),
+		Tracer:         new(madapters.TracerMock),
+		Span:           new(madapters.SpanMock),
+		Sc:             new(madapters.SpanContextMock),
+	}
+}
+
+func (s *FinishPasskeyLoginSut) Build() *command.FinishPasskeyLogin {
+	s.Cmd = command.NewFinishPasskeyLogin(s.Repo, s.CredentialRepo, s.TokenService, s.WebAuthn, s.Cache,
+		s.OpaqueToken, s.RequireVerifiedEmail, s.Log, s.Tracer)
+	return s.Cmd
+}
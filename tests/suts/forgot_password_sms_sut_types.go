@@ -0,0 +1,41 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ForgotPasswordSmsSut struct {
+	UserRepo  *mrepository.UserRepositoryMock
+	OTPStore  *madapters.OTPStoreMock
+	SmsSender *madapters.SmsSenderMock
+	OTPTTL    time.Duration
+	Log       *madapters.LoggerMock
+	Tracer    *madapters.TracerMock
+	Span      *madapters.SpanMock
+	Sc        *madapters.SpanContextMock
+	Cmd       *command.ForgotPasswordSms
+}
+
+func MakeForgotPasswordSmsSut() *ForgotPasswordSmsSut {
+	return &ForgotPasswordSmsSut{
+		UserRepo:  new(mrepository.UserRepositoryMock),
+		OTPStore:  new(madapters.OTPStoreMock),
+		SmsSender: new(madapters.SmsSenderMock),
+		OTPTTL:    5 * time.Minute,
+		Log:       new(madapters.LoggerMock),
+		Tracer:    new(madapters.TracerMock),
+		Span:      new(madapters.SpanMock),
+		Sc:        new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ForgotPasswordSmsSut) Build() *command.ForgotPasswordSms {
+	s.Cmd = command.NewForgotPasswordSms(s.UserRepo, s.OTPStore, s.SmsSender, s.OTPTTL, s.Log, s.Tracer)
+	return s.Cmd
+}
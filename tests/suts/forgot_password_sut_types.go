@@ -0,0 +1,47 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ForgotPasswordSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	UserRepo    *mrepository.UserRepositoryMock
+	TokenRepo   *mrepository.OneTimeTokenRepositoryMock
+	OpaqueToken *madapters.OpaqueTokenMock
+	Mailer      *madapters.MailerMock
+	ResetURL    string
+	ResetTTL    time.Duration
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.ForgotPassword
+}
+
+func MakeForgotPasswordSut() *ForgotPasswordSut {
+	return &ForgotPasswordSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		UserRepo:    new(mrepository.UserRepositoryMock),
+		TokenRepo:   new(mrepository.OneTimeTokenRepositoryMock),
+		OpaqueToken: new(madapters.OpaqueTokenMock),
+		Mailer:      new(madapters.MailerMock),
+		ResetURL:    "https://app.example.com/reset-password",
+		ResetTTL:    30 * time.Minute,
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ForgotPasswordSut) Build() *command.ForgotPassword {
+	s.Cmd = command.NewForgotPassword(s.Uow, s.UserRepo, s.TokenRepo, s.OpaqueToken, s.Mailer, s.ResetURL, s.ResetTTL, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,33 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/query"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type GetUserInfoSut struct {
+	Repo   *mrepository.UserRepositoryMock
+	Log    *madapters.LoggerMock
+	Tracer *madapters.TracerMock
+	Span   *madapters.SpanMock
+	Sc     *madapters.SpanContextMock
+	Query  *query.GetUserInfo
+}
+
+func MakeGetUserInfoSut() *GetUserInfoSut {
+	return &GetUserInfoSut{
+		Repo:   new(mrepository.UserRepositoryMock),
+		Log:    new(madapters.LoggerMock),
+		Tracer: new(madapters.TracerMock),
+		Span:   new(madapters.SpanMock),
+		Sc:     new(madapters.SpanContextMock),
+	}
+}
+
+func (s *GetUserInfoSut) Build() *query.GetUserInfo {
+	s.Query = query.NewGetUserInfo(s.Repo, s.Log, s.Tracer)
+	return s.Query
+}
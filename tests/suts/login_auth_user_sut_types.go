@@ -0,0 +1,43 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type LoginAuthUserSut struct {
+	Repo                 *mrepository.UserRepositoryMock
+	TokenService         *mservice.AuthTokenServiceMock
+	MFAService           *mservice.MFAServiceMock
+	Throttle             *madapters.LoginThrottleMock
+	Bcrypt               *madapters.BcryptMock
+	RequireVerifiedEmail bool
+	Log                  *madapters.LoggerMock
+	Tracer               *madapters.TracerMock
+	Span                 *madapters.SpanMock
+	Sc                   *madapters.SpanContextMock
+	Cmd                  *command.LoginAuthUser
+}
+
+func MakeLoginAuthUserSut() *LoginAuthUserSut {
+	return &LoginAuthUserSut{
+		Repo:         new(mrepository.UserRepositoryMock),
+		TokenService: new(mservice.AuthTokenServiceMock),
+		MFAService:   new(mservice.MFAServiceMock),
+		Throttle:     new(madapters.LoginThrottleMock),
+		Bcrypt:       new(madapters.BcryptMock),
+		Log:          new(madapters.LoggerMock),
+		Tracer:       new(madapters.TracerMock),
+		Span:         new(madapters.SpanMock),
+		Sc:           new(madapters.SpanContextMock),
+	}
+}
+
+func (s *LoginAuthUserSut) Build() *command.LoginAuthUser {
+	s.Cmd = command.NewLoginAuthUser(s.Repo, s.TokenService, s.MFAService, s.Throttle, s.Bcrypt, s.RequireVerifiedEmail, s.Log, s.Tracer)
+	return s.Cmd
+}
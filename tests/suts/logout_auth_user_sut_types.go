@@ -0,0 +1,38 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type LogoutAuthUserSut struct {
+	RefreshRepo  *mrepository.RefreshTokenRepositoryMock
+	TokenService *mservice.AuthTokenServiceMock
+	Denylist     *madapters.TokenDenylistMock
+	Log          *madapters.LoggerMock
+	Tracer       *madapters.TracerMock
+	Span         *madapters.SpanMock
+	Sc           *madapters.SpanContextMock
+	Cmd          *command.LogoutAuthUser
+}
+
+func MakeLogoutAuthUserSut() *LogoutAuthUserSut {
+	return &LogoutAuthUserSut{
+		RefreshRepo:  new(mrepository.RefreshTokenRepositoryMock),
+		TokenService: new(mservice.AuthTokenServiceMock),
+		Denylist:     new(madapters.TokenDenylistMock),
+		Log:          new(madapters.LoggerMock),
+		Tracer:       new(madapters.TracerMock),
+		Span:         new(madapters.SpanMock),
+		Sc:           new(madapters.SpanContextMock),
+	}
+}
+
+func (s *LogoutAuthUserSut) Build() *command.LogoutAuthUser {
+	s.Cmd = command.NewLogoutAuthUser(s.RefreshRepo, s.TokenService, s.Denylist, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,50 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/service"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type MFAServiceSut struct {
+	MFARepo       *mrepository.UserMFARepositoryMock
+	RecoveryRepo  *mrepository.MFARecoveryCodeRepositoryMock
+	TOTP          *madapters.TOTPMock
+	Cache         *madapters.CacheMock
+	OpaqueToken   *madapters.OpaqueTokenMock
+	ChallengeTTL  time.Duration
+	MaxAttempts   int
+	RecoveryCodes int
+	Tracer        *madapters.TracerMock
+	Span          *madapters.SpanMock
+	Sc            *madapters.SpanContextMock
+	Log           *madapters.LoggerMock
+	Service       *service.MFAService
+}
+
+func MakeMFAServiceSut() *MFAServiceSut {
+	return &MFAServiceSut{
+		MFARepo:       new(mrepository.UserMFARepositoryMock),
+		RecoveryRepo:  new(mrepository.MFARecoveryCodeRepositoryMock),
+		TOTP:          new(madapters.TOTPMock),
+		Cache:         new(madapters.CacheMock),
+		OpaqueToken:   new(madapters.OpaqueTokenMock),
+		ChallengeTTL:  5 * time.Minute,
+		MaxAttempts:   3,
+		RecoveryCodes: 2,
+		Tracer:        new(madapters.TracerMock),
+		Span:          new(madapters.SpanMock),
+		Sc:            new(madapters.SpanContextMock),
+		Log:           new(madapters.LoggerMock),
+	}
+}
+
+func (s *MFAServiceSut) Build() *service.MFAService {
+	s.Service = service.NewMFAService(s.MFARepo, s.RecoveryRepo, s.TOTP, s.Cache, s.OpaqueToken, s.ChallengeTTL,
+		s.MaxAttempts, s.RecoveryCodes, s.Tracer, s.Log)
+	return s.Service
+}
@@ -0,0 +1,56 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ProcessDataExportsSut struct {
+	ExportRepo   *mrepository.DataExportRepositoryMock
+	UserRepo     *mrepository.UserRepositoryMock
+	AddressRepo  *mrepository.AddressRepositoryMock
+	SessionRepo  *mrepository.SessionRepositoryMock
+	RefreshRepo  *mrepository.RefreshTokenRepositoryMock
+	IdentityRepo *mrepository.UserIdentityRepositoryMock
+	ConsentRepo  *mrepository.OAuthConsentRepositoryMock
+	PasskeyRepo  *mrepository.WebAuthnCredentialRepositoryMock
+	MFARepo      *mrepository.UserMFARepositoryMock
+	ExportTTL    time.Duration
+	ClaimTimeout time.Duration
+	Log          *madapters.LoggerMock
+	Tracer       *madapters.TracerMock
+	Span         *madapters.SpanMock
+	Sc           *madapters.SpanContextMock
+	Cmd          *command.ProcessDataExports
+}
+
+func MakeProcessDataExportsSut() *ProcessDataExportsSut {
+	return &ProcessDataExportsSut{
+		ExportRepo:   new(mrepository.DataExportRepositoryMock),
+		UserRepo:     new(mrepository.UserRepositoryMock),
+		AddressRepo:  new(mrepository.AddressRepositoryMock),
+		SessionRepo:  new(mrepository.SessionRepositoryMock),
+		RefreshRepo:  new(mrepository.RefreshTokenRepositoryMock),
+		IdentityRepo: new(mrepository.UserIdentityRepositoryMock),
+		ConsentRepo:  new(mrepository.OAuthConsentRepositoryMock),
+		PasskeyRepo:  new(mrepository.WebAuthnCredentialRepositoryMock),
+		MFARepo:      new(mrepository.UserMFARepositoryMock),
+		ExportTTL:    168 * time.Hour,
+		ClaimTimeout: 15 * time.Minute,
+		Log:          new(madapters.LoggerMock),
+		Tracer:       new(madapters.TracerMock),
+		Span:         new(madapters.SpanMock),
+		Sc:           new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ProcessDataExportsSut) Build() *command.ProcessDataExports {
+	s.Cmd = command.NewProcessDataExports(s.ExportRepo, s.UserRepo, s.AddressRepo, s.SessionRepo, s.RefreshRepo,
+		s.IdentityRepo, s.ConsentRepo, s.PasskeyRepo, s.MFARepo, s.ExportTTL, s.ClaimTimeout, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,42 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type RefreshAuthTokenSut struct {
+	Uow          *madapters.UnitOfWorkMock
+	UserRepo     *mrepository.UserRepositoryMock
+	RefreshRepo  *mrepository.RefreshTokenRepositoryMock
+	TokenService *mservice.AuthTokenServiceMock
+	OpaqueToken  *madapters.OpaqueTokenMock
+	Log          *madapters.LoggerMock
+	Tracer       *madapters.TracerMock
+	Span         *madapters.SpanMock
+	Sc           *madapters.SpanContextMock
+	Cmd          *command.RefreshAuthToken
+}
+
+func MakeRefreshAuthTokenSut() *RefreshAuthTokenSut {
+	return &RefreshAuthTokenSut{
+		Uow:          new(madapters.UnitOfWorkMock),
+		UserRepo:     new(mrepository.UserRepositoryMock),
+		RefreshRepo:  new(mrepository.RefreshTokenRepositoryMock),
+		TokenService: new(mservice.AuthTokenServiceMock),
+		OpaqueToken:  new(madapters.OpaqueTokenMock),
+		Log:          new(madapters.LoggerMock),
+		Tracer:       new(madapters.TracerMock),
+		Span:         new(madapters.SpanMock),
+		Sc:           new(madapters.SpanContextMock),
+	}
+}
+
+func (s *RefreshAuthTokenSut) Build() *command.RefreshAuthToken {
+	s.Cmd = command.NewRefreshAuthToken(s.Uow, s.UserRepo, s.RefreshRepo, s.TokenService, s.OpaqueToken, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,49 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type RequestMagicLinkSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	UserRepo    *mrepository.UserRepositoryMock
+	TokenRepo   *mrepository.OneTimeTokenRepositoryMock
+	OpaqueToken *madapters.OpaqueTokenMock
+	Signer      *madapters.SignerMock
+	Mailer      *madapters.MailerMock
+	LinkURL     string
+	LinkTTL     time.Duration
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.RequestMagicLink
+}
+
+func MakeRequestMagicLinkSut() *RequestMagicLinkSut {
+	return &RequestMagicLinkSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		UserRepo:    new(mrepository.UserRepositoryMock),
+		TokenRepo:   new(mrepository.OneTimeTokenRepositoryMock),
+		OpaqueToken: new(madapters.OpaqueTokenMock),
+		Signer:      new(madapters.SignerMock),
+		Mailer:      new(madapters.MailerMock),
+		LinkURL:     "https://app.example.com/magic-link",
+		LinkTTL:     10 * time.Minute,
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *RequestMagicLinkSut) Build() *command.RequestMagicLink {
+	s.Cmd = command.NewRequestMagicLink(s.Uow, s.UserRepo, s.TokenRepo, s.OpaqueToken, s.Signer, s.Mailer, s.LinkURL, s.LinkTTL, s.Log, s.Tracer)
+	return s.Cmd
+}
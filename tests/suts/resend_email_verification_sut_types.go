@@ -0,0 +1,44 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ResendEmailVerificationSut struct {
+	UserRepo          *mrepository.UserRepositoryMock
+	EmailVerification *mservice.EmailVerificationServiceMock
+	Cache             *madapters.CacheMock
+	ResendLimit       int
+	ResendWindow      time.Duration
+	Log               *madapters.LoggerMock
+	Tracer            *madapters.TracerMock
+	Span              *madapters.SpanMock
+	Sc                *madapters.SpanContextMock
+	Cmd               *command.ResendEmailVerification
+}
+
+func MakeResendEmailVerificationSut() *ResendEmailVerificationSut {
+	return &ResendEmailVerificationSut{
+		UserRepo:          new(mrepository.UserRepositoryMock),
+		EmailVerification: new(mservice.EmailVerificationServiceMock),
+		Cache:             new(madapters.CacheMock),
+		ResendLimit:       3,
+		ResendWindow:      time.Hour,
+		Log:               new(madapters.LoggerMock),
+		Tracer:            new(madapters.TracerMock),
+		Span:              new(madapters.SpanMock),
+		Sc:                new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ResendEmailVerificationSut) Build() *command.ResendEmailVerification {
+	s.Cmd = command.NewResendEmailVerification(s.UserRepo, s.EmailVerification, s.Cache, s.ResendLimit, s.ResendWindow, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,45 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type ResetPasswordSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	UserRepo    *mrepository.UserRepositoryMock
+	TokenRepo   *mrepository.OneTimeTokenRepositoryMock
+	RefreshRepo *mrepository.RefreshTokenRepositoryMock
+	Denylist    *madapters.TokenDenylistMock
+	OpaqueToken *madapters.OpaqueTokenMock
+	Bcrypt      *madapters.BcryptMock
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.ResetPassword
+}
+
+func MakeResetPasswordSut() *ResetPasswordSut {
+	return &ResetPasswordSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		UserRepo:    new(mrepository.UserRepositoryMock),
+		TokenRepo:   new(mrepository.OneTimeTokenRepositoryMock),
+		RefreshRepo: new(mrepository.RefreshTokenRepositoryMock),
+		Denylist:    new(madapters.TokenDenylistMock),
+		OpaqueToken: new(madapters.OpaqueTokenMock),
+		Bcrypt:      new(madapters.BcryptMock),
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *ResetPasswordSut) Build() *command.ResetPassword {
+	s.Cmd = command.NewResetPassword(s.Uow, s.UserRepo, s.TokenRepo, s.RefreshRepo, s.Denylist, s.OpaqueToken, s.Bcrypt, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,41 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type RestoreAuthUserSut struct {
+	Repo        *mrepository.UserRepositoryMock
+	Throttle    *madapters.LoginThrottleMock
+	Bcrypt      *madapters.BcryptMock
+	GracePeriod time.Duration
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.RestoreAuthUser
+}
+
+func MakeRestoreAuthUserSut() *RestoreAuthUserSut {
+	return &RestoreAuthUserSut{
+		Repo:        new(mrepository.UserRepositoryMock),
+		Throttle:    new(madapters.LoginThrottleMock),
+		Bcrypt:      new(madapters.BcryptMock),
+		GracePeriod: 720 * time.Hour,
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *RestoreAuthUserSut) Build() *command.RestoreAuthUser {
+	s.Cmd = command.NewRestoreAuthUser(s.Repo, s.Throttle, s.Bcrypt, s.GracePeriod, s.Log, s.Tracer)
+	return s.Cmd
+}
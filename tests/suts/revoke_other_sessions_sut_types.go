@@ -0,0 +1,40 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type RevokeOtherSessionsSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	RefreshRepo *mrepository.RefreshTokenRepositoryMock
+	Service     *mservice.UserServiceMock
+	Denylist    *madapters.TokenDenylistMock
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.RevokeOtherSessions
+}
+
+func MakeRevokeOtherSessionsSut() *RevokeOtherSessionsSut {
+	return &RevokeOtherSessionsSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		RefreshRepo: new(mrepository.RefreshTokenRepositoryMock),
+		Service:     new(mservice.UserServiceMock),
+		Denylist:    new(madapters.TokenDenylistMock),
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *RevokeOtherSessionsSut) Build() *command.RevokeOtherSessions {
+	s.Cmd = command.NewRevokeOtherSessions(s.Uow, s.RefreshRepo, s.Service, s.Denylist, s.Log, s.Tracer)
+	return s.Cmd
+}
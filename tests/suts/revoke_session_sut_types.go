@@ -0,0 +1,42 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type RevokeSessionSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	SessionRepo *mrepository.SessionRepositoryMock
+	RefreshRepo *mrepository.RefreshTokenRepositoryMock
+	Service     *mservice.UserServiceMock
+	Denylist    *madapters.TokenDenylistMock
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.RevokeSession
+}
+
+func MakeRevokeSessionSut() *RevokeSessionSut {
+	return &RevokeSessionSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		SessionRepo: new(mrepository.SessionRepositoryMock),
+		RefreshRepo: new(mrepository.RefreshTokenRepositoryMock),
+		Service:     new(mservice.UserServiceMock),
+		Denylist:    new(madapters.TokenDenylistMock),
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *RevokeSessionSut) Build() *command.RevokeSession {
+	s.Cmd = command.NewRevokeSession(s.Uow, s.SessionRepo, s.RefreshRepo, s.Service, s.Denylist, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,39 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type RotateOAuthClientSecretSut struct {
+	UnitOfWork  *madapters.UnitOfWorkMock
+	ClientRepo  *mrepository.OAuthClientRepositoryMock
+	Denylist    *madapters.TokenDenylistMock
+	OpaqueToken *madapters.OpaqueTokenMock
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.RotateOAuthClientSecret
+}
+
+func MakeRotateOAuthClientSecretSut() *RotateOAuthClientSecretSut {
+	return &RotateOAuthClientSecretSut{
+		UnitOfWork:  new(madapters.UnitOfWorkMock),
+		ClientRepo:  new(mrepository.OAuthClientRepositoryMock),
+		Denylist:    new(madapters.TokenDenylistMock),
+		OpaqueToken: new(madapters.OpaqueTokenMock),
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *RotateOAuthClientSecretSut) Build() *command.RotateOAuthClientSecret {
+	s.Cmd = command.NewRotateOAuthClientSecret(s.UnitOfWork, s.ClientRepo, s.Denylist, s.OpaqueToken, s.Log, s.Tracer)
+	return s.Cmd
+}
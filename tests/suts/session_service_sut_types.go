@@ -0,0 +1,39 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/service"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type SessionServiceSut struct {
+	SessionRepo   *mrepository.SessionRepositoryMock
+	Cache         *madapters.SessionCacheMock
+	TouchInterval time.Duration
+	Tracer        *madapters.TracerMock
+	Span          *madapters.SpanMock
+	Sc            *madapters.SpanContextMock
+	Log           *madapters.LoggerMock
+	Service       *service.SessionService
+}
+
+func MakeSessionServiceSut() *SessionServiceSut {
+	return &SessionServiceSut{
+		SessionRepo:   new(mrepository.SessionRepositoryMock),
+		Cache:         new(madapters.SessionCacheMock),
+		TouchInterval: time.Minute,
+		Tracer:        new(madapters.TracerMock),
+		Span:          new(madapters.SpanMock),
+		Sc:            new(madapters.SpanContextMock),
+		Log:           new(madapters.LoggerMock),
+	}
+}
+
+func (s *SessionServiceSut) Build() *service.SessionService {
+	s.Service = service.NewSessionService(s.SessionRepo, s.Cache, s.TouchInterval, s.Tracer, s.Log)
+	return s.Service
+}
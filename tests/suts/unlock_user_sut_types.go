@@ -0,0 +1,35 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type UnlockUserSut struct {
+	UserRepo *mrepository.UserRepositoryMock
+	Throttle *madapters.LoginThrottleMock
+	Log      *madapters.LoggerMock
+	Tracer   *madapters.TracerMock
+	Span     *madapters.SpanMock
+	Sc       *madapters.SpanContextMock
+	Cmd      *command.UnlockUser
+}
+
+func MakeUnlockUserSut() *UnlockUserSut {
+	return &UnlockUserSut{
+		UserRepo: new(mrepository.UserRepositoryMock),
+		Throttle: new(madapters.LoginThrottleMock),
+		Log:      new(madapters.LoggerMock),
+		Tracer:   new(madapters.TracerMock),
+		Span:     new(madapters.SpanMock),
+		Sc:       new(madapters.SpanContextMock),
+	}
+}
+
+func (s *UnlockUserSut) Build() *command.UnlockUser {
+	s.Cmd = command.NewUnlockUser(s.UserRepo, s.Throttle, s.Log, s.Tracer)
+	return s.Cmd
+}
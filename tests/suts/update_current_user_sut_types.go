@@ -0,0 +1,33 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type UpdateCurrentUserSut struct {
+	Repo   *mrepository.UserRepositoryMock
+	Log    *madapters.LoggerMock
+	Tracer *madapters.TracerMock
+	Span   *madapters.SpanMock
+	Sc     *madapters.SpanContextMock
+	Cmd    *command.UpdateCurrentUser
+}
+
+func MakeUpdateCurrentUserSut() *UpdateCurrentUserSut {
+	return &UpdateCurrentUserSut{
+		Repo:   new(mrepository.UserRepositoryMock),
+		Log:    new(madapters.LoggerMock),
+		Tracer: new(madapters.TracerMock),
+		Span:   new(madapters.SpanMock),
+		Sc:     new(madapters.SpanContextMock),
+	}
+}
+
+func (s *UpdateCurrentUserSut) Build() *command.UpdateCurrentUser {
+	s.Cmd = command.NewUpdateCurrentUser(s.Repo, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,36 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type VerifyEmailSut struct {
+	UserRepo          *mrepository.UserRepositoryMock
+	EmailVerification *mservice.EmailVerificationServiceMock
+	Log               *madapters.LoggerMock
+	Tracer            *madapters.TracerMock
+	Span              *madapters.SpanMock
+	Sc                *madapters.SpanContextMock
+	Cmd               *command.VerifyEmail
+}
+
+func MakeVerifyEmailSut() *VerifyEmailSut {
+	return &VerifyEmailSut{
+		UserRepo:          new(mrepository.UserRepositoryMock),
+		EmailVerification: new(mservice.EmailVerificationServiceMock),
+		Log:               new(madapters.LoggerMock),
+		Tracer:            new(madapters.TracerMock),
+		Span:              new(madapters.SpanMock),
+		Sc:                new(madapters.SpanContextMock),
+	}
+}
+
+func (s *VerifyEmailSut) Build() *command.VerifyEmail {
+	s.Cmd = command.NewVerifyEmail(s.UserRepo, s.EmailVerification, s.Log, s.Tracer)
+	return s.Cmd
+}
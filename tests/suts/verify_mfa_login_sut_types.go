@@ -0,0 +1,38 @@
+//go:build unit
+
+package suts
+
+import (
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type VerifyMFALoginSut struct {
+	Repo         *mrepository.UserRepositoryMock
+	TokenService *mservice.AuthTokenServiceMock
+	MFAService   *mservice.MFAServiceMock
+	Log          *madapters.LoggerMock
+	Tracer       *madapters.TracerMock
+	Span         *madapters.SpanMock
+	Sc           *madapters.SpanContextMock
+	Cmd          *command.VerifyMFALogin
+}
+
+func MakeVerifyMFALoginSut() *VerifyMFALoginSut {
+	return &VerifyMFALoginSut{
+		Repo:         new(mrepository.UserRepositoryMock),
+		TokenService: new(mservice.AuthTokenServiceMock),
+		MFAService:   new(mservice.MFAServiceMock),
+		Log:          new(madapters.LoggerMock),
+		Tracer:       new(madapters.TracerMock),
+		Span:         new(madapters.SpanMock),
+		Sc:           new(madapters.SpanContextMock),
+	}
+}
+
+func (s *VerifyMFALoginSut) Build() *command.VerifyMFALogin {
+	s.Cmd = command.NewVerifyMFALogin(s.Repo, s.TokenService, s.MFAService, s.Log, s.Tracer)
+	return s.Cmd
+}
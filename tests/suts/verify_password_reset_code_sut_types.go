@@ -0,0 +1,45 @@
+//go:build unit
+
+package suts
+
+import (
+	"time"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/mrepository"
+)
+
+type VerifyPasswordResetCodeSut struct {
+	Uow         *madapters.UnitOfWorkMock
+	UserRepo    *mrepository.UserRepositoryMock
+	TokenRepo   *mrepository.OneTimeTokenRepositoryMock
+	OTPStore    *madapters.OTPStoreMock
+	OpaqueToken *madapters.OpaqueTokenMock
+	ResetTTL    time.Duration
+	Log         *madapters.LoggerMock
+	Tracer      *madapters.TracerMock
+	Span        *madapters.SpanMock
+	Sc          *madapters.SpanContextMock
+	Cmd         *command.VerifyPasswordResetCode
+}
+
+func MakeVerifyPasswordResetCodeSut() *VerifyPasswordResetCodeSut {
+	return &VerifyPasswordResetCodeSut{
+		Uow:         new(madapters.UnitOfWorkMock),
+		UserRepo:    new(mrepository.UserRepositoryMock),
+		TokenRepo:   new(mrepository.OneTimeTokenRepositoryMock),
+		OTPStore:    new(madapters.OTPStoreMock),
+		OpaqueToken: new(madapters.OpaqueTokenMock),
+		ResetTTL:    30 * time.Minute,
+		Log:         new(madapters.LoggerMock),
+		Tracer:      new(madapters.TracerMock),
+		Span:        new(madapters.SpanMock),
+		Sc:          new(madapters.SpanContextMock),
+	}
+}
+
+func (s *VerifyPasswordResetCodeSut) Build() *command.VerifyPasswordResetCode {
+	s.Cmd = command.NewVerifyPasswordResetCode(s.Uow, s.UserRepo, s.TokenRepo, s.OTPStore, s.OpaqueToken, s.ResetTTL, s.Log, s.Tracer)
+	return s.Cmd
+}
@@ -0,0 +1,106 @@
+//go:build unit
+
+package middlewares_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/helpers"
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: INPUT :: HTTP :: MIDDLEWARES :: AUTHENTICATION", func() {
+	var (
+		tracer       *madapters.TracerMock
+		span         *madapters.SpanMock
+		spanCtx      *madapters.SpanContextMock
+		logger       *madapters.LoggerMock
+		tokenService *mservice.AuthTokenServiceMock
+		nextCalled   bool
+		principal    vo.Principal
+	)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+		principal, _ = helpers.Principal(r)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	BeforeEach(func() {
+		tracer = &madapters.TracerMock{}
+		span = &madapters.SpanMock{}
+		spanCtx = &madapters.SpanContextMock{}
+		logger = &madapters.LoggerMock{}
+		tokenService = &mservice.AuthTokenServiceMock{}
+		nextCalled = false
+		principal = vo.Principal{}
+
+		tracer.On("Start", mock.Anything, "Authentication.Authenticate").Return(context.Background(), adapter.Span(span))
+		span.On("End").Return()
+		span.On("SpanContext").Return(adapter.SpanContext(spanCtx))
+		spanCtx.On("TraceID").Return("trace-123")
+		logger.On("WarnJSON", "request not authenticated", mock.Anything, mock.Anything).Return()
+	})
+
+	serve := func(authorization string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/users/me", nil)
+		if authorization != "" {
+			req.Header.Set(helpers.Authorization, authorization)
+		}
+		w := httptest.NewRecorder()
+		middlewares.NewAuthenticationMiddleware(tokenService, logger, tracer).Authenticate()(next).ServeHTTP(w, req)
+		return w
+	}
+
+	It("should inject the principal of a valid bearer token", func() {
+		tokenService.On("VerifyAccessToken", mock.Anything, "access-token").Return(&vo.TokenClaims{
+			ID:        "jti-1",
+			PublicID:  "123e4567-e89b-12d3-a456-426614174000",
+			Role:      "admin",
+			SessionID: "family-1",
+			Scopes:    []string{"users:read"},
+		}, nil)
+
+		w := serve("Bearer access-token")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(nextCalled).To(BeTrue())
+		Expect(principal.PublicID).To(Equal("123e4567-e89b-12d3-a456-426614174000"))
+		Expect(principal.Role).To(Equal("admin"))
+		Expect(principal.TokenID).To(Equal("jti-1"))
+		Expect(principal.HasScope("users:read")).To(BeTrue())
+	})
+
+	It("should reject a request without bearer token", func() {
+		span.On("RecordError", errors.ErrorMissingBearerToken()).Return()
+
+		w := serve("Basic dXNlcjpwYXNz")
+
+		Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		Expect(w.Header().Get(middlewares.WWWAuthenticate)).To(ContainSubstring("Bearer"))
+		Expect(nextCalled).To(BeFalse())
+		Expect(tokenService.AssertNotCalled(GinkgoT(), "VerifyAccessToken", mock.Anything, mock.Anything)).To(BeTrue())
+	})
+
+	It("should reject an expired or revoked token", func() {
+		revokedErr := errors.ErrorRevokedToken("jti-1")
+		tokenService.On("VerifyAccessToken", mock.Anything, "access-token").Return(nil, revokedErr)
+		span.On("RecordError", revokedErr).Return()
+
+		w := serve("Bearer access-token")
+
+		Expect(w.Code).To(Equal(http.StatusUnauthorized))
+		Expect(nextCalled).To(BeFalse())
+	})
+})
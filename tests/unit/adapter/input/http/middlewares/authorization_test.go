@@ -0,0 +1,148 @@
+//go:build unit
+
+package middlewares_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/mocks/app/mservice"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: INPUT :: HTTP :: MIDDLEWARES :: AUTHORIZATION", func() {
+	var (
+		tracer       *madapters.TracerMock
+		span         *madapters.SpanMock
+		spanCtx      *madapters.SpanContextMock
+		logger       *madapters.LoggerMock
+		authzService *mservice.AuthorizationServiceMock
+		nextCalled   bool
+	)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+		w.WriteHeader(http.StatusOK)
+	})
+
+	BeforeEach(func() {
+		tracer = &madapters.TracerMock{}
+		span = &madapters.SpanMock{}
+		spanCtx = &madapters.SpanContextMock{}
+		logger = &madapters.LoggerMock{}
+		authzService = &mservice.AuthorizationServiceMock{}
+		nextCalled = false
+
+		tracer.On("Start", mock.Anything, mock.Anything).Return(context.Background(), adapter.Span(span))
+		span.On("End").Return()
+		span.On("SpanContext").Return(adapter.SpanContext(spanCtx))
+		span.On("RecordError", mock.Anything).Return()
+		spanCtx.On("TraceID").Return("trace-123")
+		logger.On("WarnJSON", "request not authorized", mock.Anything, mock.Anything).Return()
+	})
+
+	serve := func(guard func(http.Handler) http.Handler, principal *vo.Principal) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		if principal != nil {
+			req = req.WithContext(vo.WithPrincipal(req.Context(), *principal))
+		}
+		w := httptest.NewRecorder()
+		guard(next).ServeHTTP(w, req)
+		return w
+	}
+
+	newMiddleware := func() *middlewares.Authorization {
+		return middlewares.NewAuthorizationMiddleware(authzService, logger, tracer)
+	}
+
+	Describe("#RequirePermission", func() {
+		It("should allow a role granted every permission", func() {
+			authzService.On("HasPermission", mock.Anything, entity.RoleSupport, entity.PermissionUsersRead).Return(true, nil)
+
+			w := serve(newMiddleware().RequirePermission(entity.PermissionUsersRead), &vo.Principal{Role: "support"})
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(nextCalled).To(BeTrue())
+		})
+
+		It("should respond forbidden when a permission is missing", func() {
+			authzService.On("HasPermission", mock.Anything, entity.RoleUser, entity.PermissionUsersRead).Return(false, nil)
+
+			w := serve(newMiddleware().RequirePermission(entity.PermissionUsersRead), &vo.Principal{Role: "user"})
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+			Expect(nextCalled).To(BeFalse())
+		})
+
+		It("should respond unauthorized without an authenticated principal", func() {
+			w := serve(newMiddleware().RequirePermission(entity.PermissionUsersRead), nil)
+
+			Expect(w.Code).To(Equal(http.StatusUnauthorized))
+			Expect(authzService.AssertNotCalled(GinkgoT(), "HasPermission", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("should allow a client token whose scopes include the permission", func() {
+			authzService.On("HasPermission", mock.Anything, entity.RoleUser, entity.PermissionProfileRead).Return(true, nil)
+
+			w := serve(newMiddleware().RequirePermission(entity.PermissionProfileRead),
+				&vo.Principal{Role: "user", ClientID: "client-1", Scopes: []string{"profile:read"}})
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(nextCalled).To(BeTrue())
+		})
+
+		It("should respond forbidden to a client token without the scope even if the role allows it", func() {
+			w := serve(newMiddleware().RequirePermission(entity.PermissionProfileWrite),
+				&vo.Principal{Role: "user", ClientID: "client-1", Scopes: []string{"profile:read"}})
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+			Expect(nextCalled).To(BeFalse())
+			Expect(authzService.AssertNotCalled(GinkgoT(), "HasPermission", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("#RequireRole", func() {
+		It("should allow any of the listed roles", func() {
+			w := serve(newMiddleware().RequireRole(entity.RoleAdmin, entity.RoleSupport), &vo.Principal{Role: "support"})
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(nextCalled).To(BeTrue())
+		})
+
+		It("should respond forbidden for other roles", func() {
+			w := serve(newMiddleware().RequireRole(entity.RoleAdmin), &vo.Principal{Role: "user"})
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+			Expect(nextCalled).To(BeFalse())
+		})
+	})
+
+	Describe("#RequireMFA", func() {
+		It("should allow a caller with a confirmed MFA enrollment", func() {
+			authzService.On("HasMFA", mock.Anything, "admin-1").Return(true, nil)
+
+			w := serve(newMiddleware().RequireMFA(), &vo.Principal{PublicID: "admin-1", Role: "admin"})
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(nextCalled).To(BeTrue())
+		})
+
+		It("should respond forbidden to a caller without MFA", func() {
+			authzService.On("HasMFA", mock.Anything, "admin-1").Return(false, nil)
+
+			w := serve(newMiddleware().RequireMFA(), &vo.Principal{PublicID: "admin-1", Role: "admin"})
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+			Expect(nextCalled).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,43 @@
+//go:build unit
+
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: INPUT :: HTTP :: MIDDLEWARES :: CLIENT_IP", func() {
+	resolve := func(trustProxyHeaders bool, forwardedFor string) string {
+		var ip string
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip = vo.ClientIPFromContext(r.Context())
+		})
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+		req.RemoteAddr = "10.0.0.2:51234"
+		if forwardedFor != "" {
+			req.Header.Set("X-Forwarded-For", forwardedFor)
+		}
+		middlewares.NewClientIPMiddleware(trustProxyHeaders).ClientIP()(next).ServeHTTP(httptest.NewRecorder(), req)
+		return ip
+	}
+
+	It("should use the peer address by default", func() {
+		Expect(resolve(false, "198.51.100.1")).To(Equal("10.0.0.2"))
+	})
+
+	It("should use the address appended by a trusted proxy", func() {
+		Expect(resolve(true, "198.51.100.1, 203.0.113.7")).To(Equal("203.0.113.7"))
+	})
+
+	It("should fall back to the peer address when the header is not an address", func() {
+		Expect(resolve(true, "unknown")).To(Equal("10.0.0.2"))
+	})
+})
@@ -0,0 +1,222 @@
+//go:build unit
+
+package middlewares_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/adapter/input/http/middlewares"
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: INPUT :: HTTP :: MIDDLEWARES :: RATE_LIMIT", func() {
+	var (
+		tracer     *madapters.TracerMock
+		span       *madapters.SpanMock
+		spanCtx    *madapters.SpanContextMock
+		logger     *madapters.LoggerMock
+		metrics    *madapters.PrometheusMock
+		limiter    *madapters.RateLimiterMock
+		policies   []vo.RateLimitPolicy
+		nextCalled bool
+		nextBody   string
+	)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nextCalled = true
+		body, _ := io.ReadAll(r.Body)
+		nextBody = string(body)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	BeforeEach(func() {
+		tracer = &madapters.TracerMock{}
+		span = &madapters.SpanMock{}
+		spanCtx = &madapters.SpanContextMock{}
+		logger = &madapters.LoggerMock{}
+		metrics = &madapters.PrometheusMock{}
+		limiter = &madapters.RateLimiterMock{}
+		policies = []vo.RateLimitPolicy{
+			{Name: middlewares.RateLimitPolicyDefault, Limit: 100, Window: time.Minute, Algorithm: vo.RateLimitSlidingWindow},
+			{Name: middlewares.RateLimitPolicyAuth, Limit: 10, Window: time.Minute, Algorithm: vo.RateLimitTokenBucket},
+		}
+		nextCalled = false
+		nextBody = ""
+
+		tracer.On("Start", mock.Anything, "RateLimit.Limit").Return(context.Background(), adapter.Span(span))
+		span.On("End").Return()
+		span.On("SpanContext").Return(adapter.SpanContext(spanCtx))
+		span.On("RecordError", mock.Anything).Return()
+		spanCtx.On("TraceID").Return("trace-123")
+	})
+
+	serve := func(guard func(http.Handler) http.Handler, req *http.Request) *httptest.ResponseRecorder {
+		req = req.WithContext(vo.WithClientIP(req.Context(), "203.0.113.7"))
+		w := httptest.NewRecorder()
+		guard(next).ServeHTTP(w, req)
+		return w
+	}
+
+	newMiddleware := func(enabled bool) *middlewares.RateLimit {
+		return middlewares.NewRateLimitMiddleware(limiter, policies, enabled, metrics, logger, tracer)
+	}
+
+	It("should let the request through with the quota headers", func() {
+		limiter.On("Allow", mock.Anything, "ip:203.0.113.7", policies[0]).Return(vo.RateLimitDecision{
+			Allowed:   true,
+			Limit:     100,
+			Remaining: 99,
+			Reset:     59500 * time.Millisecond,
+		}, nil)
+
+		w := serve(newMiddleware(true).Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			httptest.NewRequest(http.MethodGet, "/users/me", nil))
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(nextCalled).To(BeTrue())
+		Expect(w.Header().Get("RateLimit-Limit")).To(Equal("100"))
+		Expect(w.Header().Get("RateLimit-Remaining")).To(Equal("99"))
+		Expect(w.Header().Get("RateLimit-Reset")).To(Equal("60"))
+		Expect(w.Header().Get("RateLimit-Policy")).To(Equal("100;w=60"))
+		Expect(metrics.AssertNotCalled(GinkgoT(), "CounterRateLimited", mock.Anything, mock.Anything)).To(BeTrue())
+	})
+
+	It("should reject with 429 and Retry-After once the quota is spent", func() {
+		limiter.On("Allow", mock.Anything, "ip:203.0.113.7", policies[0]).Return(vo.RateLimitDecision{
+			Limit:      100,
+			Reset:      30 * time.Second,
+			RetryAfter: 1500 * time.Millisecond,
+		}, nil)
+		metrics.On("CounterRateLimited", "/users/me", middlewares.RateLimitPolicyDefault).Return()
+		logger.On("WarnJSON", "Rate limit exceeded", mock.Anything).Return()
+
+		w := serve(newMiddleware(true).Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			httptest.NewRequest(http.MethodGet, "/users/me", nil))
+
+		Expect(w.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(nextCalled).To(BeFalse())
+		Expect(w.Header().Get("Retry-After")).To(Equal("2"))
+		Expect(w.Header().Get("RateLimit-Remaining")).To(Equal("0"))
+		Expect(w.Body.String()).To(ContainSubstring(string(errors.ErrTooManyRequests)))
+		metrics.AssertExpectations(GinkgoT())
+	})
+
+	It("should count by the hashed e-mail and keep the body for the handler", func() {
+		body := `{"email":" User@Example.com ","password":"secret"}`
+		limiter.On("Allow", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "email:") && !strings.Contains(key, "example")
+		}), policies[1]).Return(vo.RateLimitDecision{Allowed: true, Limit: 10, Remaining: 9}, nil)
+
+		w := serve(newMiddleware(true).Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail),
+			httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(body)))
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(nextBody).To(Equal(body))
+	})
+
+	It("should count the same e-mail under one key whatever its case", func() {
+		var keys []string
+		limiter.On("Allow", mock.Anything, mock.Anything, policies[1]).Run(func(args mock.Arguments) {
+			keys = append(keys, args.String(1))
+		}).Return(vo.RateLimitDecision{Allowed: true, Limit: 10}, nil)
+		guard := newMiddleware(true).Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail)
+
+		serve(guard, httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"email":"user@example.com"}`)))
+		serve(guard, httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`{"email":"USER@example.com"}`)))
+
+		Expect(keys).To(HaveLen(2))
+		Expect(keys[0]).To(Equal(keys[1]))
+	})
+
+	It("should fall back to the address when the body has no e-mail", func() {
+		limiter.On("Allow", mock.Anything, "ip:203.0.113.7", policies[1]).Return(vo.RateLimitDecision{Allowed: true, Limit: 10}, nil)
+
+		w := serve(newMiddleware(true).Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail),
+			httptest.NewRequest(http.MethodPost, "/auth/login", strings.NewReader(`not json`)))
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(nextBody).To(Equal("not json"))
+	})
+
+	It("should limit one address trying many e-mails on a route guarded by both policies", func() {
+		policies[0].Limit = 3
+		metrics.On("CounterRateLimited", "/auth/login", middlewares.RateLimitPolicyDefault).Return()
+		logger.On("WarnJSON", "Rate limit exceeded", mock.Anything).Return()
+		rl := middlewares.NewRateLimitMiddleware(cache.NewMemoryRateLimiter(), policies, true, metrics, logger, tracer)
+		byIP := rl.Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP)
+		byEmail := rl.Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByEmail)
+		guard := func(h http.Handler) http.Handler { return byIP(byEmail(h)) }
+
+		var codes []int
+		for _, email := range []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com"} {
+			body := strings.NewReader(`{"email":"` + email + `","password":"secret"}`)
+			codes = append(codes, serve(guard, httptest.NewRequest(http.MethodPost, "/auth/login", body)).Code)
+		}
+
+		Expect(codes).To(Equal([]int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusTooManyRequests}))
+		metrics.AssertExpectations(GinkgoT())
+	})
+
+	It("should count by the authenticated user", func() {
+		limiter.On("Allow", mock.Anything, "user:user-1", policies[1]).Return(vo.RateLimitDecision{Allowed: true, Limit: 10}, nil)
+		req := httptest.NewRequest(http.MethodPost, "/users/me/password", nil)
+		req = req.WithContext(vo.WithPrincipal(req.Context(), vo.Principal{PublicID: "user-1"}))
+
+		w := serve(newMiddleware(true).Limit(middlewares.RateLimitPolicyAuth, middlewares.KeyByUser), req)
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	It("should count by the OAuth client of the form", func() {
+		limiter.On("Allow", mock.Anything, "client:client-1", policies[0]).Return(vo.RateLimitDecision{Allowed: true, Limit: 100}, nil)
+		req := httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader("grant_type=client_credentials&client_id=client-1"))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		w := serve(newMiddleware(true).Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByClientID), req)
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	It("should use the default policy for an unknown name", func() {
+		limiter.On("Allow", mock.Anything, "ip:203.0.113.7", policies[0]).Return(vo.RateLimitDecision{Allowed: true, Limit: 100}, nil)
+
+		w := serve(newMiddleware(true).Limit("unknown", middlewares.KeyByIP),
+			httptest.NewRequest(http.MethodGet, "/users/me", nil))
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	It("should let the request through when the limiter fails", func() {
+		limiter.On("Allow", mock.Anything, mock.Anything, mock.Anything).Return(vo.RateLimitDecision{}, errors.ErrorRateLimiter(assert.AnError))
+		logger.On("ErrorJSON", "Error applying rate limit", mock.Anything).Return()
+
+		w := serve(newMiddleware(true).Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			httptest.NewRequest(http.MethodGet, "/users/me", nil))
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(nextCalled).To(BeTrue())
+	})
+
+	It("should not limit when disabled", func() {
+		w := serve(newMiddleware(false).Limit(middlewares.RateLimitPolicyDefault, middlewares.KeyByIP),
+			httptest.NewRequest(http.MethodGet, "/users/me", nil))
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Header().Get("RateLimit-Limit")).To(BeEmpty())
+		Expect(limiter.AssertNotCalled(GinkgoT(), "Allow", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+	})
+})
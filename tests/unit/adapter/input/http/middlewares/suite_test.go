@@ -0,0 +1,21 @@
+//go:build unit
+
+package middlewares_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func Test_MiddlewaresSuite(t *testing.T) {
+	suiteConfig, reporterConfig := GinkgoConfiguration()
+
+	suiteConfig.SkipStrings = []string{"SKIPPED", "PENDING", "NEVER-RUN", "SKIP"}
+	reporterConfig.FullTrace = true
+	reporterConfig.Verbose = false
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Middlewares Suite Tests Context", suiteConfig, reporterConfig)
+}
@@ -0,0 +1,143 @@
+//go:build unit
+
+package cache_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: CACHE :: LOGIN_THROTTLE", func() {
+	const ip = "203.0.113.7"
+
+	var (
+		ctx         context.Context
+		store       *madapters.CacheMock
+		throttle    *cache.LoginThrottle
+		accountKey  string
+		addressKey  string
+		window      int
+		lockSeconds int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = new(madapters.CacheMock)
+		throttle = cache.NewLoginThrottle(store, 5, 20, 15*time.Minute, time.Second)
+
+		sum := sha256.Sum256([]byte("user@example.com"))
+		accountKey = "auth:login:" + hex.EncodeToString(sum[:])
+		addressKey = accountKey + ":ip:" + ip
+		window = int((15 * time.Minute).Seconds())
+		lockSeconds = window
+	})
+
+	Describe("#RecordFailure", func() {
+		It("should not delay the first failure", func() {
+			store.On("Increment", ctx, accountKey+":failures", window).Return(int64(1), nil)
+			store.On("Increment", ctx, addressKey+":failures", window).Return(int64(1), nil)
+
+			wait, err := throttle.RecordFailure(ctx, " User@Example.com ", ip)
+
+			Expect(err).To(BeNil())
+			Expect(wait).To(BeZero())
+			store.AssertNotCalled(GinkgoT(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("should double the delay on every further failure from the address", func() {
+			store.On("Increment", ctx, accountKey+":failures", window).Return(int64(4), nil)
+			store.On("Increment", ctx, addressKey+":failures", window).Return(int64(4), nil)
+			store.On("Set", ctx, addressKey+":locked", mock.Anything, 4).Return(nil)
+
+			wait, err := throttle.RecordFailure(ctx, "user@example.com", ip)
+
+			Expect(err).To(BeNil())
+			Expect(wait).To(Equal(4 * time.Second))
+		})
+
+		It("should lock the account for the address at the threshold", func() {
+			store.On("Increment", ctx, accountKey+":failures", window).Return(int64(5), nil)
+			store.On("Increment", ctx, addressKey+":failures", window).Return(int64(5), nil)
+			store.On("Set", ctx, addressKey+":locked", mock.Anything, lockSeconds).Return(nil)
+
+			wait, err := throttle.RecordFailure(ctx, "user@example.com", ip)
+
+			Expect(err).To(BeNil())
+			Expect(wait).To(Equal(15 * time.Minute))
+		})
+
+		It("should lock the account everywhere once failures from every address reach the account threshold", func() {
+			store.On("Increment", ctx, accountKey+":failures", window).Return(int64(20), nil)
+			store.On("Increment", ctx, addressKey+":failures", window).Return(int64(1), nil)
+			store.On("Set", ctx, accountKey+":locked", mock.Anything, lockSeconds).Return(nil)
+
+			wait, err := throttle.RecordFailure(ctx, "user@example.com", ip)
+
+			Expect(err).To(BeNil())
+			Expect(wait).To(Equal(15 * time.Minute))
+			store.AssertNotCalled(GinkgoT(), "Set", ctx, addressKey+":locked", mock.Anything, mock.Anything)
+		})
+	})
+
+	Describe("#Check", func() {
+		It("should report the longest remaining lock", func() {
+			lockedUntil := func(wait time.Duration) func(mock.Arguments) {
+				return func(args mock.Arguments) {
+					lock := fmt.Sprintf(`{"until":%d}`, time.Now().Add(wait).Unix())
+					Expect(json.Unmarshal([]byte(lock), args.Get(2))).To(Succeed())
+				}
+			}
+			store.On("Get", ctx, accountKey+":locked", mock.Anything).Return(true, nil).Run(lockedUntil(time.Minute))
+			store.On("Get", ctx, addressKey+":locked", mock.Anything).Return(true, nil).Run(lockedUntil(10 * time.Minute))
+
+			wait, err := throttle.Check(ctx, "user@example.com", ip)
+
+			Expect(err).To(BeNil())
+			Expect(wait).To(BeNumerically("~", 10*time.Minute, 2*time.Second))
+		})
+
+		It("should allow an attempt when nothing is locked", func() {
+			store.On("Get", ctx, accountKey+":locked", mock.Anything).Return(false, nil)
+			store.On("Get", ctx, addressKey+":locked", mock.Anything).Return(false, nil)
+
+			wait, err := throttle.Check(ctx, "user@example.com", ip)
+
+			Expect(err).To(BeNil())
+			Expect(wait).To(BeZero())
+		})
+	})
+
+	Describe("#RecordSuccess", func() {
+		It("should forget the failures of the account and the address", func() {
+			store.On("Delete", ctx, mock.Anything).Return(nil)
+
+			err := throttle.RecordSuccess(ctx, "user@example.com", ip)
+
+			Expect(err).To(BeNil())
+			store.AssertCalled(GinkgoT(), "Delete", ctx, accountKey+":failures")
+			store.AssertCalled(GinkgoT(), "Delete", ctx, addressKey+":failures")
+			store.AssertCalled(GinkgoT(), "Delete", ctx, addressKey+":locked")
+		})
+	})
+
+	Describe("#Unlock", func() {
+		It("should drop every key of the account, from every address", func() {
+			store.On("DeleteByPrefix", ctx, accountKey+":").Return(nil)
+
+			err := throttle.Unlock(ctx, "user@example.com")
+
+			Expect(err).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,64 @@
+//go:build unit
+
+package cache_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: CACHE :: MEMORY_RATE_LIMITER", func() {
+	var (
+		ctx     context.Context
+		limiter *cache.MemoryRateLimiter
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		limiter = cache.NewMemoryRateLimiter()
+	})
+
+	for _, algorithm := range []vo.RateLimitAlgorithm{vo.RateLimitSlidingWindow, vo.RateLimitTokenBucket} {
+		Context(string(algorithm), func() {
+			policy := vo.RateLimitPolicy{Name: "default", Limit: 3, Window: time.Minute, Algorithm: algorithm}
+
+			It("should allow up to the limit and reject the next request", func() {
+				for remaining := 2; remaining >= 0; remaining-- {
+					decision, err := limiter.Allow(ctx, "ip:203.0.113.7", policy)
+					Expect(err).To(BeNil())
+					Expect(decision.Allowed).To(BeTrue())
+					Expect(decision.Limit).To(Equal(3))
+					Expect(decision.Remaining).To(Equal(remaining))
+				}
+
+				decision, err := limiter.Allow(ctx, "ip:203.0.113.7", policy)
+
+				Expect(err).To(BeNil())
+				Expect(decision.Allowed).To(BeFalse())
+				Expect(decision.Remaining).To(Equal(0))
+				Expect(decision.RetryAfter).To(BeNumerically(">", 0))
+				Expect(decision.RetryAfter).To(BeNumerically("<=", time.Minute))
+			})
+
+			It("should count each key and policy on its own", func() {
+				for range 3 {
+					_, _ = limiter.Allow(ctx, "ip:203.0.113.7", policy)
+				}
+
+				other, _ := limiter.Allow(ctx, "ip:198.51.100.1", policy)
+				auth := policy
+				auth.Name = "auth"
+				sameKey, _ := limiter.Allow(ctx, "ip:203.0.113.7", auth)
+
+				Expect(other.Allowed).To(BeTrue())
+				Expect(sameKey.Allowed).To(BeTrue())
+			})
+		})
+	}
+})
@@ -0,0 +1,79 @@
+//go:build unit
+
+package cache_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: CACHE :: OTP_STORE", func() {
+	const (
+		codeKey     = "auth:otp:password_reset:+5511999999999"
+		attemptsKey = codeKey + ":attempts"
+	)
+
+	var (
+		ctx      context.Context
+		store    *madapters.CacheMock
+		otpStore *cache.OTPStore
+		codeHash string
+		window   int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = new(madapters.CacheMock)
+		otpStore = cache.NewOTPStore(store, 10*time.Minute, 5)
+
+		sum := sha256.Sum256([]byte("123456"))
+		codeHash = hex.EncodeToString(sum[:])
+		window = int((10 * time.Minute).Seconds())
+	})
+
+	Describe("#Verify", func() {
+		It("should redeem a matching code and clear the attempts", func() {
+			store.On("Increment", ctx, attemptsKey, window).Return(int64(1), nil)
+			store.On("DeleteIfEqual", ctx, codeKey, codeHash).Return(true, nil)
+			store.On("Delete", ctx, attemptsKey).Return(nil)
+
+			ok, err := otpStore.Verify(ctx, "password_reset:+5511999999999", "123456")
+
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeTrue())
+			store.AssertNotCalled(GinkgoT(), "Get", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("should refuse a code that was already redeemed or does not match", func() {
+			store.On("Increment", ctx, attemptsKey, window).Return(int64(2), nil)
+			store.On("DeleteIfEqual", ctx, codeKey, codeHash).Return(false, nil)
+
+			ok, err := otpStore.Verify(ctx, "password_reset:+5511999999999", "123456")
+
+			Expect(err).To(BeNil())
+			Expect(ok).To(BeFalse())
+			store.AssertNotCalled(GinkgoT(), "Delete", mock.Anything, mock.Anything)
+		})
+
+		It("should burn the code once the attempts are exhausted", func() {
+			store.On("Increment", ctx, attemptsKey, window).Return(int64(6), nil)
+			store.On("Delete", ctx, codeKey).Return(nil)
+
+			ok, err := otpStore.Verify(ctx, "password_reset:+5511999999999", "123456")
+
+			Expect(ok).To(BeFalse())
+			Expect(err.Code).To(Equal(errors.ErrTooManyRequests))
+			store.AssertNotCalled(GinkgoT(), "DeleteIfEqual", mock.Anything, mock.Anything, mock.Anything)
+		})
+	})
+})
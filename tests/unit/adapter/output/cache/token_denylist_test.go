@@ -0,0 +1,95 @@
+//go:build unit
+
+package cache_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/cache"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/mocks/infra/madapters"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: CACHE :: TOKEN_DENYLIST", func() {
+	const publicID = "123e4567-e89b-12d3-a456-426614174000"
+
+	var (
+		ctx      context.Context
+		store    *madapters.CacheMock
+		denylist *cache.TokenDenylist
+		revoked  time.Time
+		stored   string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = new(madapters.CacheMock)
+		denylist = cache.NewTokenDenylist(store, 15*time.Minute)
+		revoked = time.UnixMilli(1_760_000_000_500)
+		stored = fmt.Sprintf(`{"revoked_at_ms":%d}`, revoked.UnixMilli())
+
+		store.On("Get", ctx, mock.MatchedBy(func(key string) bool {
+			return key != "auth:revoked:user:"+publicID
+		}), mock.Anything).Return(false, nil)
+		store.On("Get", ctx, "auth:revoked:user:"+publicID, mock.Anything).
+			Run(func(args mock.Arguments) {
+				Expect(json.Unmarshal([]byte(stored), args.Get(2))).To(Succeed())
+			}).Return(true, nil)
+		store.On("Set", ctx, "auth:revoked:user:"+publicID, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				value, err := json.Marshal(args.Get(2))
+				Expect(err).To(BeNil())
+				stored = string(value)
+			}).Return(nil)
+	})
+
+	Describe("#IsRevoked", func() {
+		DescribeTable("should compare the issue time to the millisecond",
+			func(issuedAt time.Time, expected bool) {
+				isRevoked, err := denylist.IsRevoked(ctx, vo.TokenClaims{
+					ID:        "token-1",
+					PublicID:  publicID,
+					SessionID: "session-1",
+					IssuedAt:  issuedAt,
+				})
+
+				Expect(err).To(BeNil())
+				Expect(isRevoked).To(Equal(expected))
+			},
+			Entry("issued earlier in the second of the revocation", time.UnixMilli(1_760_000_000_200), true),
+			Entry("issued at the revocation", time.UnixMilli(1_760_000_000_500), true),
+			Entry("issued later in the second of the revocation", time.UnixMilli(1_760_000_000_800), false),
+		)
+	})
+
+	Describe("#RevokeUserTokens", func() {
+		It("should keep an earlier cutoff for the spared session", func() {
+			claims := func(sessionID string, issuedAt time.Time) vo.TokenClaims {
+				return vo.TokenClaims{ID: "token-1", PublicID: publicID, SessionID: sessionID, IssuedAt: issuedAt}
+			}
+			beforeRevokeAll := time.UnixMilli(1_760_000_000_200)
+
+			err := denylist.RevokeUserTokens(ctx, publicID, "session-2")
+			Expect(err).To(BeNil())
+
+			spared, err := denylist.IsRevoked(ctx, claims("session-2", beforeRevokeAll))
+			Expect(err).To(BeNil())
+			Expect(spared).To(BeTrue())
+
+			sparedAfter, err := denylist.IsRevoked(ctx, claims("session-2", revoked.Add(time.Millisecond)))
+			Expect(err).To(BeNil())
+			Expect(sparedAfter).To(BeFalse())
+
+			other, err := denylist.IsRevoked(ctx, claims("session-1", revoked.Add(time.Millisecond)))
+			Expect(err).To(BeNil())
+			Expect(other).To(BeTrue())
+		})
+	})
+})
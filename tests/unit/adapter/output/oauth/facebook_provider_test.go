@@ -0,0 +1,61 @@
+//go:build unit
+
+package oauth_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/oauth"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: OAUTH :: FACEBOOK_PROVIDER", func() {
+	var (
+		ctx      context.Context
+		provider *oauth.FacebookProvider
+		login    vo.OAuthLoginState
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("code") != "auth-code" {
+				http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+				return
+			}
+			writeJSON(w, map[string]string{"access_token": "access"})
+		})
+		mux.HandleFunc("GET /me", func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(w, map[string]string{"id": "subject-1", "name": "Test User", "email": "user@example.com"})
+		})
+		server := httptest.NewServer(mux)
+		DeferCleanup(server.Close)
+
+		provider = oauth.NewFacebookProvider("facebook", server.URL+"/dialog", server.URL, "client-id", "client-secret")
+		login = vo.OAuthLoginState{
+			Provider:     "facebook",
+			State:        "state",
+			CodeVerifier: "verifier",
+			RedirectURI:  "http://localhost:8080/auth/oauth/facebook/callback",
+		}
+	})
+
+	It("should never report the profile e-mail as verified", func() {
+		identity, err := provider.Exchange(ctx, "auth-code", login)
+
+		Expect(err).To(BeNil())
+		Expect(identity).To(Equal(&vo.ExternalIdentity{
+			Provider:      "facebook",
+			Subject:       "subject-1",
+			Email:         "user@example.com",
+			EmailVerified: false,
+			Name:          "Test User",
+		}))
+	})
+})
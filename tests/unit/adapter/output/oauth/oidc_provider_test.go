@@ -0,0 +1,179 @@
+//go:build unit
+
+package oauth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/oauth"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// fakeOIDCProvider is a minimal OpenID Connect issuer: discovery, JWKS and a
+// token endpoint that checks the PKCE verifier and issues an RS256 id_token.
+type fakeOIDCProvider struct {
+	server  *httptest.Server
+	key     *rsa.PrivateKey
+	kid     string
+	claims  jwt.MapClaims
+	issuer  string
+	lastJWT string
+}
+
+func newFakeOIDCProvider() *fakeOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+
+	fake := &fakeOIDCProvider{key: key, kid: "fake-key"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                 fake.issuer,
+			"authorization_endpoint": fake.server.URL + "/authorize",
+			"token_endpoint":         fake.server.URL + "/token",
+			"jwks_uri":               fake.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, vo.JSONWebKeySet{Keys: []vo.JSONWebKey{{
+			KeyType:   "RSA",
+			KeyID:     fake.kid,
+			Algorithm: "RS256",
+			Use:       "sig",
+			N:         base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:         base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		if r.PostFormValue("code") != "auth-code" || r.PostFormValue("client_secret") != "client-secret" ||
+			vo.PKCEChallenge(r.PostFormValue("code_verifier")) != vo.PKCEChallenge("verifier") {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, fake.claims)
+		token.Header["kid"] = fake.kid
+		signed, err := token.SignedString(fake.key)
+		Expect(err).ToNot(HaveOccurred())
+		writeJSON(w, map[string]string{"access_token": "access", "id_token": signed, "token_type": "Bearer"})
+	})
+
+	fake.server = httptest.NewServer(mux)
+	fake.issuer = fake.server.URL
+	fake.claims = jwt.MapClaims{
+		"iss":            fake.server.URL,
+		"aud":            "client-id",
+		"sub":            "subject-1",
+		"email":          "user@example.com",
+		"email_verified": "true",
+		"name":           "Test User",
+		"nonce":          "nonce",
+		"exp":            time.Now().Add(time.Minute).Unix(),
+	}
+	return fake
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: OAUTH :: OIDC_PROVIDER", func() {
+	var (
+		ctx      context.Context
+		fake     *fakeOIDCProvider
+		provider *oauth.OIDCProvider
+		login    vo.OAuthLoginState
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fake = newFakeOIDCProvider()
+		DeferCleanup(fake.server.Close)
+		provider = oauth.NewOIDCProvider("google", fake.server.URL, "client-id", "client-secret")
+		login = vo.OAuthLoginState{
+			Provider:     "google",
+			State:        "state",
+			CodeVerifier: "verifier",
+			Nonce:        "nonce",
+			RedirectURI:  "http://localhost:8080/auth/oauth/google/callback",
+		}
+	})
+
+	It("should build the authorization URL with state, nonce and a S256 challenge", func() {
+		authorizationURL, err := provider.AuthorizationURL(ctx, login)
+		Expect(err).To(BeNil())
+
+		parsed, parseErr := url.Parse(authorizationURL)
+		Expect(parseErr).ToNot(HaveOccurred())
+		Expect(parsed.Path).To(Equal("/authorize"))
+		query := parsed.Query()
+		Expect(query.Get("client_id")).To(Equal("client-id"))
+		Expect(query.Get("redirect_uri")).To(Equal(login.RedirectURI))
+		Expect(query.Get("state")).To(Equal("state"))
+		Expect(query.Get("nonce")).To(Equal("nonce"))
+		Expect(query.Get("code_challenge")).To(Equal(vo.PKCEChallenge("verifier")))
+		Expect(query.Get("code_challenge_method")).To(Equal(vo.PKCEMethodS256))
+	})
+
+	It("should exchange the code and read the identity from the id_token", func() {
+		identity, err := provider.Exchange(ctx, "auth-code", login)
+
+		Expect(err).To(BeNil())
+		Expect(identity).To(Equal(&vo.ExternalIdentity{
+			Provider:      "google",
+			Subject:       "subject-1",
+			Email:         "user@example.com",
+			EmailVerified: true,
+			Name:          "Test User",
+		}))
+	})
+
+	It("should reject an id_token issued for another login", func() {
+		fake.claims["nonce"] = "another-nonce"
+
+		identity, err := provider.Exchange(ctx, "auth-code", login)
+
+		Expect(identity).To(BeNil())
+		Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+	})
+
+	It("should reject an id_token issued for another client", func() {
+		fake.claims["aud"] = "another-client"
+
+		identity, err := provider.Exchange(ctx, "auth-code", login)
+
+		Expect(identity).To(BeNil())
+		Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+	})
+
+	It("should fail the exchange when the code verifier does not match", func() {
+		login.CodeVerifier = "another-verifier"
+
+		identity, err := provider.Exchange(ctx, "auth-code", login)
+
+		Expect(identity).To(BeNil())
+		Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+	})
+
+	It("should refuse a discovery document of another issuer", func() {
+		fake.issuer = "https://evil.example.com"
+
+		_, err := provider.AuthorizationURL(ctx, login)
+
+		Expect(err.Code).To(Equal(errors.ErrInternal))
+	})
+})
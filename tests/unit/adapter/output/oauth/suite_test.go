@@ -0,0 +1,21 @@
+//go:build unit
+
+package oauth_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func Test_OAuthSuite(t *testing.T) {
+	suiteConfig, reporterConfig := GinkgoConfiguration()
+
+	suiteConfig.SkipStrings = []string{"SKIPPED", "PENDING", "NEVER-RUN", "SKIP"}
+	reporterConfig.FullTrace = true
+	reporterConfig.Verbose = false
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OAuth Suite Tests Context", suiteConfig, reporterConfig)
+}
@@ -0,0 +1,69 @@
+//go:build unit
+
+package security_test
+
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: SECURITY :: ID_TOKEN_SIGNER", func() {
+	It("should sign an id_token verifiable with the published keys", func() {
+		keyring, err := security.NewGeneratedKeyring(security.AlgorithmES256)
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewIDTokenSigner(keyring, "https://auth.example.com", time.Minute)
+		authTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+		verified := true
+
+		token, signErr := signer.Sign(vo.IDTokenClaims{
+			UserInfo: vo.UserInfo{
+				Subject:       "123e4567-e89b-12d3-a456-426614174000",
+				Email:         "user@example.com",
+				EmailVerified: &verified,
+			},
+			ClientID: "client-1",
+			Nonce:    "n-0S6_WzA2Mj",
+			AuthTime: &authTime,
+		})
+		Expect(signErr).To(BeNil())
+
+		// relying parties verify it with the published key set
+		claims := jwt.MapClaims{}
+		_, parseErr := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+			Expect(t.Header["kid"]).To(Equal(keyring.JWKS().Keys[0].KeyID))
+			return security.PublicKeyFromJWK(keyring.JWKS().Keys[0])
+		})
+		Expect(parseErr).ToNot(HaveOccurred())
+		Expect(claims["iss"]).To(Equal("https://auth.example.com"))
+		Expect(claims["sub"]).To(Equal("123e4567-e89b-12d3-a456-426614174000"))
+		Expect(claims["aud"]).To(ConsistOf("client-1"))
+		Expect(claims["nonce"]).To(Equal("n-0S6_WzA2Mj"))
+		Expect(claims["auth_time"]).To(BeNumerically("==", authTime.Unix()))
+		Expect(claims["email"]).To(Equal("user@example.com"))
+		Expect(claims["email_verified"]).To(BeTrue())
+		Expect(claims).ToNot(HaveKey("name"))
+	})
+
+	It("should not be accepted as an access token", func() {
+		keyring, err := security.NewGeneratedKeyring(security.AlgorithmES256)
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewIDTokenSigner(keyring, "https://auth.example.com", time.Minute)
+
+		token, signErr := signer.Sign(vo.IDTokenClaims{
+			UserInfo: vo.UserInfo{Subject: "123e4567-e89b-12d3-a456-426614174000"},
+			ClientID: "client-1",
+		})
+		Expect(signErr).To(BeNil())
+
+		claims, valErr := security.NewJWT(keyring, time.Minute).Validate(token)
+		Expect(claims).To(BeNil())
+		Expect(valErr.Code).To(Equal(errors.ErrUnauthorized))
+	})
+})
@@ -0,0 +1,187 @@
+//go:build unit
+
+package security_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: SECURITY :: JWT", func() {
+	claims := vo.TokenClaims{
+		PublicID:  "123e4567-e89b-12d3-a456-426614174000",
+		Role:      "user",
+		Email:     "user@example.com",
+		SessionID: "family-1",
+	}
+
+	DescribeTable("should sign and verify tokens with asymmetric keys",
+		func(algorithm, keyType string) {
+			keyring, err := security.NewGeneratedKeyring(algorithm)
+			Expect(err).ToNot(HaveOccurred())
+			signer := security.NewJWT(keyring, time.Minute)
+
+			issued, genErr := signer.Generate(claims)
+			Expect(genErr).To(BeNil())
+
+			validated, valErr := signer.Validate(issued.Token)
+			Expect(valErr).To(BeNil())
+			Expect(validated.PublicID).To(Equal(claims.PublicID))
+			Expect(validated.SessionID).To(Equal(claims.SessionID))
+
+			jwks := signer.JWKS()
+			Expect(jwks.Keys).To(HaveLen(1))
+			Expect(jwks.Keys[0].Algorithm).To(Equal(algorithm))
+			Expect(jwks.Keys[0].KeyType).To(Equal(keyType))
+		},
+		Entry("RS256", security.AlgorithmRS256, "RSA"),
+		Entry("ES256", security.AlgorithmES256, "EC"),
+		Entry("EdDSA", security.AlgorithmEdDSA, "OKP"),
+	)
+
+	It("should carry the client, scopes and audiences of service tokens", func() {
+		keyring, err := security.NewGeneratedKeyring(security.AlgorithmES256)
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewJWT(keyring, time.Minute)
+
+		issued, genErr := signer.Generate(vo.TokenClaims{
+			PublicID: "client-1",
+			ClientID: "client-1",
+			Scopes:   []string{"payments:charge", "payments:refund"},
+			Audience: []string{"payments"},
+		})
+		Expect(genErr).To(BeNil())
+
+		validated, valErr := signer.Validate(issued.Token)
+		Expect(valErr).To(BeNil())
+		Expect(validated.PublicID).To(Equal("client-1"))
+		Expect(validated.ClientID).To(Equal("client-1"))
+		Expect(validated.Scopes).To(Equal([]string{"payments:charge", "payments:refund"}))
+		Expect(validated.Audience).To(Equal([]string{"payments"}))
+	})
+
+	It("should keep the issue time to the millisecond", func() {
+		keyring, err := security.NewGeneratedKeyring(security.AlgorithmES256)
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewJWT(keyring, time.Minute)
+
+		issued, genErr := signer.Generate(claims)
+		Expect(genErr).To(BeNil())
+
+		validated, valErr := signer.Validate(issued.Token)
+		Expect(valErr).To(BeNil())
+		Expect(validated.IssuedAt.UnixMilli()).To(Equal(issued.IssuedAt.UnixMilli()))
+
+		parsed, _, _ := jwt.NewParser().ParseUnverified(issued.Token, jwt.MapClaims{})
+		iat, _ := parsed.Claims.(jwt.MapClaims)["iat"].(float64)
+		Expect(int64(iat * 1000)).To(BeNumerically("~", issued.IssuedAt.UnixMilli(), 1))
+	})
+
+	It("should keep verifying tokens signed by a rotated key during its grace period", func() {
+		keyring, err := security.NewGeneratedKeyring(security.AlgorithmES256)
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewJWT(keyring, time.Minute)
+
+		old, _ := signer.Generate(claims)
+		_, err = keyring.Rotate(time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		current, _ := signer.Generate(claims)
+
+		_, oldErr := signer.Validate(old.Token)
+		_, currentErr := signer.Validate(current.Token)
+		Expect(oldErr).To(BeNil())
+		Expect(currentErr).To(BeNil())
+		Expect(signer.JWKS().Keys).To(HaveLen(2))
+	})
+
+	It("should reject tokens signed by a key whose grace period has ended", func() {
+		keyring, err := security.NewGeneratedKeyring(security.AlgorithmES256)
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewJWT(keyring, time.Minute)
+
+		old, _ := signer.Generate(claims)
+		_, err = keyring.Rotate(0)
+		Expect(err).ToNot(HaveOccurred())
+		time.Sleep(time.Millisecond)
+
+		_, valErr := signer.Validate(old.Token)
+		Expect(valErr).ToNot(BeNil())
+		Expect(valErr.Code).To(Equal(errors.ErrUnauthorized))
+		Expect(signer.JWKS().Keys).To(HaveLen(1))
+	})
+
+	It("should reject an HMAC token forged with the published key material", func() {
+		keyring, err := security.NewGeneratedKeyring(security.AlgorithmRS256)
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewJWT(keyring, time.Minute)
+		kid := signer.JWKS().Keys[0].KeyID
+
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub": claims.PublicID,
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(time.Minute).Unix(),
+		})
+		forged.Header["kid"] = kid
+		forged.Header["typ"] = "at+jwt"
+		token, _ := forged.SignedString([]byte(signer.JWKS().Keys[0].N))
+
+		_, valErr := signer.Validate(token)
+		Expect(valErr).ToNot(BeNil())
+	})
+
+	It("should load PEM keys named by kid and keep public-only keys for verification", func() {
+		dir := GinkgoT().TempDir()
+		writeKey := func(name, blockType string, der []byte) {
+			data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+			Expect(os.WriteFile(filepath.Join(dir, name), data, 0o600)).To(Succeed())
+		}
+
+		retired, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		retiredPublic, _ := x509.MarshalPKIXPublicKey(retired.Public())
+		writeKey("2026-01.pem", "PUBLIC KEY", retiredPublic)
+
+		current, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		currentPrivate, _ := x509.MarshalPKCS8PrivateKey(current)
+		writeKey("2026-02.pem", "PRIVATE KEY", currentPrivate)
+
+		keyring, err := security.NewPEMKeyring(security.AlgorithmES256, dir, "")
+		Expect(err).ToNot(HaveOccurred())
+		signer := security.NewJWT(keyring, time.Minute)
+
+		issued, _ := signer.Generate(claims)
+		parsed, _, _ := jwt.NewParser().ParseUnverified(issued.Token, jwt.MapClaims{})
+		Expect(parsed.Header["kid"]).To(Equal("2026-02"))
+
+		legacy := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+			"sub": claims.PublicID,
+			"iat": time.Now().Unix(),
+			"exp": time.Now().Add(time.Minute).Unix(),
+		})
+		legacy.Header["kid"] = "2026-01"
+		legacy.Header["typ"] = "at+jwt"
+		legacyToken, _ := legacy.SignedString(retired)
+		_, valErr := signer.Validate(legacyToken)
+		Expect(valErr).To(BeNil())
+
+		Expect(signer.JWKS().Keys).To(HaveLen(2))
+	})
+
+	It("should refuse a PEM directory without a private key for signing", func() {
+		_, err := security.NewPEMKeyring(security.AlgorithmES256, GinkgoT().TempDir(), "")
+		Expect(err).To(HaveOccurred())
+	})
+})
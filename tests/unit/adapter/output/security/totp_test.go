@@ -0,0 +1,90 @@
+//go:build unit
+
+package security_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+)
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: SECURITY :: TOTP", func() {
+	// base32 of the RFC 6238 test key "12345678901234567890"
+	const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	Describe("#Match", func() {
+		It("should accept the codes of the RFC 6238 test vectors", func() {
+			totp := security.NewTOTP("auth-ms", 0)
+
+			step, ok := totp.Match(rfcSecret, "287082", time.Unix(59, 0))
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(int64(1)))
+
+			step, ok = totp.Match(rfcSecret, "081804", time.Unix(1111111109, 0))
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(int64(37037036)))
+		})
+
+		It("should accept a code of the adjacent step within the skew", func() {
+			totp := security.NewTOTP("auth-ms", 1)
+
+			step, ok := totp.Match(rfcSecret, "287082", time.Unix(59+30, 0))
+
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(int64(1)))
+		})
+
+		It("should reject a code outside the skew", func() {
+			totp := security.NewTOTP("auth-ms", 1)
+
+			_, ok := totp.Match(rfcSecret, "287082", time.Unix(59+90, 0))
+
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should reject malformed codes", func() {
+			totp := security.NewTOTP("auth-ms", 1)
+
+			_, ok := totp.Match(rfcSecret, "28708", time.Unix(59, 0))
+
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("#GenerateSecret", func() {
+		It("should generate a secret and the URI to provision it", func() {
+			totp := security.NewTOTP("auth-ms", 0)
+
+			secret, err := totp.GenerateSecret()
+
+			Expect(err).To(BeNil())
+			Expect(secret).To(HaveLen(32))
+			uri := totp.ProvisioningURI(secret, "user@example.com")
+			Expect(uri).To(HavePrefix("otpauth://totp/auth-ms:user@example.com?"))
+			Expect(uri).To(ContainSubstring("secret=" + secret))
+			Expect(uri).To(ContainSubstring("issuer=auth-ms"))
+		})
+	})
+
+	Describe("#GenerateRecoveryCodes", func() {
+		It("should generate distinct codes", func() {
+			totp := security.NewTOTP("auth-ms", 0)
+
+			codes, err := totp.GenerateRecoveryCodes(10)
+
+			Expect(err).To(BeNil())
+			Expect(codes).To(HaveLen(10))
+			seen := map[string]bool{}
+			for _, code := range codes {
+				Expect(code).To(MatchRegexp(`^[a-z2-9]{5}-[a-z2-9]{5}$`))
+				Expect(strings.ContainsAny(code, "01ilo")).To(BeFalse())
+				seen[code] = true
+			}
+			Expect(seen).To(HaveLen(10))
+		})
+	})
+})
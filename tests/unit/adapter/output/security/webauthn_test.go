@@ -0,0 +1,264 @@
+//go:build unit
+
+package security_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/adapter/output/security"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+)
+
+// cborMap keeps the order of its entries, which is all the encoder below
+// needs to build authenticator responses.
+type cborMap [][2]any
+
+func encodeCBOR(value any) []byte {
+	head := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n <= 0xff:
+			return []byte{major<<5 | 24, byte(n)}
+		default:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+		}
+	}
+	switch v := value.(type) {
+	case int:
+		if v < 0 {
+			return head(1, uint64(-1-v))
+		}
+		return head(0, uint64(v))
+	case []byte:
+		return append(head(2, uint64(len(v))), v...)
+	case string:
+		return append(head(3, uint64(len(v))), v...)
+	case cborMap:
+		out := head(5, uint64(len(v)))
+		for _, entry := range v {
+			out = append(out, encodeCBOR(entry[0])...)
+			out = append(out, encodeCBOR(entry[1])...)
+		}
+		return out
+	}
+	panic("unsupported CBOR value")
+}
+
+var _ = Describe("INTERNAL :: ADAPTER :: OUTPUT :: SECURITY :: WEBAUTHN", func() {
+	const (
+		rpID      = "example.com"
+		origin    = "https://app.example.com"
+		challenge = "c2lnbi1pbi1jaGFsbGVuZ2U"
+	)
+
+	var (
+		webAuthn     *security.WebAuthn
+		key          *ecdsa.PrivateKey
+		coseKey      []byte
+		credentialID []byte
+	)
+
+	clientData := func(ceremony, challenge, origin string) []byte {
+		data, _ := json.Marshal(map[string]any{"type": ceremony, "challenge": challenge, "origin": origin})
+		return data
+	}
+
+	authData := func(rpID string, flags byte, signCount uint32, attested []byte) []byte {
+		rpIDHash := sha256.Sum256([]byte(rpID))
+		data := append(rpIDHash[:], flags)
+		data = binary.BigEndian.AppendUint32(data, signCount)
+		return append(data, attested...)
+	}
+
+	attestationObject := func(authData []byte) []byte {
+		return encodeCBOR(cborMap{{"fmt", "none"}, {"attStmt", cborMap{}}, {"authData", authData}})
+	}
+
+	attestedCredential := func(aaguid, credentialID, coseKey []byte) []byte {
+		data := append([]byte{}, aaguid...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(credentialID)))
+		data = append(data, credentialID...)
+		return append(data, coseKey...)
+	}
+
+	signES256 := func(authData, clientData []byte) []byte {
+		clientDataHash := sha256.Sum256(clientData)
+		digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+		signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+		Expect(err).ToNot(HaveOccurred())
+		return signature
+	}
+
+	BeforeEach(func() {
+		var err error
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		point, err := key.PublicKey.ECDH()
+		Expect(err).ToNot(HaveOccurred())
+		raw := point.Bytes()
+		coseKey = encodeCBOR(cborMap{{1, 2}, {3, -7}, {-1, 1}, {-2, raw[1:33]}, {-3, raw[33:]}})
+		credentialID = []byte("credential-0001")
+		webAuthn = security.NewWebAuthn(rpID, []string{origin})
+	})
+
+	Describe("#Challenge", func() {
+		It("should read the challenge answered by a response", func() {
+			read, err := webAuthn.Challenge(clientData("webauthn.get", challenge, origin))
+
+			Expect(err).To(BeNil())
+			Expect(read).To(Equal(challenge))
+		})
+
+		It("should reject client data without a challenge", func() {
+			_, err := webAuthn.Challenge([]byte(`{"type":"webauthn.get"}`))
+
+			Expect(err).To(Equal(errors.ErrorInvalidPasskeyChallenge()))
+		})
+	})
+
+	Describe("#VerifyRegistration", func() {
+		aaguid := []byte{0xad, 0xce, 0x00, 0x02, 0x35, 0xbc, 0xc6, 0x0a, 0x64, 0x8b, 0x0b, 0x25, 0xf1, 0xf0, 0x55, 0x03}
+
+		register := func(rpID string, flags byte, clientData []byte) (*vo.WebAuthnCredentialData, *errors.Error) {
+			data := authData(rpID, flags, 0, attestedCredential(aaguid, credentialID, coseKey))
+			return webAuthn.VerifyRegistration(challenge, vo.WebAuthnAttestation{
+				ClientDataJSON:    clientData,
+				AttestationObject: attestationObject(data),
+			})
+		}
+
+		It("should return the attested credential of a none attestation", func() {
+			credential, err := register(rpID, 0x5d, clientData("webauthn.create", challenge, origin))
+
+			Expect(err).To(BeNil())
+			Expect(credential.CredentialID).To(Equal(credentialID))
+			Expect(credential.PublicKey).To(Equal(coseKey))
+			Expect(credential.Algorithm).To(Equal(vo.COSEAlgorithmES256))
+			Expect(credential.AAGUID).To(Equal("adce0002-35bc-c60a-648b-0b25f1f05503"))
+			Expect(credential.BackupEligible).To(BeTrue())
+			Expect(credential.BackedUp).To(BeTrue())
+		})
+
+		It("should reject a response from an origin that is not allowed", func() {
+			_, err := register(rpID, 0x45, clientData("webauthn.create", challenge, "https://evil.example"))
+
+			Expect(err).ToNot(BeNil())
+			Expect(err.Code).To(Equal(errors.ErrBadRequest))
+		})
+
+		It("should reject a response to another challenge", func() {
+			_, err := register(rpID, 0x45, clientData("webauthn.create", "b3RoZXI", origin))
+
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should reject a credential scoped to another relying party", func() {
+			_, err := register("other.com", 0x45, clientData("webauthn.create", challenge, origin))
+
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should reject a registration without user verification", func() {
+			_, err := register(rpID, 0x41, clientData("webauthn.create", challenge, origin))
+
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should reject an assertion presented as a registration", func() {
+			_, err := register(rpID, 0x45, clientData("webauthn.get", challenge, origin))
+
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should reject a key of an unsupported algorithm", func() {
+			coseKey = encodeCBOR(cborMap{{1, 2}, {3, -36}, {-1, 3}, {-2, []byte{1}}, {-3, []byte{2}}})
+
+			_, err := register(rpID, 0x45, clientData("webauthn.create", challenge, origin))
+
+			Expect(err).ToNot(BeNil())
+		})
+
+		It("should reject a malformed attestation object", func() {
+			_, err := webAuthn.VerifyRegistration(challenge, vo.WebAuthnAttestation{
+				ClientDataJSON:    clientData("webauthn.create", challenge, origin),
+				AttestationObject: []byte{0xa3, 0x63, 'f'},
+			})
+
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe("#VerifyAssertion", func() {
+		It("should accept an ES256 signature of the registered key", func() {
+			data := authData(rpID, 0x05, 42, nil)
+			client := clientData("webauthn.get", challenge, origin)
+
+			result, err := webAuthn.VerifyAssertion(challenge, vo.WebAuthnAssertion{
+				ClientDataJSON:    client,
+				AuthenticatorData: data,
+				Signature:         signES256(data, client),
+			}, coseKey)
+
+			Expect(err).To(BeNil())
+			Expect(result.SignCount).To(Equal(uint32(42)))
+			Expect(result.BackedUp).To(BeFalse())
+		})
+
+		It("should accept an Ed25519 signature", func() {
+			public, private, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			edKey := encodeCBOR(cborMap{{1, 1}, {3, -8}, {-1, 6}, {-2, []byte(public)}})
+			data := authData(rpID, 0x05, 0, nil)
+			client := clientData("webauthn.get", challenge, origin)
+			clientDataHash := sha256.Sum256(client)
+
+			result, verifyErr := webAuthn.VerifyAssertion(challenge, vo.WebAuthnAssertion{
+				ClientDataJSON:    client,
+				AuthenticatorData: data,
+				Signature:         ed25519.Sign(private, append(append([]byte{}, data...), clientDataHash[:]...)),
+			}, edKey)
+
+			Expect(verifyErr).To(BeNil())
+			Expect(result.SignCount).To(BeZero())
+		})
+
+		It("should reject a signature over other client data", func() {
+			data := authData(rpID, 0x05, 42, nil)
+			client := clientData("webauthn.get", challenge, origin)
+			signed := clientData("webauthn.get", challenge, "https://evil.example")
+
+			_, err := webAuthn.VerifyAssertion(challenge, vo.WebAuthnAssertion{
+				ClientDataJSON:    client,
+				AuthenticatorData: data,
+				Signature:         signES256(data, signed),
+			}, coseKey)
+
+			Expect(err).ToNot(BeNil())
+			Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+		})
+
+		It("should reject an assertion without user presence", func() {
+			data := authData(rpID, 0x04, 42, nil)
+			client := clientData("webauthn.get", challenge, origin)
+
+			_, err := webAuthn.VerifyAssertion(challenge, vo.WebAuthnAssertion{
+				ClientDataJSON:    client,
+				AuthenticatorData: data,
+				Signature:         signES256(data, client),
+			}, coseKey)
+
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
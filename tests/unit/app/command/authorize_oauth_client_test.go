@@ -0,0 +1,205 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: AUTHORIZE_OAUTH_CLIENT", func() {
+	Describe("#Execute", func() {
+		const redirectURI = "https://app.example.com/callback"
+
+		var (
+			ctx     context.Context
+			input   dto.AuthorizeOAuthClientInput
+			client  entity.OAuthClient
+			user    entity.User
+			approve bool
+			sut     *suts.AuthorizeOAuthClientSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			approve = true
+			input = dto.AuthorizeOAuthClientInput{
+				OAuthAuthorizationRequest: dto.OAuthAuthorizationRequest{
+					ResponseType:        "code",
+					ClientID:            "client-1",
+					RedirectURI:         redirectURI,
+					Scope:               "profile:read",
+					State:               "xyz",
+					CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+					CodeChallengeMethod: vo.PKCEMethodS256,
+				},
+				Approve: &approve,
+			}
+			client = entity.BuilderOAuthClient().
+				WithID(3).
+				WithClientID("client-1").
+				WithRedirectURIs([]string{redirectURI}).
+				WithScopes([]string{"profile:read", "profile:write"}).
+				WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).
+				Build()
+			user = entity.BuilderUser().
+				WithID(7).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				Build()
+
+			sut = suts.MakeAuthorizeOAuthClientSut()
+			sut.Tracer.On("Start", ctx, "AuthorizeOAuthClient.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Log.On("WarnJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		Context("success cases", func() {
+			It("should record the consent and redirect back with a code and the state", func() {
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.UserService.On("FindCurrentUser", ctx).Return(&user, nil)
+				sut.OpaqueToken.On("Generate").Return("raw-code", "code-hash", nil)
+				sut.UnitOfWork.On("WithTransaction", ctx).Return(nil)
+				sut.ConsentRepo.On("SaveConsent", ctx, mock.MatchedBy(func(c entity.OAuthConsent) bool {
+					return c.UserID() == 7 && c.ClientID() == 3 && c.Covers([]string{"profile:read"})
+				})).Return(nil)
+				sut.CodeRepo.On("CreateAuthorizationCode", ctx, mock.MatchedBy(func(c entity.AuthorizationCode) bool {
+					return c.CodeHash() == "code-hash" && c.UserID() == 7 && c.ClientID() == 3 &&
+						c.RedirectURI() == redirectURI && c.CodeChallenge() == input.CodeChallenge
+				})).Return(nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.RedirectTo).To(Equal(redirectURI + "?code=raw-code&state=xyz"))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "FindFamilyStartedAt", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should keep the nonce and the sign-in time of the session for the id_token", func() {
+				signedInAt := time.Now().Add(-time.Hour).UTC()
+				sessionCtx := vo.WithPrincipal(ctx, vo.Principal{PublicID: user.PublicID(), SessionID: "family-1"})
+				client.WithScopes([]string{entity.ScopeOpenID, entity.ScopeEmail})
+				input.Scope = "openid email"
+				input.Nonce = "n-0S6_WzA2Mj"
+				sut.Tracer.On("Start", sessionCtx, "AuthorizeOAuthClient.Execute").Return(sessionCtx, adapter.Span(sut.Span))
+				sut.ClientRepo.On("FindClientByClientID", sessionCtx, "client-1").Return(&client, nil)
+				sut.UserService.On("FindCurrentUser", sessionCtx).Return(&user, nil)
+				sut.RefreshRepo.On("FindFamilyStartedAt", sessionCtx, "family-1").Return(&signedInAt, nil)
+				sut.OpaqueToken.On("Generate").Return("raw-code", "code-hash", nil)
+				sut.UnitOfWork.On("WithTransaction", sessionCtx).Return(nil)
+				sut.ConsentRepo.On("SaveConsent", sessionCtx, mock.Anything).Return(nil)
+				sut.CodeRepo.On("CreateAuthorizationCode", sessionCtx, mock.MatchedBy(func(c entity.AuthorizationCode) bool {
+					return c.Nonce() == "n-0S6_WzA2Mj" && c.AuthTime() != nil && c.AuthTime().Equal(signedInAt)
+				})).Return(nil)
+
+				output, err := sut.Build().Execute(sessionCtx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.RedirectTo).To(ContainSubstring("code=raw-code"))
+			})
+
+			It("should reuse a previous consent that covers the requested scopes", func() {
+				input.Approve = nil
+				consent := entity.BuilderOAuthConsent().WithUserID(7).WithClientID(3).
+					WithScopes([]string{"profile:read"}).Build()
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.UserService.On("FindCurrentUser", ctx).Return(&user, nil)
+				sut.ConsentRepo.On("FindConsent", ctx, int64(7), int64(3)).Return(&consent, nil)
+				sut.OpaqueToken.On("Generate").Return("raw-code", "code-hash", nil)
+				sut.UnitOfWork.On("WithTransaction", ctx).Return(nil)
+				sut.CodeRepo.On("CreateAuthorizationCode", ctx, mock.Anything).Return(nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.RedirectTo).To(ContainSubstring("code=raw-code"))
+				Expect(sut.ConsentRepo.AssertNotCalled(GinkgoT(), "SaveConsent", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should redirect back with access_denied when the user declines", func() {
+				approve = false
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.RedirectTo).To(HavePrefix(redirectURI + "?"))
+				Expect(output.RedirectTo).To(ContainSubstring("error=access_denied"))
+				Expect(output.RedirectTo).To(ContainSubstring("state=xyz"))
+				Expect(sut.CodeRepo.AssertNotCalled(GinkgoT(), "CreateAuthorizationCode", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should report a request without PKCE to the client", func() {
+				input.CodeChallenge = ""
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.RedirectTo).To(ContainSubstring("error=invalid_request"))
+			})
+		})
+
+		Context("error cases", func() {
+			It("should not redirect to an unregistered redirect URI", func() {
+				input.RedirectURI = "https://evil.example.com/callback"
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorOAuthInvalidRedirectURI()))
+			})
+
+			It("should reject an unknown client", func() {
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_client"))
+			})
+
+			It("should require consent when the previous one does not cover the scopes", func() {
+				input.Approve = nil
+				input.Scope = "profile:read profile:write"
+				consent := entity.BuilderOAuthConsent().WithUserID(7).WithClientID(3).
+					WithScopes([]string{"profile:read"}).Build()
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.UserService.On("FindCurrentUser", ctx).Return(&user, nil)
+				sut.ConsentRepo.On("FindConsent", ctx, int64(7), int64(3)).Return(&consent, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorOAuthConsentRequired()))
+				Expect(sut.OpaqueToken.AssertNotCalled(GinkgoT(), "Generate")).To(BeTrue())
+			})
+
+			It("should refuse a session opened with another client's token", func() {
+				ctx = vo.WithPrincipal(ctx, vo.Principal{PublicID: user.PublicID(), ClientID: "client-2"})
+				sut.Tracer.On("Start", ctx, "AuthorizeOAuthClient.Execute").Return(ctx, adapter.Span(sut.Span))
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorOAuthDelegatedConsent()))
+				Expect(sut.ClientRepo.AssertNotCalled(GinkgoT(), "FindClientByClientID", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
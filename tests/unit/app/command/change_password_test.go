@@ -0,0 +1,135 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: CHANGE_PASSWORD", func() {
+	Describe("#Execute", func() {
+		const sessionID = "5b0b2c9e-8f4e-4b8a-9d63-0c7e3c1f2a11"
+
+		var (
+			ctx   context.Context
+			user  entity.User
+			input dto.ChangePasswordInput
+			sut   *suts.ChangePasswordSut
+		)
+
+		BeforeEach(func() {
+			ctx = vo.WithPrincipal(context.Background(), vo.Principal{
+				PublicID:  "123e4567-e89b-12d3-a456-426614174000",
+				SessionID: sessionID,
+			})
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail("user@example.com").
+				WithRole(entity.RoleUser).
+				Build()
+			user.AssignPasswordHash("old-hash")
+			input = dto.ChangePasswordInput{
+				CurrentPassword: "Old$ecretZ9",
+				Password:        "N3w$ecretZq",
+				PasswordConfirm: "N3w$ecretZq",
+			}
+
+			sut = suts.MakeChangePasswordSut()
+			sut.Tracer.On("Start", ctx, "ChangePassword.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Service.On("FindCurrentUser", ctx).Return(&user, nil)
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+			sut.Throttle.On("Check", ctx, "user@example.com", "").Return(time.Duration(0), nil)
+			sut.Throttle.On("RecordSuccess", ctx, "user@example.com", "").Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should store the new hash and revoke every other session", func() {
+				sut.Bcrypt.On("CompareHash", input.CurrentPassword, "old-hash").Return(true)
+				sut.Bcrypt.On("Hash", input.Password).Return("new-hash", nil)
+				sut.UserRepo.On("UpdateUserPassword", ctx, int64(1), "new-hash", mock.Anything).Return(true, nil)
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, user.PublicID(), sessionID).Return(nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, user.PublicID(), sessionID).Return(nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(sut.RefreshRepo.AssertCalled(GinkgoT(), "RevokeUserRefreshTokens", ctx, user.PublicID(), sessionID)).To(BeTrue())
+				Expect(sut.Denylist.AssertCalled(GinkgoT(), "RevokeUserTokens", ctx, user.PublicID(), sessionID)).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			BeforeEach(func() {
+				sut.Span.On("RecordError", mock.Anything).Return()
+			})
+
+			It("should reject a confirmation that does not match", func() {
+				input.PasswordConfirm = "Different$1x"
+				sut.Log.On("WarnJSON", "Password validation failed", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).ToNot(BeNil())
+				Expect(err.Code).To(Equal(errors.ValidationCode))
+				Expect(err.Fields).To(HaveKey("password_confirm"))
+				sut.Service.AssertNotCalled(GinkgoT(), "FindCurrentUser", mock.Anything)
+			})
+
+			It("should reject a new password that breaks the policy", func() {
+				input.Password = "weak"
+				input.PasswordConfirm = "weak"
+				sut.Log.On("WarnJSON", "Password validation failed", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).ToNot(BeNil())
+				Expect(err.Fields).To(HaveKey("password"))
+			})
+
+			It("should refuse when the current password is wrong", func() {
+				sut.Bcrypt.On("CompareHash", input.CurrentPassword, "old-hash").Return(false)
+				sut.Throttle.On("RecordFailure", ctx, "user@example.com", "").Return(time.Duration(0), nil)
+				sut.Log.On("WarnJSON", "Incorrect current password", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(Equal(errors.ErrorIncorrectCurrentPassword()))
+				sut.Throttle.AssertNumberOfCalls(GinkgoT(), "RecordFailure", 1)
+				sut.Bcrypt.AssertNotCalled(GinkgoT(), "Hash", mock.Anything)
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "UpdateUserPassword", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should fail when sessions cannot be revoked", func() {
+				denylistErr := errors.ErrorSetCache(assert.AnError)
+				sut.Bcrypt.On("CompareHash", input.CurrentPassword, "old-hash").Return(true)
+				sut.Bcrypt.On("Hash", input.Password).Return("new-hash", nil)
+				sut.UserRepo.On("UpdateUserPassword", ctx, int64(1), "new-hash", mock.Anything).Return(true, nil)
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, user.PublicID(), sessionID).Return(nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, user.PublicID(), sessionID).Return(denylistErr)
+				sut.Log.On("ErrorJSON", "Error changing password", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(Equal(denylistErr))
+			})
+		})
+	})
+})
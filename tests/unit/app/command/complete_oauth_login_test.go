@@ -0,0 +1,221 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: COMPLETE_OAUTH_LOGIN", func() {
+	Describe("#Execute", func() {
+		const stateKey = "auth:oauth:state:state-hash"
+
+		var (
+			ctx      context.Context
+			input    dto.CompleteOAuthLoginInput
+			login    vo.OAuthLoginState
+			external *vo.ExternalIdentity
+			tokens   *vo.AuthTokens
+			sut      *suts.CompleteOAuthLoginSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.CompleteOAuthLoginInput{
+				Provider:     "google",
+				Code:         "auth-code",
+				State:        "state-token",
+				StateBinding: "state-hash",
+			}
+			login = vo.OAuthLoginState{
+				Provider:     "google",
+				State:        "state-token",
+				CodeVerifier: "verifier",
+				Nonce:        "nonce",
+				RedirectURI:  "http://localhost:8080/auth/oauth/google/callback",
+			}
+			external = &vo.ExternalIdentity{
+				Provider:      "google",
+				Subject:       "google-sub-1",
+				Email:         "user@example.com",
+				EmailVerified: true,
+				Name:          "Test User",
+			}
+			expiresAt := time.Date(2025, 8, 4, 10, 0, 0, 0, time.UTC)
+			tokens = &vo.AuthTokens{
+				Access:           vo.TokenClaims{Token: "signed-token", ExpiresAt: expiresAt},
+				RefreshToken:     "refresh-token",
+				RefreshExpiresAt: expiresAt.Add(720 * time.Hour),
+			}
+
+			sut = suts.MakeCompleteOAuthLoginSut()
+			sut.Tracer.On("Start", ctx, "CompleteOAuthLogin.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.OpaqueToken.On("Hash", "state-token").Return("state-hash")
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+		})
+
+		storedState := func() {
+			sut.Cache.On("Get", ctx, stateKey, mock.Anything).
+				Run(func(args mock.Arguments) {
+					*args.Get(2).(*vo.OAuthLoginState) = login
+				}).
+				Return(true, nil)
+			sut.Cache.On("Delete", ctx, stateKey).Return(nil)
+		}
+
+		Context("success cases", func() {
+			It("should sign in the user already linked to the identity", func() {
+				user := entity.BuilderUser().WithID(7).WithPublicID("public-7").WithEmail("user@example.com").Build()
+				identity := entity.BuilderUserIdentity().WithID(3).WithUserID(7).Build()
+
+				storedState()
+				sut.Provider.On("Exchange", ctx, "auth-code", login).Return(external, nil)
+				sut.IdentityRepo.On("FindIdentity", ctx, "google", "google-sub-1").Return(&identity, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.IdentityRepo.On("RecordIdentityLogin", ctx, int64(3), "user@example.com", mock.AnythingOfType("time.Time")).Return(nil)
+				sut.MFAService.On("StartChallenge", ctx, &user).Return(nil, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "").Return(tokens, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+				Expect(output.RefreshToken).To(Equal("refresh-token"))
+				sut.IdentityRepo.AssertNotCalled(GinkgoT(), "CreateIdentity", mock.Anything, mock.Anything)
+			})
+
+			It("should link the identity to the account holding the same verified e-mail", func() {
+				verifiedAt := time.Now()
+				user := entity.BuilderUser().WithID(7).WithPublicID("public-7").WithEmail("user@example.com").
+					WithEmailVerifiedAt(&verifiedAt).Build()
+
+				storedState()
+				sut.Provider.On("Exchange", ctx, "auth-code", login).Return(external, nil)
+				sut.IdentityRepo.On("FindIdentity", ctx, "google", "google-sub-1").Return(nil, nil)
+				sut.UserRepo.On("FindUserByEmail", ctx, "user@example.com").Return(&user, nil)
+				sut.IdentityRepo.On("CreateIdentity", ctx, mock.MatchedBy(func(i entity.UserIdentity) bool {
+					return i.UserID() == 7 && i.Provider() == "google" && i.Subject() == "google-sub-1"
+				})).Return(nil, nil)
+				sut.MFAService.On("StartChallenge", ctx, &user).Return(nil, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "").Return(tokens, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "CreateUser", mock.Anything, mock.Anything)
+			})
+
+			It("should create a verified account on the first sign-in of an unknown e-mail", func() {
+				external.Name = ""
+				created := entity.BuilderUser().WithID(9).WithPublicID("new-public-id").WithEmail("user@example.com").Build()
+
+				storedState()
+				sut.Provider.On("Exchange", ctx, "auth-code", login).Return(external, nil)
+				sut.IdentityRepo.On("FindIdentity", ctx, "google", "google-sub-1").Return(nil, nil)
+				sut.UserRepo.On("FindUserByEmail", ctx, "user@example.com").Return(nil, nil)
+				sut.Utils.On("UUID").Return("new-public-id")
+				sut.UserRepo.On("CreateUser", ctx, mock.MatchedBy(func(u entity.User) bool {
+					return u.PublicID() == "new-public-id" && u.Name() == "user" &&
+						u.IsEmailVerified() && u.PasswordHash() == "" && u.Role() == string(entity.RoleUser)
+				})).Return(&created, nil)
+				sut.IdentityRepo.On("CreateIdentity", ctx, mock.MatchedBy(func(i entity.UserIdentity) bool {
+					return i.UserID() == 9
+				})).Return(nil, nil)
+				sut.MFAService.On("StartChallenge", ctx, &created).Return(nil, nil)
+				sut.TokenService.On("IssueTokens", ctx, &created, "").Return(tokens, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+			})
+		})
+
+		Context("error cases", func() {
+			BeforeEach(func() {
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", mock.Anything, mock.Anything).Return()
+				sut.Log.On("ErrorJSON", mock.Anything, mock.Anything).Return()
+			})
+
+			It("should reject a callback whose state is unknown or already used", func() {
+				sut.Cache.On("Get", ctx, stateKey, mock.Anything).Return(false, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidOAuthState()))
+				sut.Provider.AssertNotCalled(GinkgoT(), "Exchange", mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should reject a callback reaching a browser that did not start the login", func() {
+				input.StateBinding = "another-state-hash"
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidOAuthState()))
+				sut.Cache.AssertNotCalled(GinkgoT(), "Get", mock.Anything, mock.Anything, mock.Anything)
+				sut.Cache.AssertNotCalled(GinkgoT(), "Delete", mock.Anything, mock.Anything)
+			})
+
+			It("should reject a state issued for another provider", func() {
+				login.Provider = "facebook"
+				storedState()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidOAuthState()))
+				sut.Cache.AssertCalled(GinkgoT(), "Delete", ctx, stateKey)
+			})
+
+			It("should not link an unverified e-mail to an existing account", func() {
+				external.EmailVerified = false
+				user := entity.BuilderUser().WithID(7).WithEmail("user@example.com").Build()
+
+				storedState()
+				sut.Provider.On("Exchange", ctx, "auth-code", login).Return(external, nil)
+				sut.IdentityRepo.On("FindIdentity", ctx, "google", "google-sub-1").Return(nil, nil)
+				sut.UserRepo.On("FindUserByEmail", ctx, "user@example.com").Return(&user, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrConflict))
+				sut.IdentityRepo.AssertNotCalled(GinkgoT(), "CreateIdentity", mock.Anything, mock.Anything)
+				sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should require an e-mail to create an account", func() {
+				external.Email = ""
+
+				storedState()
+				sut.Provider.On("Exchange", ctx, "auth-code", login).Return(external, nil)
+				sut.IdentityRepo.On("FindIdentity", ctx, "google", "google-sub-1").Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorOAuthEmailRequired("google")))
+			})
+		})
+	})
+})
@@ -0,0 +1,166 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: CONSUME_MAGIC_LINK", func() {
+	Describe("#Execute", func() {
+		const subject = "magic_link:token-hash:nonce-hash"
+
+		var (
+			ctx       context.Context
+			input     dto.ConsumeMagicLinkInput
+			expiresAt time.Time
+			token     entity.OneTimeToken
+			user      entity.User
+			sut       *suts.ConsumeMagicLinkSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			expiresAt = time.Unix(1754301900, 0)
+			input = dto.ConsumeMagicLinkInput{
+				Token:     "raw-token",
+				Expires:   "1754301900",
+				Signature: "signature",
+				Nonce:     "raw-nonce",
+			}
+			token = entity.BuilderOneTimeToken().
+				WithID(3).
+				WithUserID(1).
+				WithPurpose(entity.TokenPurposeMagicLink).
+				WithTokenHash("token-hash").
+				Build()
+			verifiedAt := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail("user@example.com").
+				WithEmailVerifiedAt(&verifiedAt).
+				Build()
+
+			sut = suts.MakeConsumeMagicLinkSut()
+			sut.Tracer.On("Start", ctx, "ConsumeMagicLink.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Log.On("WarnJSON", mock.Anything, mock.Anything).Return()
+			sut.OpaqueToken.On("Hash", "raw-token").Return("token-hash")
+			sut.OpaqueToken.On("Hash", "raw-nonce").Return("nonce-hash")
+		})
+
+		Context("success cases", func() {
+			It("should consume the token and issue tokens", func() {
+				sut.Signer.On("Verify", subject, expiresAt, "signature").Return(true)
+				sut.TokenRepo.On("ConsumeOneTimeToken", ctx, entity.TokenPurposeMagicLink, "token-hash", mock.AnythingOfType("time.Time")).Return(&token, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(1)).Return(&user, nil)
+				sut.MFAService.On("StartChallenge", ctx, &user).Return(nil, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "").Return(&vo.AuthTokens{
+					Access: vo.TokenClaims{
+						PublicID:  user.PublicID(),
+						Token:     "signed-token",
+						ExpiresAt: expiresAt,
+					},
+					RefreshToken:     "refresh-token",
+					RefreshExpiresAt: expiresAt.Add(720 * time.Hour),
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+				Expect(output.RefreshToken).To(Equal("refresh-token"))
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "MarkEmailVerified", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should verify the e-mail of an unverified account", func() {
+				unverified := entity.BuilderUser().
+					WithID(1).
+					WithEmail("user@example.com").
+					Build()
+				sut.Signer.On("Verify", subject, expiresAt, "signature").Return(true)
+				sut.TokenRepo.On("ConsumeOneTimeToken", ctx, entity.TokenPurposeMagicLink, "token-hash", mock.AnythingOfType("time.Time")).Return(&token, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(1)).Return(&unverified, nil)
+				sut.UserRepo.On("MarkEmailVerified", ctx, int64(1), "user@example.com", mock.AnythingOfType("time.Time")).Return(true, nil)
+				sut.MFAService.On("StartChallenge", ctx, &unverified).Return(nil, nil)
+				sut.TokenService.On("IssueTokens", ctx, &unverified, "").Return(&vo.AuthTokens{
+					Access: vo.TokenClaims{Token: "signed-token", ExpiresAt: expiresAt},
+				}, nil)
+
+				_, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(unverified.IsEmailVerified()).To(BeTrue())
+			})
+
+			It("should return an MFA challenge when the user has MFA", func() {
+				sut.Signer.On("Verify", subject, expiresAt, "signature").Return(true)
+				sut.TokenRepo.On("ConsumeOneTimeToken", ctx, entity.TokenPurposeMagicLink, "token-hash", mock.AnythingOfType("time.Time")).Return(&token, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(1)).Return(&user, nil)
+				sut.MFAService.On("StartChallenge", ctx, &user).Return(&vo.MFAChallenge{
+					Token:     "mfa-token",
+					ExpiresAt: expiresAt,
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.MFARequired).To(BeTrue())
+				Expect(output.MFAToken).To(Equal("mfa-token"))
+				sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject a link opened without the requesting browser's nonce and keep it usable", func() {
+				input.Nonce = "other-nonce"
+				sut.OpaqueToken.On("Hash", "other-nonce").Return("other-hash")
+				sut.Signer.On("Verify", "magic_link:token-hash:other-hash", expiresAt, "signature").Return(false)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+				sut.TokenRepo.AssertNotCalled(GinkgoT(), "ConsumeOneTimeToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should reject a link without a nonce", func() {
+				input.Nonce = ""
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+				sut.Signer.AssertNotCalled(GinkgoT(), "Verify", mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should reject a link that was already used or has expired", func() {
+				sut.Signer.On("Verify", subject, expiresAt, "signature").Return(true)
+				sut.TokenRepo.On("ConsumeOneTimeToken", ctx, entity.TokenPurposeMagicLink, "token-hash", mock.AnythingOfType("time.Time")).Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "FindUserByID", mock.Anything, mock.Anything)
+			})
+		})
+	})
+})
@@ -60,6 +60,7 @@ var _ = Describe("INTERNAL :: APP :: COMMAND :: CREATE_AUTH_USER", func() {
 						user.Email() == input.Email &&
 						user.Name() == input.Name
 				})).Return(&createdUser, (*errors.Error)(nil))
+				sut.EmailVerification.On("SendVerification", ctx, &createdUser).Return(nil)
 
 				command := sut.Build()
 
@@ -95,6 +96,40 @@ var _ = Describe("INTERNAL :: APP :: COMMAND :: CREATE_AUTH_USER", func() {
 				Expect(sut.Service.AssertCalled(GinkgoT(), "ValidateEmailAvailability", ctx, input.Email)).To(BeTrue())
 				Expect(sut.Bcrypt.AssertCalled(GinkgoT(), "Hash", input.Password)).To(BeTrue())
 				Expect(sut.Repo.AssertCalled(GinkgoT(), "CreateUser", ctx, mock.AnythingOfType("entity.User"))).To(BeTrue())
+				Expect(sut.EmailVerification.AssertCalled(GinkgoT(), "SendVerification", ctx, &createdUser)).To(BeTrue())
+			})
+
+			It("should create the user even when the verification e-mail fails", func() {
+				ctx := context.Background()
+				input := dto.CreateAuthUserInput{
+					Email:           "user@example.com",
+					Password:        "Sup3r$ecretZ",
+					PasswordConfirm: "Sup3r$ecretZ",
+					Name:            "Test User",
+				}
+				mailErr := errors.ErrorSendMail(assert.AnError)
+
+				sut := suts.MakeCreateAuthUserSut()
+				sut.Tracer.On("Start", ctx, "CreateAuthUser.Execute").Return(ctx, adapter.Span(sut.Span))
+				sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+				sut.Span.On("End").Return()
+				sut.Span.On("RecordError", mailErr).Return()
+				sut.Sc.On("TraceID").Return("trace-123")
+				sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+				sut.Utils.On("UUID").Return("generated-uuid")
+				sut.Service.On("ValidateEmailAvailability", ctx, input.Email).Return((*errors.Error)(nil))
+				sut.Bcrypt.On("Hash", input.Password).Return("hashed-password", (*errors.Error)(nil))
+
+				createdUser := mapper.ToUser(input)
+				createdUser.AssignPublicID("generated-uuid")
+				createdUser.AssignID(1)
+				sut.Repo.On("CreateUser", ctx, mock.AnythingOfType("entity.User")).Return(&createdUser, (*errors.Error)(nil))
+				sut.EmailVerification.On("SendVerification", ctx, &createdUser).Return(mailErr)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.PublicID).To(Equal("generated-uuid"))
 			})
 
 			Context("error cases", func() {
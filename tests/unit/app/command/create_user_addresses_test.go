@@ -0,0 +1,129 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/command"
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: CREATE_USER_ADDRESSES", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx  context.Context
+			user entity.User
+			sut  *suts.CreateUserAddressesSut
+		)
+
+		validAddress := func() dto.AddressInput {
+			return dto.AddressInput{
+				Label:        "Home",
+				Street:       "Avenida Paulista",
+				Number:       "1000",
+				Neighborhood: "Bela Vista",
+				City:         "São Paulo",
+				State:        "sp",
+				ZipCode:      "01310-100",
+			}
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithRole(entity.RoleUser).
+				Build()
+
+			sut = suts.MakeCreateUserAddressesSut()
+			sut.Tracer.On("Start", ctx, "CreateUserAddresses.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Service.On("FindCurrentUser", ctx).Return(&user, nil)
+			sut.Utils.On("UUID").Return("address-uuid")
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should make the first address of a new user the default for shipping and billing", func() {
+				sut.Repo.On("LockUserAddresses", ctx, int64(1)).Return(nil)
+				sut.Repo.On("ListAddressesByUserID", ctx, int64(1)).Return([]entity.Address{}, nil)
+				sut.Repo.On("ClearDefaultAddresses", ctx, int64(1), true, true).Return(nil)
+				saved := entity.BuilderAddress().WithPublicID("address-uuid").WithZipCode("01310100").Build()
+				sut.Repo.On("CreateAddress", ctx, mock.Anything).Return(&saved, nil)
+
+				output, err := sut.Build().Execute(ctx, dto.CreateUserAddressesInput{
+					Addresses: []dto.AddressInput{validAddress()},
+				})
+
+				Expect(err).To(BeNil())
+				Expect(sut.Repo.AssertCalled(GinkgoT(), "CreateAddress", ctx, mock.MatchedBy(func(a entity.Address) bool {
+					return a.IsDefaultShipping() && a.IsDefaultBilling() &&
+						a.UserID() == 1 && a.State() == "SP" && a.ZipCode() == "01310100"
+				}))).To(BeTrue())
+				Expect(output.Addresses).To(HaveLen(1))
+				Expect(output.Addresses[0].ZipCode).To(Equal("01310-100"))
+			})
+		})
+
+		Context("error cases", func() {
+			It("should group validation errors by request index", func() {
+				invalid := validAddress()
+				invalid.ZipCode = "123"
+				invalid.State = "XX"
+				second := validAddress()
+				second.IsDefaultShipping = true
+				third := validAddress()
+				third.IsDefaultShipping = true
+
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Address validation failed", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, dto.CreateUserAddressesInput{
+					Addresses: []dto.AddressInput{validAddress(), invalid, second, third},
+				})
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrBadRequest))
+				grouped, ok := err.Fields["addresses"].([]map[string]any)
+				Expect(ok).To(BeTrue())
+				Expect(grouped).To(HaveLen(4))
+				Expect(grouped[0]).To(BeNil())
+				Expect(grouped[1]).To(HaveKey("zip_code"))
+				Expect(grouped[1]).To(HaveKey("state"))
+				Expect(grouped[2]).To(BeNil())
+				Expect(grouped[3]).To(HaveKey("is_default_shipping"))
+				Expect(sut.Uow.AssertNotCalled(GinkgoT(), "WithTransaction", mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject addresses beyond the per-user limit", func() {
+				existing := make([]entity.Address, command.MaxAddressesPerUser)
+				sut.Repo.On("LockUserAddresses", ctx, int64(1)).Return(nil)
+				sut.Repo.On("ListAddressesByUserID", ctx, int64(1)).Return(existing, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("ErrorJSON", "Error creating user addresses", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, dto.CreateUserAddressesInput{
+					Addresses: []dto.AddressInput{validAddress()},
+				})
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorAddressLimitReached(command.MaxAddressesPerUser)))
+				sut.Repo.AssertCalled(GinkgoT(), "LockUserAddresses", ctx, int64(1))
+				Expect(sut.Repo.AssertNotCalled(GinkgoT(), "CreateAddress", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,89 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: DELETE_CURRENT_USER", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx  context.Context
+			user entity.User
+			sut  *suts.DeleteCurrentUserSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithRole(entity.RoleUser).
+				Build()
+
+			sut = suts.MakeDeleteCurrentUserSut()
+			sut.Tracer.On("Start", ctx, "DeleteCurrentUser.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Service.On("FindCurrentUser", ctx).Return(&user, nil)
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should soft delete the user and revoke every session", func() {
+				sut.UserRepo.On("SoftDeleteUser", ctx, int64(1), mock.Anything).Return(true, nil)
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, user.PublicID(), "").Return(nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, user.PublicID(), "").Return(nil)
+
+				output, err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(output.DeletedAt).ToNot(BeEmpty())
+				Expect(output.RestoreUntil > output.DeletedAt).To(BeTrue())
+				Expect(sut.Denylist.AssertCalled(GinkgoT(), "RevokeUserTokens", ctx, user.PublicID(), "")).To(BeTrue())
+			})
+
+			It("should still succeed when the denylist is unavailable", func() {
+				denylistErr := errors.ErrorSetCache(assert.AnError)
+				sut.UserRepo.On("SoftDeleteUser", ctx, int64(1), mock.Anything).Return(true, nil)
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, user.PublicID(), "").Return(nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, user.PublicID(), "").Return(denylistErr)
+				sut.Span.On("RecordError", denylistErr).Return()
+				sut.Log.On("WarnJSON", "Error revoking access tokens of deleted user", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(output).ToNot(BeNil())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should not revoke sessions when the user was already deleted", func() {
+				sut.UserRepo.On("SoftDeleteUser", ctx, int64(1), mock.Anything).Return(false, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("ErrorJSON", "Error deleting current user", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorUserNotFound(user.PublicID())))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeUserRefreshTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.Denylist.AssertNotCalled(GinkgoT(), "RevokeUserTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,329 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: EXCHANGE_OAUTH_TOKEN", func() {
+	Describe("#Execute", func() {
+		const (
+			verifier    = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+			challenge   = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+			redirectURI = "https://app.example.com/callback"
+		)
+
+		var (
+			ctx    context.Context
+			input  dto.OAuthTokenInput
+			client entity.OAuthClient
+			code   entity.AuthorizationCode
+			user   entity.User
+			sut    *suts.ExchangeOAuthTokenSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.OAuthTokenInput{
+				GrantType:    entity.GrantTypeAuthorizationCode,
+				Code:         "raw-code",
+				RedirectURI:  redirectURI,
+				ClientID:     "client-1",
+				CodeVerifier: verifier,
+			}
+			client = entity.BuilderOAuthClient().
+				WithID(3).
+				WithClientID("client-1").
+				WithRedirectURIs([]string{redirectURI}).
+				WithScopes([]string{"profile:read"}).
+				WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).
+				Build()
+			code = entity.BuilderAuthorizationCode().
+				WithClientID(3).
+				WithUserID(7).
+				WithRedirectURI(redirectURI).
+				WithScopes([]string{"profile:read"}).
+				WithCodeChallenge(challenge).
+				Build()
+			user = entity.BuilderUser().
+				WithID(7).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail("user@example.com").
+				WithRole(entity.RoleUser).
+				Build()
+
+			sut = suts.MakeExchangeOAuthTokenSut()
+			sut.Tracer.On("Start", ctx, "ExchangeOAuthToken.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Log.On("WarnJSON", "OAuth token request rejected", mock.Anything).Return()
+			sut.OpaqueToken.On("Hash", "raw-code").Return("code-hash")
+		})
+
+		Context("success cases", func() {
+			It("should issue an access token bound to the client and the granted scopes", func() {
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.CodeRepo.On("ConsumeAuthorizationCode", ctx, "code-hash", mock.Anything).Return(&code, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.JWT.On("Generate", vo.TokenClaims{
+					PublicID: user.PublicID(),
+					Role:     string(entity.RoleUser),
+					Email:    "user@example.com",
+					Scopes:   []string{"profile:read"},
+					ClientID: "client-1",
+				}).Return(&vo.TokenClaims{
+					Token:     "signed-token",
+					Scopes:    []string{"profile:read"},
+					ExpiresAt: time.Now().Add(15 * time.Minute),
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+				Expect(output.TokenType).To(Equal(mapper.TokenTypeBearer))
+				Expect(output.ExpiresIn).To(BeNumerically("~", 900, 1))
+				Expect(output.Scope).To(Equal("profile:read"))
+				Expect(output.IDToken).To(BeEmpty())
+				Expect(sut.IDToken.AssertNotCalled(GinkgoT(), "Sign", mock.Anything)).To(BeTrue())
+			})
+
+			It("should issue an id_token with the claims of the granted openid scopes", func() {
+				authTime := time.Now().Add(-time.Hour).UTC()
+				verified := false
+				openID := entity.BuilderAuthorizationCode().
+					WithClientID(3).
+					WithUserID(7).
+					WithRedirectURI(redirectURI).
+					WithScopes([]string{entity.ScopeOpenID, entity.ScopeEmail}).
+					WithCodeChallenge(challenge).
+					WithNonce("n-0S6_WzA2Mj").
+					WithAuthTime(&authTime).
+					Build()
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.CodeRepo.On("ConsumeAuthorizationCode", ctx, "code-hash", mock.Anything).Return(&openID, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.JWT.On("Generate", mock.Anything).Return(&vo.TokenClaims{Token: "signed-token", ExpiresAt: time.Now()}, nil)
+				sut.IDToken.On("Sign", vo.IDTokenClaims{
+					UserInfo: vo.UserInfo{
+						Subject:       user.PublicID(),
+						Email:         "user@example.com",
+						EmailVerified: &verified,
+					},
+					ClientID: "client-1",
+					Nonce:    "n-0S6_WzA2Mj",
+					AuthTime: &authTime,
+				}).Return("signed-id-token", nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.IDToken).To(Equal("signed-id-token"))
+			})
+
+			It("should accept a confidential client presenting its secret", func() {
+				confidential := entity.BuilderOAuthClient().
+					WithID(3).
+					WithClientID("client-1").
+					WithSecretHash("secret-hash").
+					WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).
+					Build()
+				input.ClientSecret = "secret"
+				sut.OpaqueToken.On("Hash", "secret").Return("secret-hash")
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&confidential, nil)
+				sut.CodeRepo.On("ConsumeAuthorizationCode", ctx, "code-hash", mock.Anything).Return(&code, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.JWT.On("Generate", mock.Anything).Return(&vo.TokenClaims{Token: "signed-token", ExpiresAt: time.Now()}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+			})
+		})
+
+		Context("client_credentials", func() {
+			var service entity.OAuthClient
+
+			BeforeEach(func() {
+				input = dto.OAuthTokenInput{
+					GrantType:    entity.GrantTypeClientCredentials,
+					ClientID:     "orders",
+					ClientSecret: "secret",
+				}
+				service = entity.BuilderOAuthClient().
+					WithID(9).
+					WithClientID("orders").
+					WithSecretHash("secret-hash").
+					WithScopes([]string{"payments:charge", "payments:refund"}).
+					WithAudiences([]string{"payments"}).
+					WithGrantTypes([]string{entity.GrantTypeClientCredentials}).
+					Build()
+				sut.OpaqueToken.On("Hash", "secret").Return("secret-hash")
+				sut.ClientRepo.On("FindClientByClientID", ctx, "orders").Return(&service, nil)
+			})
+
+			It("should issue a short-lived token whose subject is the client", func() {
+				input.Scope = "payments:charge"
+				sut.ServiceJWT.On("Generate", vo.TokenClaims{
+					PublicID: "orders",
+					ClientID: "orders",
+					Scopes:   []string{"payments:charge"},
+					Audience: []string{"payments"},
+				}).Return(&vo.TokenClaims{
+					Token:     "service-token",
+					Scopes:    []string{"payments:charge"},
+					ExpiresAt: time.Now().Add(5 * time.Minute),
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("service-token"))
+				Expect(output.ExpiresIn).To(BeNumerically("~", 300, 1))
+				Expect(sut.JWT.AssertNotCalled(GinkgoT(), "Generate", mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject scopes outside the registered ones", func() {
+				input.Scope = "users:delete"
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_scope"))
+			})
+
+			It("should reject audiences outside the registered ones", func() {
+				input.Audience = "ledger"
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_target"))
+			})
+
+			It("should reject a client registered only for authorization codes", func() {
+				input.ClientID = "client-1"
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("unauthorized_client"))
+			})
+
+			It("should reject a disabled client", func() {
+				disabledAt := time.Now()
+				service.WithDisabledAt(&disabledAt)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_client"))
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject other grant types", func() {
+				input.GrantType = "password"
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("unsupported_grant_type"))
+				Expect(sut.ClientRepo.AssertNotCalled(GinkgoT(), "FindClientByClientID", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject a confidential client with a wrong secret without consuming the code", func() {
+				confidential := entity.BuilderOAuthClient().
+					WithID(3).
+					WithClientID("client-1").
+					WithSecretHash("secret-hash").
+					WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).
+					Build()
+				input.ClientSecret = "wrong"
+				sut.OpaqueToken.On("Hash", "wrong").Return("other-hash")
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&confidential, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_client"))
+				Expect(sut.CodeRepo.AssertNotCalled(GinkgoT(), "ConsumeAuthorizationCode", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject an unknown, expired or used code", func() {
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.CodeRepo.On("ConsumeAuthorizationCode", ctx, "code-hash", mock.Anything).Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_grant"))
+			})
+
+			It("should reject a code_verifier that does not match the challenge", func() {
+				input.CodeVerifier = "x" + verifier[1:]
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.CodeRepo.On("ConsumeAuthorizationCode", ctx, "code-hash", mock.Anything).Return(&code, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_grant"))
+				Expect(sut.JWT.AssertNotCalled(GinkgoT(), "Generate", mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject a redirect_uri other than the one of the authorization request", func() {
+				input.RedirectURI = "https://app.example.com/other"
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+				sut.CodeRepo.On("ConsumeAuthorizationCode", ctx, "code-hash", mock.Anything).Return(&code, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_grant"))
+			})
+
+			It("should reject a code issued to another client", func() {
+				other := entity.BuilderOAuthClient().WithID(4).WithClientID("client-2").
+					WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).Build()
+				input.ClientID = "client-2"
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-2").Return(&other, nil)
+				sut.CodeRepo.On("ConsumeAuthorizationCode", ctx, "code-hash", mock.Anything).Return(&code, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_grant"))
+			})
+
+			It("should require a well-formed code_verifier", func() {
+				input.CodeVerifier = "short"
+				sut.ClientRepo.On("FindClientByClientID", ctx, "client-1").Return(&client, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.OAuthCode()).To(Equal("invalid_request"))
+				Expect(sut.CodeRepo.AssertNotCalled(GinkgoT(), "ConsumeAuthorizationCode", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
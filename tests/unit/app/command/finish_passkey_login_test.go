@@ -0,0 +1,204 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: FINISH_PASSKEY_LOGIN", func() {
+	Describe("#Execute", func() {
+		const (
+			publicID     = "123e4567-e89b-12d3-a456-426614174000"
+			credentialID = "Y3JlZGVudGlhbC0x"
+			challenge    = "challenge-1"
+			challengeKey = "auth:webauthn:challenge:challenge-hash"
+		)
+
+		var (
+			ctx        context.Context
+			input      dto.FinishPasskeyLoginInput
+			assertion  vo.WebAuthnAssertion
+			user       entity.User
+			credential entity.WebAuthnCredential
+			session    vo.WebAuthnSession
+			sut        *suts.FinishPasskeyLoginSut
+		)
+
+		encode := func(value string) string {
+			return base64.RawURLEncoding.EncodeToString([]byte(value))
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.FinishPasskeyLoginInput{Credential: dto.PasskeyAssertionCredential{
+				ID:    credentialID,
+				RawID: credentialID,
+				Type:  "public-key",
+				Response: dto.PasskeyAssertionResponse{
+					ClientDataJSON:    encode("client-data"),
+					AuthenticatorData: encode("authenticator-data"),
+					Signature:         encode("signature"),
+					UserHandle:        encode(publicID),
+				},
+			}}
+			assertion = vo.WebAuthnAssertion{
+				ClientDataJSON:    []byte("client-data"),
+				AuthenticatorData: []byte("authenticator-data"),
+				Signature:         []byte("signature"),
+			}
+			user = entity.BuilderUser().
+				WithID(7).
+				WithPublicID(publicID).
+				Build()
+			credential = entity.BuilderWebAuthnCredential().
+				WithID(3).
+				WithUserID(7).
+				WithCredentialID(credentialID).
+				WithPublicKey([]byte("cose-key")).
+				WithSignCount(10).
+				Build()
+			session = vo.WebAuthnSession{Ceremony: vo.WebAuthnCeremonyLogin}
+
+			sut = suts.MakeFinishPasskeyLoginSut()
+			sut.Tracer.On("Start", ctx, "FinishPasskeyLogin.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Log.On("WarnJSON", mock.Anything, mock.Anything).Return()
+			sut.WebAuthn.On("Challenge", []byte("client-data")).Return(challenge, nil)
+			sut.OpaqueToken.On("Hash", challenge).Return("challenge-hash")
+			sut.Cache.On("Get", ctx, challengeKey, mock.Anything).
+				Run(func(args mock.Arguments) {
+					*args.Get(2).(*vo.WebAuthnSession) = session
+				}).
+				Return(true, nil)
+			sut.Cache.On("Delete", ctx, challengeKey).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should issue the tokens of a password login for a valid assertion", func() {
+				expiresAt := time.Date(2025, 8, 4, 10, 0, 0, 0, time.UTC)
+				sut.CredentialRepo.On("FindCredentialByCredentialID", ctx, credentialID).Return(&credential, nil)
+				sut.Repo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.WebAuthn.On("VerifyAssertion", challenge, assertion, []byte("cose-key")).
+					Return(&vo.WebAuthnAssertionResult{SignCount: 11, BackedUp: true}, nil)
+				sut.CredentialRepo.On("RecordCredentialUse", ctx, int64(3), uint32(11), true, mock.Anything).Return(true, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "").Return(&vo.AuthTokens{
+					Access:           vo.TokenClaims{Token: "signed-token", ExpiresAt: expiresAt},
+					RefreshToken:     "refresh-token",
+					RefreshExpiresAt: expiresAt.Add(time.Hour),
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.MFARequired).To(BeFalse())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+				Expect(output.RefreshToken).To(Equal("refresh-token"))
+				Expect(sut.Cache.AssertCalled(GinkgoT(), "Delete", ctx, challengeKey)).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject an assertion for an unknown or expired challenge", func() {
+				sut.Cache.ExpectedCalls = nil
+				sut.Cache.On("Get", ctx, challengeKey, mock.Anything).Return(false, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidPasskeyChallenge()))
+				Expect(sut.CredentialRepo.AssertNotCalled(GinkgoT(), "FindCredentialByCredentialID", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject the challenge of a registration", func() {
+				session.Ceremony = vo.WebAuthnCeremonyRegistration
+				session.UserID = 7
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidPasskeyChallenge()))
+			})
+
+			It("should reject a passkey that is not registered", func() {
+				sut.CredentialRepo.On("FindCredentialByCredentialID", ctx, credentialID).Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorUnknownPasskey()))
+				Expect(sut.WebAuthn.AssertNotCalled(GinkgoT(), "VerifyAssertion", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject a user handle that does not belong to the passkey owner", func() {
+				input.Credential.Response.UserHandle = encode("someone-else")
+				sut.CredentialRepo.On("FindCredentialByCredentialID", ctx, credentialID).Return(&credential, nil)
+				sut.Repo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorUnknownPasskey()))
+			})
+
+			It("should reject a signature counter that did not increase", func() {
+				sut.CredentialRepo.On("FindCredentialByCredentialID", ctx, credentialID).Return(&credential, nil)
+				sut.Repo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.WebAuthn.On("VerifyAssertion", challenge, assertion, []byte("cose-key")).
+					Return(&vo.WebAuthnAssertionResult{SignCount: 10}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorPasskeyCloneSuspected()))
+				Expect(sut.CredentialRepo.AssertNotCalled(GinkgoT(), "RecordCredentialUse", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject a sign-in that lost the race to record its counter", func() {
+				sut.CredentialRepo.On("FindCredentialByCredentialID", ctx, credentialID).Return(&credential, nil)
+				sut.Repo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.WebAuthn.On("VerifyAssertion", challenge, assertion, []byte("cose-key")).
+					Return(&vo.WebAuthnAssertionResult{SignCount: 11}, nil)
+				sut.CredentialRepo.On("RecordCredentialUse", ctx, int64(3), uint32(11), false, mock.Anything).Return(false, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorPasskeyCloneSuspected()))
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject an unverified e-mail when verification is required", func() {
+				sut.RequireVerifiedEmail = true
+				sut.CredentialRepo.On("FindCredentialByCredentialID", ctx, credentialID).Return(&credential, nil)
+				sut.Repo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.WebAuthn.On("VerifyAssertion", challenge, assertion, []byte("cose-key")).
+					Return(&vo.WebAuthnAssertionResult{SignCount: 11}, nil)
+				sut.CredentialRepo.On("RecordCredentialUse", ctx, int64(3), uint32(11), false, mock.Anything).Return(true, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorEmailNotVerified()))
+			})
+		})
+	})
+})
@@ -0,0 +1,106 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: FORGOT_PASSWORD_SMS", func() {
+	Describe("#Execute", func() {
+		const phone = "+5511912345678"
+
+		var (
+			ctx   context.Context
+			input dto.ForgotPasswordSmsInput
+			user  entity.User
+			sut   *suts.ForgotPasswordSmsSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.ForgotPasswordSmsInput{Phone: "+55 (11) 91234-5678"}
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithPhone(phone).
+				Build()
+
+			sut = suts.MakeForgotPasswordSmsSut()
+			sut.Tracer.On("Start", ctx, "ForgotPasswordSms.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		Context("success cases", func() {
+			It("should text a code to the normalized phone", func() {
+				sut.UserRepo.On("FindUserByVerifiedPhone", ctx, phone).Return(&user, nil)
+				sut.OTPStore.On("Issue", mock.Anything, "password_reset:"+phone).Return("123456", nil)
+				sent := make(chan struct{})
+				sut.SmsSender.On("Send", mock.Anything, phone, mock.MatchedBy(func(message string) bool {
+					return strings.Contains(message, "123456")
+				})).Run(func(mock.Arguments) { close(sent) }).Return(nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Eventually(sent).Should(BeClosed())
+				sut.SmsSender.AssertNumberOfCalls(GinkgoT(), "Send", 1)
+			})
+
+			It("should answer the same for unknown phones without sending anything", func() {
+				sut.UserRepo.On("FindUserByVerifiedPhone", ctx, phone).Return(nil, nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				sut.OTPStore.AssertNotCalled(GinkgoT(), "Issue", mock.Anything, mock.Anything)
+				sut.SmsSender.AssertNotCalled(GinkgoT(), "Send", mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should not reveal delivery failures", func() {
+				smsErr := errors.ErrorSendSms(assert.AnError)
+				sut.UserRepo.On("FindUserByVerifiedPhone", ctx, phone).Return(&user, nil)
+				sut.OTPStore.On("Issue", mock.Anything, "password_reset:"+phone).Return("123456", nil)
+				sut.SmsSender.On("Send", mock.Anything, phone, mock.Anything).Return(smsErr)
+				logged := make(chan struct{})
+				sut.Log.On("ErrorJSON", "Error sending password reset code", mock.Anything).
+					Run(func(mock.Arguments) { close(logged) }).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Eventually(logged).Should(BeClosed())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject a malformed phone", func() {
+				input.Phone = "11 91234"
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Phone validation failed", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).NotTo(BeNil())
+				Expect(err.Code).To(Equal(errors.ValidationCode))
+				Expect(err.Fields).To(HaveKey("phone"))
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "FindUserByVerifiedPhone", mock.Anything, mock.Anything)
+			})
+		})
+	})
+})
@@ -0,0 +1,97 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: FORGOT_PASSWORD", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx   context.Context
+			input dto.ForgotPasswordInput
+			user  entity.User
+			sut   *suts.ForgotPasswordSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.ForgotPasswordInput{Email: "user@example.com"}
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail(input.Email).
+				Build()
+
+			sut = suts.MakeForgotPasswordSut()
+			sut.Tracer.On("Start", ctx, "ForgotPassword.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Uow.On("WithTransaction", mock.Anything).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should store a hashed token and e-mail the raw one", func() {
+				sut.UserRepo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.OpaqueToken.On("Generate").Return("raw-token", "token-hash", nil)
+				sut.TokenRepo.On("InvalidateOneTimeTokens", mock.Anything, int64(1), entity.TokenPurposePasswordReset).Return(nil)
+				sut.TokenRepo.On("CreateOneTimeToken", mock.Anything, mock.MatchedBy(func(token entity.OneTimeToken) bool {
+					return token.TokenHash() == "token-hash" && token.Purpose() == entity.TokenPurposePasswordReset
+				})).Return(&entity.OneTimeToken{}, nil)
+				sent := make(chan struct{})
+				sut.Mailer.On("Send", mock.Anything, mock.MatchedBy(func(message vo.MailMessage) bool {
+					return message.To == input.Email && strings.Contains(message.Body, sut.ResetURL+"?token=raw-token")
+				})).Run(func(mock.Arguments) { close(sent) }).Return(nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Eventually(sent).Should(BeClosed())
+				sut.Mailer.AssertNumberOfCalls(GinkgoT(), "Send", 1)
+			})
+
+			It("should answer the same for unknown e-mails without sending anything", func() {
+				sut.UserRepo.On("FindUserByEmail", ctx, input.Email).Return(nil, nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				sut.OpaqueToken.AssertNotCalled(GinkgoT(), "Generate")
+				sut.Mailer.AssertNotCalled(GinkgoT(), "Send", mock.Anything, mock.Anything)
+			})
+
+			It("should not reveal delivery failures", func() {
+				mailErr := errors.ErrorSendMail(assert.AnError)
+				sut.UserRepo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.OpaqueToken.On("Generate").Return("raw-token", "token-hash", nil)
+				sut.TokenRepo.On("InvalidateOneTimeTokens", mock.Anything, int64(1), entity.TokenPurposePasswordReset).Return(nil)
+				sut.TokenRepo.On("CreateOneTimeToken", mock.Anything, mock.Anything).Return(&entity.OneTimeToken{}, nil)
+				sut.Mailer.On("Send", mock.Anything, mock.Anything).Return(mailErr)
+				logged := make(chan struct{})
+				sut.Log.On("ErrorJSON", "Error sending password reset e-mail", mock.Anything).
+					Run(func(mock.Arguments) { close(logged) }).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Eventually(logged).Should(BeClosed())
+			})
+		})
+	})
+})
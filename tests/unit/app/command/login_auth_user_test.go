@@ -0,0 +1,224 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/app/mapper"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: LOGIN_AUTH_USER", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx           context.Context
+			input         dto.LoginAuthUserInput
+			user          entity.User
+			sut           *suts.LoginAuthUserSut
+			throttleCheck *mock.Call
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.LoginAuthUserInput{
+				Email:    "user@example.com",
+				Password: "Sup3r$ecretZ",
+			}
+
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail(input.Email).
+				WithName("Test User").
+				WithRole(entity.RoleUser).
+				Build()
+			user.AssignPasswordHash("hashed-password")
+
+			sut = suts.MakeLoginAuthUserSut()
+			sut.Tracer.On("Start", ctx, "LoginAuthUser.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			throttleCheck = sut.Throttle.On("Check", ctx, input.Email, "").Return(time.Duration(0), nil)
+			sut.Throttle.On("RecordSuccess", ctx, input.Email, "").Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should return a signed access token and refresh token when credentials are valid", func() {
+				expiresAt := time.Date(2025, 8, 4, 10, 0, 0, 0, time.UTC)
+
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(true)
+				sut.MFAService.On("StartChallenge", ctx, &user).Return(nil, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "").Return(&vo.AuthTokens{
+					Access: vo.TokenClaims{
+						PublicID:  user.PublicID(),
+						Role:      string(entity.RoleUser),
+						Email:     input.Email,
+						SessionID: "family-1",
+						Token:     "signed-token",
+						ExpiresAt: expiresAt,
+					},
+					RefreshToken:     "refresh-token",
+					RefreshExpiresAt: expiresAt.Add(720 * time.Hour),
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output).ToNot(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+				Expect(output.TokenType).To(Equal(mapper.TokenTypeBearer))
+				Expect(output.ExpiresAt).To(Equal("2025-08-04T10:00:00.000000Z"))
+				Expect(output.RefreshToken).To(Equal("refresh-token"))
+				Expect(output.RefreshExpiresAt).To(Equal("2025-09-03T10:00:00.000000Z"))
+
+				Expect(sut.Log.AssertCalled(GinkgoT(), "InfoJSON", "Authenticating user", mock.MatchedBy(func(m map[string]any) bool {
+					body, ok := m["body"].(dto.LoginAuthUserInput)
+					return ok && m["trace_id"] == "trace-123" &&
+						body.Email == input.Email &&
+						body.Password == logger.Mask
+				}))).To(BeTrue())
+				Expect(sut.Span.AssertNotCalled(GinkgoT(), "RecordError", mock.Anything)).To(BeTrue())
+			})
+
+			It("should return an MFA challenge instead of tokens when the user has MFA", func() {
+				expiresAt := time.Date(2025, 8, 4, 10, 5, 0, 0, time.UTC)
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(true)
+				sut.MFAService.On("StartChallenge", ctx, &user).Return(&vo.MFAChallenge{
+					Token:     "mfa-token",
+					ExpiresAt: expiresAt,
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.MFARequired).To(BeTrue())
+				Expect(output.MFAToken).To(Equal("mfa-token"))
+				Expect(output.MFATokenExpiresAt).To(Equal("2025-08-04T10:05:00.000000Z"))
+				Expect(output.AuthTokenOutput).To(BeNil())
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should return invalid credentials when user does not exist", func() {
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(nil, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, mock.Anything).Return(false)
+				sut.Throttle.On("RecordFailure", ctx, input.Email, "").Return(time.Duration(0), nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid credentials", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidCredentials()))
+				Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+				Expect(err.FriendlyMessage).To(Equal(errors.InvalidCredentialsMessage))
+				Expect(sut.Bcrypt.AssertNumberOfCalls(GinkgoT(), "CompareHash", 1)).To(BeTrue())
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should return invalid credentials when password does not match", func() {
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(false)
+				sut.Throttle.On("RecordFailure", ctx, input.Email, "").Return(time.Duration(0), nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid credentials", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidCredentials()))
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should tell how long to wait when a failure delays the next attempt", func() {
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(false)
+				sut.Throttle.On("RecordFailure", ctx, input.Email, "").Return(4*time.Second, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid credentials", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+				Expect(err.Fields).To(HaveKeyWithValue(errors.RetryAfterField, int64(4)))
+			})
+
+			It("should refuse without checking the password while the account is locked", func() {
+				throttleCheck.Unset()
+				sut.Throttle.On("Check", ctx, input.Email, "").Return(90*time.Second, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Login throttled", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrAccountLocked))
+				Expect(err.Fields).To(HaveKeyWithValue(errors.RetryAfterField, int64(90)))
+				Expect(sut.Repo.AssertNotCalled(GinkgoT(), "FindUserByEmail", mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.Bcrypt.AssertNotCalled(GinkgoT(), "CompareHash", mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.Throttle.AssertNotCalled(GinkgoT(), "RecordFailure", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should return the repository error when lookup fails", func() {
+				repoErr := errors.ErrorFindUserByEmail(assert.AnError)
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(nil, repoErr)
+				sut.Span.On("RecordError", repoErr).Return()
+				sut.Log.On("ErrorJSON", "Error finding user by email", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(repoErr))
+				Expect(sut.Bcrypt.AssertNotCalled(GinkgoT(), "CompareHash", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject unverified e-mails when verification is required", func() {
+				sut.RequireVerifiedEmail = true
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(true)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Login of unverified e-mail rejected", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrForbidden))
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should return the token error when issuing tokens fails", func() {
+				tokenErr := errors.ErrorGenerateToken(assert.AnError)
+				sut.Repo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(true)
+				sut.MFAService.On("StartChallenge", ctx, &user).Return(nil, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "").Return(nil, tokenErr)
+				sut.Span.On("RecordError", tokenErr).Return()
+				sut.Log.On("ErrorJSON", "Error issuing auth tokens", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(tokenErr))
+			})
+		})
+	})
+})
@@ -0,0 +1,87 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: LOGOUT_AUTH_USER", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx    context.Context
+			claims *vo.TokenClaims
+			sut    *suts.LogoutAuthUserSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			claims = &vo.TokenClaims{
+				ID:        "jti-1",
+				PublicID:  "123e4567-e89b-12d3-a456-426614174000",
+				SessionID: "family-1",
+				ExpiresAt: time.Now().Add(15 * time.Minute),
+			}
+
+			sut = suts.MakeLogoutAuthUserSut()
+			sut.Tracer.On("Start", ctx, "LogoutAuthUser.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		Context("success cases", func() {
+			It("should revoke the current session and deny the presented access token", func() {
+				sut.TokenService.On("VerifyAccessToken", ctx, "access-token").Return(claims, nil)
+				sut.RefreshRepo.On("RevokeRefreshTokenFamily", ctx, "family-1").Return(nil)
+				sut.Denylist.On("RevokeSession", ctx, "family-1").Return(nil)
+				sut.Denylist.On("RevokeToken", ctx, "jti-1", claims.ExpiresAt).Return(nil)
+
+				err := sut.Build().Execute(ctx, dto.LogoutAuthUserInput{AccessToken: "access-token"})
+
+				Expect(err).To(BeNil())
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeUserRefreshTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.Denylist.AssertNotCalled(GinkgoT(), "RevokeUserTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should revoke every session of the user when all_sessions is set", func() {
+				sut.TokenService.On("VerifyAccessToken", ctx, "access-token").Return(claims, nil)
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, claims.PublicID, "").Return(nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, claims.PublicID, "").Return(nil)
+				sut.Denylist.On("RevokeToken", ctx, "jti-1", claims.ExpiresAt).Return(nil)
+
+				err := sut.Build().Execute(ctx, dto.LogoutAuthUserInput{AccessToken: "access-token", AllSessions: true})
+
+				Expect(err).To(BeNil())
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject an already revoked access token", func() {
+				revokedErr := errors.ErrorRevokedToken("jti-1")
+				sut.TokenService.On("VerifyAccessToken", ctx, "access-token").Return(nil, revokedErr)
+				sut.Span.On("RecordError", revokedErr).Return()
+
+				err := sut.Build().Execute(ctx, dto.LogoutAuthUserInput{AccessToken: "access-token"})
+
+				Expect(err).To(Equal(revokedErr))
+				Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.Denylist.AssertNotCalled(GinkgoT(), "RevokeToken", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
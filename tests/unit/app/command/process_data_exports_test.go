@@ -0,0 +1,199 @@
+//go:build unit
+
+package command_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: PROCESS_DATA_EXPORTS", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx     context.Context
+			sut     *suts.ProcessDataExportsSut
+			payload []byte
+		)
+
+		pendingExport := func(format entity.ExportFormat) *entity.DataExport {
+			export := entity.BuilderDataExport().
+				WithID(7).
+				WithPublicID("0b7f1c7e-3f5a-4c1e-9d1a-2f8a6c3b9e10").
+				WithUserID(1).
+				WithFormat(format).
+				WithStatus(entity.ExportStatusProcessing).
+				WithRequestedAt(time.Now().Add(-time.Minute)).
+				Build()
+			return &export
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			payload = nil
+
+			sut = suts.MakeProcessDataExportsSut()
+			sut.Tracer.On("Start", ctx, "ProcessDataExports.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+
+			user := entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail("user@example.com").
+				WithName("User").
+				WithRole(entity.RoleUser).
+				Build()
+			user.AssignPasswordHash("hashed-password")
+			token := entity.BuilderRefreshToken().
+				WithUserID(1).
+				WithFamilyID("family-1").
+				WithTokenHash("token-hash").
+				WithExpiresAt(time.Now().Add(time.Hour)).
+				Build()
+			session := entity.BuilderSession().
+				WithUserID(1).
+				WithFamilyID("family-1").
+				WithDeviceName("Firefox on Linux").
+				WithUserAgent("Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0").
+				WithIPAddress("203.0.113.7").
+				Build()
+			identity := entity.BuilderUserIdentity().
+				WithUserID(1).
+				WithProvider("google").
+				WithSubject("google-subject").
+				WithEmail("user@gmail.com").
+				Build()
+			consent := entity.BuilderOAuthConsent().
+				WithUserID(1).
+				WithClientName("Partner App").
+				WithScopes([]string{"openid", "profile"}).
+				Build()
+			passkey := entity.BuilderWebAuthnCredential().
+				WithUserID(1).
+				WithCredentialID("credential-1").
+				WithName("Laptop").
+				Build()
+			mfa := entity.BuilderUserMFA().
+				WithUserID(1).
+				WithTOTPSecret("JBSWY3DPEHPK3PXP").
+				Build()
+
+			sut.ExportRepo.On("ExpireDataExports", ctx, mock.Anything).Return(int64(0), nil)
+			sut.UserRepo.On("FindUserByID", ctx, int64(1)).Return(&user, nil)
+			sut.AddressRepo.On("ListAddressesByUserID", ctx, int64(1)).Return([]entity.Address{}, nil)
+			sut.SessionRepo.On("ListSessionsByUserID", ctx, int64(1)).Return([]entity.Session{session}, nil)
+			sut.RefreshRepo.On("ListRefreshTokensByUserID", ctx, int64(1)).Return([]entity.RefreshToken{token}, nil)
+			sut.IdentityRepo.On("ListIdentitiesByUserID", ctx, int64(1)).Return([]entity.UserIdentity{identity}, nil)
+			sut.ConsentRepo.On("ListConsentsByUserID", ctx, int64(1)).Return([]entity.OAuthConsent{consent}, nil)
+			sut.PasskeyRepo.On("ListCredentialsByUserID", ctx, int64(1)).Return([]entity.WebAuthnCredential{passkey}, nil)
+			sut.MFARepo.On("FindUserMFA", ctx, int64(1)).Return(&mfa, nil)
+			sut.ExportRepo.On("ListDataExportsByUserID", ctx, int64(1)).Return([]entity.DataExport{}, nil)
+			sut.ExportRepo.On("CompleteDataExport", ctx, int64(7), mock.Anything, mock.Anything).
+				Run(func(args mock.Arguments) { payload = args.Get(2).([]byte) }).
+				Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should build a versioned JSON bundle with secrets redacted", func() {
+				sut.ExportRepo.On("ClaimPendingDataExport", ctx, mock.Anything).Return(pendingExport(entity.ExportFormatJSON), nil).Once()
+				sut.ExportRepo.On("ClaimPendingDataExport", ctx, mock.Anything).Return(nil, nil).Once()
+
+				processed, err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(processed).To(Equal(int64(1)))
+
+				var bundle dto.PersonalDataBundle
+				Expect(json.Unmarshal(payload, &bundle)).To(Succeed())
+				Expect(bundle.Version).To(Equal(dto.PersonalDataBundleVersion))
+				Expect(bundle.Profile.Email).To(Equal("user@example.com"))
+				Expect(bundle.Profile.PasswordHash).To(Equal(logger.Mask))
+				Expect(bundle.Sessions).To(HaveLen(1))
+				Expect(bundle.Sessions[0].DeviceName).To(Equal("Firefox on Linux"))
+				Expect(bundle.Sessions[0].IPAddress).To(Equal("203.0.113.7"))
+				Expect(bundle.RefreshTokens).To(HaveLen(1))
+				Expect(bundle.RefreshTokens[0].TokenHash).To(Equal(logger.Mask))
+				Expect(bundle.LinkedIdentities).To(HaveLen(1))
+				Expect(bundle.LinkedIdentities[0].Email).To(Equal("user@gmail.com"))
+				Expect(bundle.OAuthConsents).To(HaveLen(1))
+				Expect(bundle.OAuthConsents[0].ClientName).To(Equal("Partner App"))
+				Expect(bundle.Passkeys).To(HaveLen(1))
+				Expect(bundle.Passkeys[0].Name).To(Equal("Laptop"))
+				Expect(bundle.MFA).NotTo(BeNil())
+				Expect(bundle.MFA.TOTPSecret).To(Equal(logger.Mask))
+				Expect(string(payload)).NotTo(ContainSubstring("hashed-password"))
+				Expect(string(payload)).NotTo(ContainSubstring("JBSWY3DPEHPK3PXP"))
+			})
+
+			It("should wrap the bundle in a ZIP archive when requested", func() {
+				sut.ExportRepo.On("ClaimPendingDataExport", ctx, mock.Anything).Return(pendingExport(entity.ExportFormatZIP), nil).Once()
+				sut.ExportRepo.On("ClaimPendingDataExport", ctx, mock.Anything).Return(nil, nil).Once()
+
+				_, err := sut.Build().Execute(ctx)
+				Expect(err).To(BeNil())
+
+				archive, zipErr := zip.NewReader(bytes.NewReader(payload), int64(len(payload)))
+				Expect(zipErr).NotTo(HaveOccurred())
+				Expect(archive.File).To(HaveLen(1))
+				Expect(archive.File[0].Name).To(Equal("personal-data.json"))
+
+				file, openErr := archive.File[0].Open()
+				Expect(openErr).NotTo(HaveOccurred())
+				content, readErr := io.ReadAll(file)
+				Expect(readErr).NotTo(HaveOccurred())
+
+				var bundle dto.PersonalDataBundle
+				Expect(json.Unmarshal(content, &bundle)).To(Succeed())
+				Expect(bundle.Profile.PasswordHash).To(Equal(logger.Mask))
+			})
+
+			It("should reclaim exports left in processing past the claim timeout", func() {
+				var staleBefore time.Time
+				sut.ExportRepo.On("ClaimPendingDataExport", ctx, mock.Anything).
+					Run(func(args mock.Arguments) { staleBefore = args.Get(1).(time.Time) }).
+					Return(nil, nil).Once()
+
+				processed, err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(processed).To(BeZero())
+				Expect(staleBefore).To(BeTemporally("~", time.Now().Add(-15*time.Minute), time.Second))
+			})
+		})
+
+		Context("error cases", func() {
+			It("should mark the export as failed and keep going", func() {
+				repoErr := errors.ErrorListAddresses(io.ErrUnexpectedEOF)
+				sut.AddressRepo.ExpectedCalls = nil
+				sut.AddressRepo.On("ListAddressesByUserID", ctx, int64(1)).Return(nil, repoErr)
+				sut.ExportRepo.On("ClaimPendingDataExport", ctx, mock.Anything).Return(pendingExport(entity.ExportFormatJSON), nil).Once()
+				sut.ExportRepo.On("ClaimPendingDataExport", ctx, mock.Anything).Return(nil, nil).Once()
+				sut.ExportRepo.On("FailDataExport", ctx, int64(7), repoErr.Error()).Return(nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("ErrorJSON", "Error processing data export", mock.Anything).Return()
+
+				processed, err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(processed).To(Equal(int64(1)))
+				sut.ExportRepo.AssertNotCalled(GinkgoT(), "CompleteDataExport", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+		})
+	})
+})
@@ -0,0 +1,143 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: REFRESH_AUTH_TOKEN", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx   context.Context
+			input dto.RefreshAuthTokenInput
+			user  entity.User
+			sut   *suts.RefreshAuthTokenSut
+		)
+
+		buildToken := func(rotatedAt, revokedAt *time.Time, expiresAt time.Time) *entity.RefreshToken {
+			token := entity.BuilderRefreshToken().
+				WithID(10).
+				WithUserID(1).
+				WithFamilyID("family-1").
+				WithTokenHash("hashed-refresh").
+				WithExpiresAt(expiresAt).
+				WithRotatedAt(rotatedAt).
+				WithRevokedAt(revokedAt).
+				Build()
+			return &token
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.RefreshAuthTokenInput{RefreshToken: "plain-refresh"}
+
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail("user@example.com").
+				WithRole(entity.RoleUser).
+				Build()
+
+			sut = suts.MakeRefreshAuthTokenSut()
+			sut.Tracer.On("Start", ctx, "RefreshAuthToken.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.OpaqueToken.On("Hash", "plain-refresh").Return("hashed-refresh")
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should rotate the token and issue a new pair in the same family", func() {
+				stored := buildToken(nil, nil, time.Now().Add(time.Hour))
+				sut.RefreshRepo.On("FindRefreshTokenByHash", ctx, "hashed-refresh").Return(stored, nil)
+				sut.RefreshRepo.On("MarkRefreshTokenRotated", ctx, int64(10)).Return(nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(1)).Return(&user, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "family-1").Return(&vo.AuthTokens{
+					Access:           vo.TokenClaims{Token: "new-access", SessionID: "family-1"},
+					RefreshToken:     "new-refresh",
+					RefreshExpiresAt: time.Now().Add(720 * time.Hour),
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("new-access"))
+				Expect(output.RefreshToken).To(Equal("new-refresh"))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should revoke the whole family when a rotated token is presented again", func() {
+				rotatedAt := time.Now().Add(-time.Minute)
+				stored := buildToken(&rotatedAt, nil, time.Now().Add(time.Hour))
+				sut.RefreshRepo.On("FindRefreshTokenByHash", ctx, "hashed-refresh").Return(stored, nil)
+				sut.RefreshRepo.On("RevokeRefreshTokenFamily", ctx, "family-1").Return(nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("CriticalJSON", "Refresh token reuse detected, family revoked", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorRefreshTokenReused("family-1")))
+				Expect(err.Code).To(Equal(errors.ErrUnauthorized))
+				Expect(sut.RefreshRepo.AssertCalled(GinkgoT(), "RevokeRefreshTokenFamily", ctx, "family-1")).To(BeTrue())
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "MarkRefreshTokenRotated", mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject an unknown token", func() {
+				sut.RefreshRepo.On("FindRefreshTokenByHash", ctx, "hashed-refresh").Return(nil, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("ErrorJSON", "Error refreshing auth token", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidRefreshToken()))
+			})
+
+			It("should reject an expired token without rotating it", func() {
+				stored := buildToken(nil, nil, time.Now().Add(-time.Minute))
+				sut.RefreshRepo.On("FindRefreshTokenByHash", ctx, "hashed-refresh").Return(stored, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("ErrorJSON", "Error refreshing auth token", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidRefreshToken()))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "MarkRefreshTokenRotated", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject a token from a revoked family", func() {
+				revokedAt := time.Now().Add(-time.Minute)
+				stored := buildToken(&revokedAt, &revokedAt, time.Now().Add(time.Hour))
+				sut.RefreshRepo.On("FindRefreshTokenByHash", ctx, "hashed-refresh").Return(stored, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("ErrorJSON", "Error refreshing auth token", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidRefreshToken()))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,86 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: REQUEST_MAGIC_LINK", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx   context.Context
+			input dto.RequestMagicLinkInput
+			user  entity.User
+			sut   *suts.RequestMagicLinkSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.RequestMagicLinkInput{Email: "user@example.com"}
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail(input.Email).
+				Build()
+
+			sut = suts.MakeRequestMagicLinkSut()
+			sut.Tracer.On("Start", ctx, "RequestMagicLink.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Uow.On("WithTransaction", mock.Anything).Return(nil)
+			sut.OpaqueToken.On("Generate").Return("raw-nonce", "nonce-hash", nil).Once()
+		})
+
+		Context("success cases", func() {
+			It("should e-mail a link signed for the token and the browser nonce", func() {
+				sut.UserRepo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.OpaqueToken.On("Generate").Return("raw-token", "token-hash", nil).Once()
+				sut.TokenRepo.On("InvalidateOneTimeTokens", mock.Anything, int64(1), entity.TokenPurposeMagicLink).Return(nil)
+				sut.TokenRepo.On("CreateOneTimeToken", mock.Anything, mock.MatchedBy(func(token entity.OneTimeToken) bool {
+					return token.TokenHash() == "token-hash" && token.Purpose() == entity.TokenPurposeMagicLink
+				})).Return(&entity.OneTimeToken{}, nil)
+				sut.Signer.On("Sign", "magic_link:token-hash:nonce-hash", mock.AnythingOfType("time.Time")).Return("signature")
+				sent := make(chan struct{})
+				sut.Mailer.On("Send", mock.Anything, mock.MatchedBy(func(message vo.MailMessage) bool {
+					return message.To == input.Email &&
+						strings.Contains(message.Body, sut.LinkURL+"?") &&
+						strings.Contains(message.Body, "token=raw-token") &&
+						strings.Contains(message.Body, "signature=signature") &&
+						!strings.Contains(message.Body, "raw-nonce")
+				})).Run(func(mock.Arguments) { close(sent) }).Return(nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.Nonce).To(Equal("raw-nonce"))
+				Eventually(sent).Should(BeClosed())
+				sut.Mailer.AssertNumberOfCalls(GinkgoT(), "Send", 1)
+			})
+
+			It("should return a nonce for unknown e-mails without sending anything", func() {
+				sut.UserRepo.On("FindUserByEmail", ctx, input.Email).Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.Nonce).To(Equal("raw-nonce"))
+				sut.OpaqueToken.AssertNumberOfCalls(GinkgoT(), "Generate", 1)
+				sut.Mailer.AssertNotCalled(GinkgoT(), "Send", mock.Anything, mock.Anything)
+			})
+		})
+	})
+})
@@ -0,0 +1,83 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: RESEND_EMAIL_VERIFICATION", func() {
+	Describe("#Execute", func() {
+		const counterKey = "auth:verify_email:resend:user@example.com"
+
+		var (
+			ctx   context.Context
+			input dto.ResendEmailVerificationInput
+			user  entity.User
+			sut   *suts.ResendEmailVerificationSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.ResendEmailVerificationInput{Email: "user@example.com"}
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail(input.Email).
+				Build()
+
+			sut = suts.MakeResendEmailVerificationSut()
+			sut.Tracer.On("Start", ctx, "ResendEmailVerification.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		Context("success cases", func() {
+			It("should send a new link to an unverified account", func() {
+				sut.Cache.On("Increment", ctx, counterKey, 3600).Return(int64(1), nil)
+				sut.UserRepo.On("FindUserByEmail", ctx, input.Email).Return(&user, nil)
+				sut.EmailVerification.On("SendVerification", ctx, &user).Return(nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				sut.EmailVerification.AssertNumberOfCalls(GinkgoT(), "SendVerification", 1)
+			})
+
+			It("should answer the same for unknown e-mails without sending anything", func() {
+				sut.Cache.On("Increment", ctx, counterKey, 3600).Return(int64(1), nil)
+				sut.UserRepo.On("FindUserByEmail", ctx, input.Email).Return(nil, nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				sut.EmailVerification.AssertNotCalled(GinkgoT(), "SendVerification", mock.Anything, mock.Anything)
+			})
+		})
+
+		Context("error cases", func() {
+			It("should throttle requests over the limit", func() {
+				sut.Cache.On("Increment", ctx, counterKey, 3600).Return(int64(4), nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Verification e-mail resend limit reached", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err.Code).To(Equal(errors.ErrTooManyRequests))
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "FindUserByEmail", mock.Anything, mock.Anything)
+			})
+		})
+	})
+})
@@ -0,0 +1,99 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: RESET_PASSWORD", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx   context.Context
+			input dto.ResetPasswordInput
+			user  entity.User
+			sut   *suts.ResetPasswordSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.ResetPasswordInput{Token: "raw-token", Password: "N3w$ecretZq", PasswordConfirm: "N3w$ecretZq"}
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				Build()
+
+			sut = suts.MakeResetPasswordSut()
+			sut.Tracer.On("Start", ctx, "ResetPassword.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+			sut.OpaqueToken.On("Hash", "raw-token").Return("token-hash")
+			sut.Bcrypt.On("Hash", input.Password).Return("new-hash", nil)
+		})
+
+		Context("success cases", func() {
+			It("should consume the token, store the hash and end every session", func() {
+				token := entity.BuilderOneTimeToken().WithID(9).WithUserID(1).Build()
+				sut.TokenRepo.On("ConsumeOneTimeToken", ctx, entity.TokenPurposePasswordReset, "token-hash", mock.Anything).Return(&token, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(1)).Return(&user, nil)
+				sut.UserRepo.On("UpdateUserPassword", ctx, int64(1), "new-hash", mock.Anything).Return(true, nil)
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, user.PublicID(), "").Return(nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, user.PublicID(), "").Return(nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				sut.Denylist.AssertCalled(GinkgoT(), "RevokeUserTokens", ctx, user.PublicID(), "")
+			})
+		})
+
+		Context("error cases", func() {
+			BeforeEach(func() {
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("ErrorJSON", "Error resetting password", mock.Anything).Return()
+			})
+
+			It("should reject unknown, used or expired tokens", func() {
+				sut.TokenRepo.On("ConsumeOneTimeToken", ctx, entity.TokenPurposePasswordReset, "token-hash", mock.Anything).Return(nil, nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(Equal(errors.ErrorInvalidResetToken()))
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "UpdateUserPassword", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should not accept a token of a deleted account", func() {
+				token := entity.BuilderOneTimeToken().WithID(9).WithUserID(1).Build()
+				sut.TokenRepo.On("ConsumeOneTimeToken", ctx, entity.TokenPurposePasswordReset, "token-hash", mock.Anything).Return(&token, nil)
+				sut.UserRepo.On("FindUserByID", ctx, int64(1)).Return(nil, nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(Equal(errors.ErrorInvalidResetToken()))
+			})
+
+			It("should validate the new password before touching the token", func() {
+				input.PasswordConfirm = "Other$ecret1"
+				sut.Log.On("WarnJSON", "Password validation failed", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err.Fields).To(HaveKey("password_confirm"))
+				sut.TokenRepo.AssertNotCalled(GinkgoT(), "ConsumeOneTimeToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+		})
+	})
+})
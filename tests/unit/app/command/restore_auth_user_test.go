@@ -0,0 +1,99 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: RESTORE_AUTH_USER", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx   context.Context
+			input dto.RestoreAuthUserInput
+			sut   *suts.RestoreAuthUserSut
+		)
+
+		deletedUser := func(deletedAt time.Time) *entity.User {
+			user := entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithEmail(input.Email).
+				WithRole(entity.RoleUser).
+				WithDeletedAt(&deletedAt).
+				Build()
+			user.AssignPasswordHash("hashed-password")
+			return &user
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.RestoreAuthUserInput{Email: "user@example.com", Password: "Sup3r$ecretZ"}
+
+			sut = suts.MakeRestoreAuthUserSut()
+			sut.Tracer.On("Start", ctx, "RestoreAuthUser.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Throttle.On("Check", ctx, input.Email, "").Return(time.Duration(0), nil)
+			sut.Throttle.On("RecordSuccess", ctx, input.Email, "").Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should restore an account inside the grace period", func() {
+				user := deletedUser(time.Now().Add(-time.Hour))
+				restored := entity.BuilderUser().WithID(1).WithPublicID(user.PublicID()).WithEmail(input.Email).Build()
+				sut.Repo.On("FindDeletedUserByEmail", ctx, input.Email).Return(user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(true)
+				sut.Repo.On("RestoreUser", ctx, int64(1)).Return(&restored, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.PublicID).To(Equal(user.PublicID()))
+			})
+		})
+
+		Context("error cases", func() {
+			It("should answer like a failed login for unknown emails", func() {
+				sut.Repo.On("FindDeletedUserByEmail", ctx, input.Email).Return(nil, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, mock.Anything).Return(false)
+				sut.Throttle.On("RecordFailure", ctx, input.Email, "").Return(time.Duration(0), nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid credentials", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidCredentials()))
+				sut.Throttle.AssertNumberOfCalls(GinkgoT(), "RecordFailure", 1)
+				sut.Bcrypt.AssertNumberOfCalls(GinkgoT(), "CompareHash", 1)
+			})
+
+			It("should refuse once the grace period is over", func() {
+				user := deletedUser(time.Now().Add(-sut.GracePeriod - time.Minute))
+				sut.Repo.On("FindDeletedUserByEmail", ctx, input.Email).Return(user, nil)
+				sut.Bcrypt.On("CompareHash", input.Password, "hashed-password").Return(true)
+				sut.Span.On("RecordError", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorRestoreWindowExpired(user.PublicID())))
+				Expect(sut.Repo.AssertNotCalled(GinkgoT(), "RestoreUser", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
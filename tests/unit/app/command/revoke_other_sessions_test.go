@@ -0,0 +1,75 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: REVOKE_OTHER_SESSIONS", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx  context.Context
+			user entity.User
+			sut  *suts.RevokeOtherSessionsSut
+		)
+
+		BeforeEach(func() {
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithRole(entity.RoleUser).
+				Build()
+			ctx = vo.WithPrincipal(context.Background(), vo.Principal{
+				PublicID:  user.PublicID(),
+				SessionID: "family-1",
+			})
+
+			sut = suts.MakeRevokeOtherSessionsSut()
+			sut.Tracer.On("Start", ctx, "RevokeOtherSessions.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Service.On("FindCurrentUser", ctx).Return(&user, nil)
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should revoke every session but the current one", func() {
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, user.PublicID(), "family-1").Return(nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, user.PublicID(), "family-1").Return(nil)
+
+				err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(sut.Denylist.AssertCalled(GinkgoT(), "RevokeUserTokens", ctx, user.PublicID(), "family-1")).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should not deny access tokens when revoking the refresh tokens fails", func() {
+				repoErr := errors.ErrorRevokeUserRefreshTokens(assert.AnError)
+				sut.RefreshRepo.On("RevokeUserRefreshTokens", ctx, user.PublicID(), "family-1").Return(repoErr)
+				sut.Span.On("RecordError", repoErr).Return()
+				sut.Log.On("ErrorJSON", "Error revoking other sessions", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx)
+
+				Expect(err).To(Equal(repoErr))
+				Expect(sut.Denylist.AssertNotCalled(GinkgoT(), "RevokeUserTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
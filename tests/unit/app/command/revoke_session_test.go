@@ -0,0 +1,125 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: REVOKE_SESSION", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx     context.Context
+			user    entity.User
+			session entity.Session
+			input   dto.RevokeSessionInput
+			sut     *suts.RevokeSessionSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithRole(entity.RoleUser).
+				Build()
+			session = entity.BuilderSession().
+				WithID(7).
+				WithUserID(1).
+				WithFamilyID("5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").
+				Build()
+			input = dto.RevokeSessionInput{SessionID: "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b"}
+
+			sut = suts.MakeRevokeSessionSut()
+			sut.Tracer.On("Start", ctx, "RevokeSession.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Service.On("FindCurrentUser", ctx).Return(&user, nil)
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should revoke the refresh tokens and deny the access tokens of the session", func() {
+				sut.SessionRepo.On("FindSessionByFamilyID", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(&session, nil)
+				sut.RefreshRepo.On("RevokeRefreshTokenFamily", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(nil)
+				sut.Denylist.On("RevokeSession", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(sut.Denylist.AssertCalled(GinkgoT(), "RevokeSession", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b")).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should return not found when the session does not exist", func() {
+				sut.SessionRepo.On("FindSessionByFamilyID", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(nil, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(Equal(errors.ErrorSessionNotFound("5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b")))
+				Expect(err.Code).To(Equal(errors.ErrNotFound))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should return not found when the session belongs to another user", func() {
+				other := entity.BuilderSession().WithUserID(2).WithFamilyID("5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Build()
+				sut.SessionRepo.On("FindSessionByFamilyID", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(&other, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err.Code).To(Equal(errors.ErrNotFound))
+				Expect(sut.RefreshRepo.AssertNotCalled(GinkgoT(), "RevokeRefreshTokenFamily", mock.Anything, mock.Anything)).To(BeTrue())
+				Expect(sut.Denylist.AssertNotCalled(GinkgoT(), "RevokeSession", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should return not found without a lookup when the id is not a session id", func() {
+				sut.Span.On("RecordError", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, dto.RevokeSessionInput{SessionID: "not-a-uuid"})
+
+				Expect(err.Code).To(Equal(errors.ErrNotFound))
+				Expect(sut.SessionRepo.AssertNotCalled(GinkgoT(), "FindSessionByFamilyID", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should return the repository error when the lookup fails", func() {
+				repoErr := errors.ErrorFindSession(assert.AnError)
+				sut.SessionRepo.On("FindSessionByFamilyID", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(nil, repoErr)
+				sut.Span.On("RecordError", repoErr).Return()
+				sut.Log.On("ErrorJSON", "Error finding session", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(Equal(repoErr))
+			})
+
+			It("should return the error when the denylist is unavailable", func() {
+				denylistErr := errors.ErrorSetCache(assert.AnError)
+				sut.SessionRepo.On("FindSessionByFamilyID", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(&session, nil)
+				sut.RefreshRepo.On("RevokeRefreshTokenFamily", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(nil)
+				sut.Denylist.On("RevokeSession", ctx, "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b").Return(denylistErr)
+				sut.Span.On("RecordError", denylistErr).Return()
+				sut.Log.On("ErrorJSON", "Error revoking session", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(Equal(denylistErr))
+			})
+		})
+	})
+})
@@ -0,0 +1,102 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: ROTATE_OAUTH_CLIENT_SECRET", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx    context.Context
+			input  dto.RotateOAuthClientSecretInput
+			client entity.OAuthClient
+			sut    *suts.RotateOAuthClientSecretSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.RotateOAuthClientSecretInput{ClientID: "orders"}
+			client = entity.BuilderOAuthClient().
+				WithID(9).
+				WithClientID("orders").
+				WithSecretHash("old-hash").
+				WithGrantTypes([]string{entity.GrantTypeClientCredentials}).
+				Build()
+
+			sut = suts.MakeRotateOAuthClientSecretSut()
+			sut.Tracer.On("Start", ctx, "RotateOAuthClientSecret.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Log.On("ErrorJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		Context("success cases", func() {
+			It("should store the new secret hash, revoke the client's tokens and return the secret once", func() {
+				sut.ClientRepo.On("FindClientByClientID", ctx, "orders").Return(&client, nil)
+				sut.OpaqueToken.On("Generate").Return("new-secret", "new-hash", nil)
+				sut.UnitOfWork.On("WithTransaction", ctx).Return(nil)
+				sut.ClientRepo.On("UpdateClientSecret", ctx, "orders", "new-hash", mock.Anything).Return(true, nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, "orders", "").Return(nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.ClientID).To(Equal("orders"))
+				Expect(output.ClientSecret).To(Equal("new-secret"))
+				Expect(sut.Denylist.AssertCalled(GinkgoT(), "RevokeUserTokens", ctx, "orders", "")).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should return not found for an unknown client", func() {
+				sut.ClientRepo.On("FindClientByClientID", ctx, "orders").Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrNotFound))
+				Expect(sut.OpaqueToken.AssertNotCalled(GinkgoT(), "Generate")).To(BeTrue())
+			})
+
+			It("should refuse to give a public client a secret", func() {
+				public := entity.BuilderOAuthClient().WithClientID("orders").Build()
+				sut.ClientRepo.On("FindClientByClientID", ctx, "orders").Return(&public, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorPublicOAuthClientSecret("orders")))
+			})
+
+			It("should return the denylist error so the previous secret is kept", func() {
+				denylistErr := errors.ErrorSetCache(assert.AnError)
+				sut.ClientRepo.On("FindClientByClientID", ctx, "orders").Return(&client, nil)
+				sut.OpaqueToken.On("Generate").Return("new-secret", "new-hash", nil)
+				sut.UnitOfWork.On("WithTransaction", ctx).Return(nil)
+				sut.ClientRepo.On("UpdateClientSecret", ctx, "orders", "new-hash", mock.Anything).Return(true, nil)
+				sut.Denylist.On("RevokeUserTokens", ctx, "orders", "").Return(denylistErr)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(denylistErr))
+			})
+		})
+	})
+})
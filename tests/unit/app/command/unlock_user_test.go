@@ -0,0 +1,77 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: UNLOCK_USER", func() {
+	Describe("#Execute", func() {
+		const publicID = "123e4567-e89b-12d3-a456-426614174000"
+
+		var (
+			ctx  context.Context
+			user entity.User
+			sut  *suts.UnlockUserSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID(publicID).
+				WithEmail("user@example.com").
+				Build()
+
+			sut = suts.MakeUnlockUserSut()
+			sut.Tracer.On("Start", ctx, "UnlockUser.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		It("should lift the lockouts of the user's account", func() {
+			sut.UserRepo.On("FindUserByPublicID", ctx, publicID).Return(&user, nil)
+			sut.Throttle.On("Unlock", ctx, "user@example.com").Return(nil)
+
+			err := sut.Build().Execute(ctx, dto.UnlockUserInput{PublicID: publicID})
+
+			Expect(err).To(BeNil())
+			sut.Throttle.AssertNumberOfCalls(GinkgoT(), "Unlock", 1)
+		})
+
+		It("should answer not found for unknown users", func() {
+			sut.UserRepo.On("FindUserByPublicID", ctx, publicID).Return(nil, nil)
+
+			err := sut.Build().Execute(ctx, dto.UnlockUserInput{PublicID: publicID})
+
+			Expect(err.Code).To(Equal(errors.ErrNotFound))
+			sut.Throttle.AssertNotCalled(GinkgoT(), "Unlock", mock.Anything, mock.Anything)
+		})
+
+		It("should return the throttle error", func() {
+			cacheErr := errors.ErrorDeleteCacheByPrefix(assert.AnError)
+			sut.UserRepo.On("FindUserByPublicID", ctx, publicID).Return(&user, nil)
+			sut.Throttle.On("Unlock", ctx, "user@example.com").Return(cacheErr)
+			sut.Log.On("ErrorJSON", "Error unlocking user", mock.Anything).Return()
+
+			err := sut.Build().Execute(ctx, dto.UnlockUserInput{PublicID: publicID})
+
+			Expect(err).To(Equal(cacheErr))
+		})
+	})
+})
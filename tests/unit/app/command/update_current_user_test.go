@@ -0,0 +1,98 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: UPDATE_CURRENT_USER", func() {
+	Describe("#Execute", func() {
+		const publicID = "123e4567-e89b-12d3-a456-426614174000"
+
+		var (
+			ctx  context.Context
+			user entity.User
+			sut  *suts.UpdateCurrentUserSut
+		)
+
+		strPtr := func(s string) *string { return &s }
+
+		BeforeEach(func() {
+			ctx = vo.WithPrincipal(context.Background(), vo.Principal{PublicID: publicID, Role: "user"})
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID(publicID).
+				WithEmail("user@example.com").
+				WithName("Old Name").
+				WithRole(entity.RoleUser).
+				WithAvatarURL("https://cdn.example.com/old.png").
+				Build()
+
+			sut = suts.MakeUpdateCurrentUserSut()
+			sut.Tracer.On("Start", ctx, "UpdateCurrentUser.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Repo.On("FindUserByPublicID", ctx, publicID).Return(&user, nil)
+		})
+
+		Context("success cases", func() {
+			It("should update only the provided fields", func() {
+				sut.Repo.On("UpdateUser", ctx, mock.MatchedBy(func(u entity.User) bool {
+					return u.Name() == "New Name" && u.AvatarURL() == "https://cdn.example.com/old.png"
+				})).Return(func() *entity.User {
+					updated := user
+					updated.AssignName("New Name")
+					updated.AssignUpdateAT(time.Now().UTC())
+					return &updated
+				}(), nil)
+
+				output, err := sut.Build().Execute(ctx, dto.UpdateCurrentUserInput{Name: strPtr("  New Name ")})
+
+				Expect(err).To(BeNil())
+				Expect(output.Name).To(Equal("New Name"))
+				Expect(output.AvatarURL).To(Equal("https://cdn.example.com/old.png"))
+			})
+
+			It("should not touch the database when nothing changes", func() {
+				output, err := sut.Build().Execute(ctx, dto.UpdateCurrentUserInput{})
+
+				Expect(err).To(BeNil())
+				Expect(output.Name).To(Equal("Old Name"))
+				Expect(sut.Repo.AssertNotCalled(GinkgoT(), "UpdateUser", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject a blank name and an invalid avatar URL", func() {
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "User profile validation failed", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, dto.UpdateCurrentUserInput{
+					Name:      strPtr(" "),
+					AvatarURL: strPtr("javascript:alert(1)"),
+				})
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ValidationCode))
+				Expect(err.Fields).To(HaveKey("name"))
+				Expect(err.Fields).To(HaveKey("avatar_url"))
+				Expect(sut.Repo.AssertNotCalled(GinkgoT(), "UpdateUser", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,99 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: VERIFY_EMAIL", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx   context.Context
+			input dto.VerifyEmailInput
+			user  entity.User
+			sut   *suts.VerifyEmailSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.VerifyEmailInput{
+				PublicID:  "123e4567-e89b-12d3-a456-426614174000",
+				Expires:   "1893456000",
+				Signature: "signature",
+			}
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID(input.PublicID).
+				WithEmail("user@example.com").
+				Build()
+
+			sut = suts.MakeVerifyEmailSut()
+			sut.Tracer.On("Start", ctx, "VerifyEmail.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		Context("success cases", func() {
+			It("should mark the e-mail of a valid link as verified", func() {
+				sut.UserRepo.On("FindUserByPublicID", ctx, input.PublicID).Return(&user, nil)
+				sut.EmailVerification.On("IsValidLink", &user, time.Unix(1893456000, 0), "signature").Return(true)
+				sut.UserRepo.On("MarkEmailVerified", ctx, int64(1), "user@example.com", mock.AnythingOfType("time.Time")).Return(true, nil)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				sut.UserRepo.AssertNumberOfCalls(GinkgoT(), "MarkEmailVerified", 1)
+			})
+
+			It("should accept a link opened again", func() {
+				verifiedAt := time.Now().UTC()
+				user.AssignEmailVerifiedAt(&verifiedAt)
+				sut.UserRepo.On("FindUserByPublicID", ctx, input.PublicID).Return(&user, nil)
+				sut.EmailVerification.On("IsValidLink", &user, mock.Anything, "signature").Return(true)
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "MarkEmailVerified", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject a tampered link", func() {
+				sut.UserRepo.On("FindUserByPublicID", ctx, input.PublicID).Return(&user, nil)
+				sut.EmailVerification.On("IsValidLink", &user, mock.Anything, "signature").Return(false)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid e-mail verification link", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err.Code).To(Equal(errors.ErrBadRequest))
+				sut.UserRepo.AssertNotCalled(GinkgoT(), "MarkEmailVerified", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should reject links of unknown users", func() {
+				sut.UserRepo.On("FindUserByPublicID", ctx, input.PublicID).Return(nil, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid e-mail verification link", mock.Anything).Return()
+
+				err := sut.Build().Execute(ctx, input)
+
+				Expect(err.Code).To(Equal(errors.ErrBadRequest))
+			})
+		})
+	})
+})
@@ -0,0 +1,104 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/internal/infra/logger"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: VERIFY_MFA_LOGIN", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx   context.Context
+			input dto.VerifyMFALoginInput
+			user  entity.User
+			sut   *suts.VerifyMFALoginSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.VerifyMFALoginInput{MFAToken: "mfa-token", Code: "123456"}
+			user = entity.BuilderUser().
+				WithID(7).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				Build()
+
+			sut = suts.MakeVerifyMFALoginSut()
+			sut.Tracer.On("Start", ctx, "VerifyMFALogin.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Log.On("WarnJSON", mock.Anything, mock.Anything).Return()
+		})
+
+		Context("success cases", func() {
+			It("should issue the tokens once the second factor is verified", func() {
+				expiresAt := time.Date(2025, 8, 4, 10, 0, 0, 0, time.UTC)
+				sut.MFAService.On("VerifyChallenge", ctx, "mfa-token", "123456").Return(int64(7), nil)
+				sut.Repo.On("FindUserByID", ctx, int64(7)).Return(&user, nil)
+				sut.TokenService.On("IssueTokens", ctx, &user, "").Return(&vo.AuthTokens{
+					Access:           vo.TokenClaims{Token: "signed-token", ExpiresAt: expiresAt},
+					RefreshToken:     "refresh-token",
+					RefreshExpiresAt: expiresAt.Add(time.Hour),
+				}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.AccessToken).To(Equal("signed-token"))
+				Expect(output.RefreshToken).To(Equal("refresh-token"))
+				Expect(sut.Log.AssertCalled(GinkgoT(), "InfoJSON", "Verifying MFA login", mock.MatchedBy(func(m map[string]any) bool {
+					body, ok := m["body"].(dto.VerifyMFALoginInput)
+					return ok && body.MFAToken == logger.Mask && body.Code == logger.Mask
+				}))).To(BeTrue())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should not issue tokens when the code is wrong", func() {
+				sut.MFAService.On("VerifyChallenge", ctx, "mfa-token", "123456").Return(int64(0), errors.ErrorInvalidMFACode())
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidMFACode()))
+				Expect(sut.TokenService.AssertNotCalled(GinkgoT(), "IssueTokens", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject a request without an MFA token", func() {
+				input.MFAToken = ""
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidMFAToken()))
+				Expect(sut.MFAService.AssertNotCalled(GinkgoT(), "VerifyChallenge", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject the challenge of a user that no longer exists", func() {
+				sut.MFAService.On("VerifyChallenge", ctx, "mfa-token", "123456").Return(int64(7), nil)
+				sut.Repo.On("FindUserByID", ctx, int64(7)).Return(nil, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidMFAToken()))
+			})
+		})
+	})
+})
@@ -0,0 +1,104 @@
+//go:build unit
+
+package command_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: COMMAND :: VERIFY_PASSWORD_RESET_CODE", func() {
+	Describe("#Execute", func() {
+		const phone = "+5511912345678"
+
+		var (
+			ctx   context.Context
+			input dto.VerifyPasswordResetCodeInput
+			user  entity.User
+			sut   *suts.VerifyPasswordResetCodeSut
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			input = dto.VerifyPasswordResetCodeInput{Phone: phone, Code: "123456"}
+			user = entity.BuilderUser().
+				WithID(1).
+				WithPublicID("123e4567-e89b-12d3-a456-426614174000").
+				WithPhone(phone).
+				Build()
+
+			sut = suts.MakeVerifyPasswordResetCodeSut()
+			sut.Tracer.On("Start", ctx, "VerifyPasswordResetCode.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+			sut.Log.On("InfoJSON", mock.Anything, mock.Anything).Return()
+			sut.Uow.On("WithTransaction", ctx).Return(nil)
+		})
+
+		Context("success cases", func() {
+			It("should exchange a valid code for a reset token", func() {
+				sut.OTPStore.On("Verify", ctx, "password_reset:"+phone, "123456").Return(true, nil)
+				sut.UserRepo.On("FindUserByVerifiedPhone", ctx, phone).Return(&user, nil)
+				sut.OpaqueToken.On("Generate").Return("raw-token", "token-hash", nil)
+				sut.TokenRepo.On("InvalidateOneTimeTokens", ctx, int64(1), entity.TokenPurposePasswordReset).Return(nil)
+				sut.TokenRepo.On("CreateOneTimeToken", ctx, mock.MatchedBy(func(token entity.OneTimeToken) bool {
+					return token.TokenHash() == "token-hash" && token.UserID() == 1
+				})).Return(&entity.OneTimeToken{}, nil)
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(output.ResetToken).To(Equal("raw-token"))
+				Expect(output.ExpiresAt).NotTo(BeEmpty())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject a wrong code", func() {
+				sut.OTPStore.On("Verify", ctx, "password_reset:"+phone, "123456").Return(false, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid password reset code", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrBadRequest))
+				sut.OpaqueToken.AssertNotCalled(GinkgoT(), "Generate")
+			})
+
+			It("should surface the lockout after too many attempts", func() {
+				lockoutErr := errors.ErrorTooManyOTPAttempts()
+				sut.OTPStore.On("Verify", ctx, "password_reset:"+phone, "123456").Return(false, lockoutErr)
+				sut.Span.On("RecordError", lockoutErr).Return()
+				sut.Log.On("WarnJSON", "Error verifying password reset code", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrTooManyRequests))
+			})
+
+			It("should reject the code when the phone no longer belongs to an account", func() {
+				sut.OTPStore.On("Verify", ctx, "password_reset:"+phone, "123456").Return(true, nil)
+				sut.UserRepo.On("FindUserByVerifiedPhone", ctx, phone).Return(nil, nil)
+				sut.Span.On("RecordError", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, input)
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrBadRequest))
+				sut.TokenRepo.AssertNotCalled(GinkgoT(), "CreateOneTimeToken", mock.Anything, mock.Anything)
+			})
+		})
+	})
+})
@@ -0,0 +1,113 @@
+//go:build unit
+
+package query_test
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: QUERY :: DOWNLOAD_DATA_EXPORT", func() {
+	Describe("#Execute", func() {
+		const exportID = "0b7f1c7e-3f5a-4c1e-9d1a-2f8a6c3b9e10"
+
+		var (
+			ctx       context.Context
+			sut       *suts.DownloadDataExportSut
+			input     dto.DownloadDataExportInput
+			linkUntil time.Time
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			linkUntil = time.Unix(time.Now().Add(10*time.Minute).Unix(), 0)
+			input = dto.DownloadDataExportInput{
+				ExportID:  exportID,
+				Expires:   strconv.FormatInt(linkUntil.Unix(), 10),
+				Signature: "signature",
+			}
+
+			sut = suts.MakeDownloadDataExportSut()
+			sut.Tracer.On("Start", ctx, "DownloadDataExport.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+		})
+
+		Context("success cases", func() {
+			It("should return the bundle of a valid link", func() {
+				expiresAt := time.Now().Add(time.Hour)
+				export := entity.BuilderDataExport().
+					WithPublicID(exportID).
+					WithFormat(entity.ExportFormatZIP).
+					WithStatus(entity.ExportStatusReady).
+					WithPayload([]byte("bundle")).
+					WithExpiresAt(&expiresAt).
+					Build()
+				sut.Signer.On("Verify", "data_export:"+exportID, linkUntil, "signature").Return(true)
+				sut.Repo.On("FindDataExportWithPayload", ctx, exportID).Return(&export, nil)
+
+				file, err := sut.Build().Execute(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(file.FileName).To(Equal("personal-data-" + exportID + ".zip"))
+				Expect(file.ContentType).To(Equal("application/zip"))
+				Expect(file.Content).To(Equal([]byte("bundle")))
+			})
+		})
+
+		Context("error cases", func() {
+			BeforeEach(func() {
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "Invalid data export download link", mock.Anything).Return()
+			})
+
+			It("should reject a tampered signature without loading the export", func() {
+				sut.Signer.On("Verify", "data_export:"+exportID, linkUntil, "signature").Return(false)
+
+				file, err := sut.Build().Execute(ctx, input)
+
+				Expect(file).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidDownloadLink(exportID)))
+				sut.Repo.AssertNotCalled(GinkgoT(), "FindDataExportWithPayload", mock.Anything, mock.Anything)
+			})
+
+			It("should reject a malformed expiry", func() {
+				input.Expires = "tomorrow"
+
+				file, err := sut.Build().Execute(ctx, input)
+
+				Expect(file).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorInvalidDownloadLink(exportID)))
+				sut.Signer.AssertNotCalled(GinkgoT(), "Verify", mock.Anything, mock.Anything, mock.Anything)
+			})
+
+			It("should not serve an expired export", func() {
+				expiredAt := time.Now().Add(-time.Minute)
+				export := entity.BuilderDataExport().
+					WithPublicID(exportID).
+					WithStatus(entity.ExportStatusReady).
+					WithExpiresAt(&expiredAt).
+					Build()
+				sut.Signer.On("Verify", "data_export:"+exportID, linkUntil, "signature").Return(true)
+				sut.Repo.On("FindDataExportWithPayload", ctx, exportID).Return(&export, nil)
+
+				file, err := sut.Build().Execute(ctx, input)
+
+				Expect(file).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorDataExportNotFound(exportID)))
+			})
+		})
+	})
+})
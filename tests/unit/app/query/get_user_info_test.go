@@ -0,0 +1,95 @@
+//go:build unit
+
+package query_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: QUERY :: GET_USER_INFO", func() {
+	Describe("#Execute", func() {
+		const publicID = "123e4567-e89b-12d3-a456-426614174000"
+
+		var (
+			user entity.User
+			sut  *suts.GetUserInfoSut
+		)
+
+		withScopes := func(scopes ...string) context.Context {
+			ctx := vo.WithPrincipal(context.Background(), vo.Principal{
+				PublicID: publicID,
+				ClientID: "client-1",
+				Scopes:   scopes,
+			})
+			sut.Tracer.On("Start", ctx, "GetUserInfo.Execute").Return(ctx, adapter.Span(sut.Span))
+			return ctx
+		}
+
+		BeforeEach(func() {
+			verifiedAt := time.Now()
+			user = entity.BuilderUser().
+				WithID(7).
+				WithPublicID(publicID).
+				WithEmail("user@example.com").
+				WithEmailVerifiedAt(&verifiedAt).
+				WithName("Jane Doe").
+				WithAvatarURL("https://cdn.example.com/jane.png").
+				Build()
+
+			sut = suts.MakeGetUserInfoSut()
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Span.On("RecordError", mock.Anything).Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+		})
+
+		Context("success cases", func() {
+			It("should release only the subject with the openid scope alone", func() {
+				ctx := withScopes(entity.ScopeOpenID, "profile:read")
+				sut.Repo.On("FindUserByPublicID", ctx, publicID).Return(&user, nil)
+
+				output, err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(*output).To(Equal(vo.UserInfo{Subject: publicID}))
+			})
+
+			It("should release the e-mail and profile claims granted by their scopes", func() {
+				ctx := withScopes(entity.ScopeOpenID, entity.ScopeEmail, entity.ScopeProfile)
+				sut.Repo.On("FindUserByPublicID", ctx, publicID).Return(&user, nil)
+
+				output, err := sut.Build().Execute(ctx)
+
+				Expect(err).To(BeNil())
+				Expect(output.Subject).To(Equal(publicID))
+				Expect(output.Email).To(Equal("user@example.com"))
+				Expect(*output.EmailVerified).To(BeTrue())
+				Expect(output.Name).To(Equal("Jane Doe"))
+				Expect(output.Picture).To(Equal("https://cdn.example.com/jane.png"))
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject tokens not granted the openid scope", func() {
+				ctx := withScopes(entity.ScopeEmail)
+
+				output, err := sut.Build().Execute(ctx)
+
+				Expect(output).To(BeNil())
+				Expect(err).To(Equal(errors.ErrorMissingScope(entity.ScopeOpenID)))
+				Expect(sut.Repo.AssertNotCalled(GinkgoT(), "FindUserByPublicID", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+		})
+	})
+})
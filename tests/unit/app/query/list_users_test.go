@@ -0,0 +1,121 @@
+//go:build unit
+
+package query_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/app/dto"
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: QUERY :: LIST_USERS", func() {
+	Describe("#Execute", func() {
+		var (
+			ctx context.Context
+			sut *suts.ListUsersSut
+		)
+
+		buildUsers := func(ids ...int64) []entity.User {
+			users := make([]entity.User, 0, len(ids))
+			for _, id := range ids {
+				users = append(users, entity.BuilderUser().
+					WithID(id).
+					WithEmail("user@example.com").
+					WithRole(entity.RoleUser).
+					WithCreateAT(time.Date(2026, 1, 1, 0, 0, int(id), 0, time.UTC)).
+					Build())
+			}
+			return users
+		}
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			sut = suts.MakeListUsersSut()
+			sut.Tracer.On("Start", ctx, "ListUsers.Execute").Return(ctx, adapter.Span(sut.Span))
+			sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+			sut.Span.On("End").Return()
+			sut.Sc.On("TraceID").Return("trace-123")
+		})
+
+		Context("success cases", func() {
+			It("should return a next cursor only when another page exists", func() {
+				sut.Repo.On("SearchUsers", ctx, mock.MatchedBy(func(s vo.UserSearch) bool {
+					return s.Limit == 2 && s.Descending && s.Cursor == nil && s.Deleted != nil && !*s.Deleted
+				})).Return(buildUsers(3, 2, 1), nil)
+
+				output, err := sut.Build().Execute(ctx, dto.ListUsersInput{Limit: "2"})
+
+				Expect(err).To(BeNil())
+				Expect(output.Users).To(HaveLen(2))
+				Expect(output.Pagination.PrevCursor).To(BeNil())
+				Expect(output.Pagination.NextCursor).ToNot(BeNil())
+
+				cursor, decodeErr := vo.DecodeUserCursor(*output.Pagination.NextCursor)
+				Expect(decodeErr).To(BeNil())
+				Expect(cursor.ID).To(Equal(int64(2)))
+				Expect(cursor.Direction).To(Equal(vo.CursorNext))
+				Expect(cursor.Sort).To(Equal("-created_at"))
+			})
+
+			It("should restore the requested order when walking backwards", func() {
+				prev := vo.UserCursor{Sort: "-created_at", Value: "2026-01-01T00:00:05Z", ID: 5, Direction: vo.CursorPrev}
+				sut.Repo.On("SearchUsers", ctx, mock.MatchedBy(func(s vo.UserSearch) bool {
+					return s.Ascending() && s.Cursor != nil && s.Cursor.ID == 5
+				})).Return(buildUsers(6, 7), nil)
+
+				output, err := sut.Build().Execute(ctx, dto.ListUsersInput{Limit: "2", Cursor: prev.Encode()})
+
+				Expect(err).To(BeNil())
+				Expect(output.Users).To(HaveLen(2))
+				Expect(output.Pagination.PrevCursor).To(BeNil())
+				next, _ := vo.DecodeUserCursor(*output.Pagination.NextCursor)
+				Expect(next.ID).To(Equal(int64(6)))
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject invalid filters without querying the repository", func() {
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "User search validation failed", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, dto.ListUsersInput{
+					Role:        "root",
+					CreatedFrom: "yesterday",
+					Deleted:     "maybe",
+					Sort:        "name",
+					Limit:       "500",
+				})
+
+				Expect(output).To(BeNil())
+				Expect(err.Code).To(Equal(errors.ErrBadRequest))
+				Expect(err.Fields).To(HaveKey("role"))
+				Expect(err.Fields).To(HaveKey("created_from"))
+				Expect(err.Fields).To(HaveKey("deleted"))
+				Expect(err.Fields).To(HaveKey("sort"))
+				Expect(err.Fields).To(HaveKey("limit"))
+				Expect(sut.Repo.AssertNotCalled(GinkgoT(), "SearchUsers", mock.Anything, mock.Anything)).To(BeTrue())
+			})
+
+			It("should reject a cursor issued for another sort", func() {
+				cursor := vo.UserCursor{Sort: "email", Value: "a@example.com", ID: 1, Direction: vo.CursorNext}
+				sut.Span.On("RecordError", mock.Anything).Return()
+				sut.Log.On("WarnJSON", "User search validation failed", mock.Anything).Return()
+
+				output, err := sut.Build().Execute(ctx, dto.ListUsersInput{Cursor: cursor.Encode()})
+
+				Expect(output).To(BeNil())
+				Expect(err.Fields).To(HaveKey("cursor"))
+			})
+		})
+	})
+})
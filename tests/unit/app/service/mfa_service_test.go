@@ -0,0 +1,206 @@
+//go:build unit
+
+package service_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: SERVICE :: MFA_SERVICE", func() {
+	const (
+		userID    = int64(7)
+		secret    = "JBSWY3DPEHPK3PXP"
+		challenge = "auth:mfa:challenge:token-hash"
+	)
+
+	var (
+		ctx       context.Context
+		confirmed entity.UserMFA
+		sut       *suts.MFAServiceSut
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		confirmedAt := time.Now().Add(-time.Hour)
+		confirmed = entity.BuilderUserMFA().
+			WithUserID(userID).
+			WithTOTPSecret(secret).
+			WithConfirmedAt(&confirmedAt).
+			Build()
+
+		sut = suts.MakeMFAServiceSut()
+		sut.Tracer.On("Start", ctx, mock.Anything).Return(ctx, adapter.Span(sut.Span))
+		sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+		sut.Span.On("End").Return()
+		sut.Span.On("RecordError", mock.Anything).Return()
+		sut.Sc.On("TraceID").Return("trace-123")
+		sut.Log.On("WarnJSON", mock.Anything, mock.Anything).Return()
+	})
+
+	Describe("#StartChallenge", func() {
+		It("should not challenge a user without a confirmed enrollment", func() {
+			user := entity.BuilderUser().WithID(userID).Build()
+			sut.MFARepo.On("FindUserMFA", ctx, userID).Return(nil, nil)
+
+			result, err := sut.Build().StartChallenge(ctx, &user)
+
+			Expect(err).To(BeNil())
+			Expect(result).To(BeNil())
+			Expect(sut.Cache.AssertNotCalled(GinkgoT(), "Set", mock.Anything, mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("should store the user of the challenge under the token hash", func() {
+			user := entity.BuilderUser().WithID(userID).Build()
+			sut.MFARepo.On("FindUserMFA", ctx, userID).Return(&confirmed, nil)
+			sut.OpaqueToken.On("Generate").Return("mfa-token", "token-hash", nil)
+			sut.Cache.On("Set", ctx, challenge, userID, 300).Return(nil)
+
+			result, err := sut.Build().StartChallenge(ctx, &user)
+
+			Expect(err).To(BeNil())
+			Expect(result.Token).To(Equal("mfa-token"))
+			Expect(result.ExpiresAt).To(BeTemporally("~", time.Now().Add(5*time.Minute), time.Second))
+		})
+	})
+
+	Describe("#VerifyChallenge", func() {
+		BeforeEach(func() {
+			sut.OpaqueToken.On("Hash", "mfa-token").Return("token-hash")
+		})
+
+		It("should return the user and close the challenge when the code is valid", func() {
+			sut.Cache.On("Increment", ctx, challenge+":attempts", 300).Return(int64(1), nil)
+			sut.Cache.On("Get", ctx, challenge, mock.Anything).Run(func(args mock.Arguments) {
+				*args.Get(2).(*int64) = userID
+			}).Return(true, nil)
+			sut.MFARepo.On("FindUserMFA", ctx, userID).Return(&confirmed, nil)
+			sut.TOTP.On("Match", secret, "123456", mock.Anything).Return(int64(42), true)
+			sut.MFARepo.On("RecordTOTPStep", ctx, userID, int64(42)).Return(true, nil)
+			sut.Cache.On("Delete", ctx, challenge).Return(nil)
+			sut.Cache.On("Delete", ctx, challenge+":attempts").Return(nil)
+
+			id, err := sut.Build().VerifyChallenge(ctx, "mfa-token", "123456")
+
+			Expect(err).To(BeNil())
+			Expect(id).To(Equal(userID))
+			Expect(sut.Cache.AssertCalled(GinkgoT(), "Delete", ctx, challenge)).To(BeTrue())
+		})
+
+		It("should reject a TOTP code that was already used", func() {
+			sut.Cache.On("Increment", ctx, challenge+":attempts", 300).Return(int64(1), nil)
+			sut.Cache.On("Get", ctx, challenge, mock.Anything).Run(func(args mock.Arguments) {
+				*args.Get(2).(*int64) = userID
+			}).Return(true, nil)
+			sut.MFARepo.On("FindUserMFA", ctx, userID).Return(&confirmed, nil)
+			sut.TOTP.On("Match", secret, "123456", mock.Anything).Return(int64(42), true)
+			sut.MFARepo.On("RecordTOTPStep", ctx, userID, int64(42)).Return(false, nil)
+
+			_, err := sut.Build().VerifyChallenge(ctx, "mfa-token", "123456")
+
+			Expect(err).To(Equal(errors.ErrorInvalidMFACode()))
+			Expect(sut.Cache.AssertNotCalled(GinkgoT(), "Delete", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("should accept an unused recovery code typed with other case and separators", func() {
+			sut.Cache.On("Increment", ctx, challenge+":attempts", 300).Return(int64(1), nil)
+			sut.Cache.On("Get", ctx, challenge, mock.Anything).Run(func(args mock.Arguments) {
+				*args.Get(2).(*int64) = userID
+			}).Return(true, nil)
+			sut.MFARepo.On("FindUserMFA", ctx, userID).Return(&confirmed, nil)
+			sut.TOTP.On("Match", secret, "ABCDE-FGHJK", mock.Anything).Return(int64(0), false)
+			sut.OpaqueToken.On("Hash", "abcdefghjk").Return("code-hash")
+			sut.RecoveryRepo.On("ConsumeRecoveryCode", ctx, userID, "code-hash", mock.Anything).Return(true, nil)
+			sut.Cache.On("Delete", ctx, mock.Anything).Return(nil)
+
+			id, err := sut.Build().VerifyChallenge(ctx, "mfa-token", "ABCDE-FGHJK")
+
+			Expect(err).To(BeNil())
+			Expect(id).To(Equal(userID))
+		})
+
+		It("should reject an unknown or expired challenge", func() {
+			sut.Cache.On("Increment", ctx, challenge+":attempts", 300).Return(int64(1), nil)
+			sut.Cache.On("Get", ctx, challenge, mock.Anything).Return(false, nil)
+
+			_, err := sut.Build().VerifyChallenge(ctx, "mfa-token", "123456")
+
+			Expect(err).To(Equal(errors.ErrorInvalidMFAToken()))
+			Expect(sut.MFARepo.AssertNotCalled(GinkgoT(), "FindUserMFA", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("should drop the challenge after too many attempts", func() {
+			sut.Cache.On("Increment", ctx, challenge+":attempts", 300).Return(int64(4), nil)
+			sut.Cache.On("Delete", ctx, challenge).Return(nil)
+
+			_, err := sut.Build().VerifyChallenge(ctx, "mfa-token", "123456")
+
+			Expect(err).To(Equal(errors.ErrorTooManyMFAAttempts()))
+			Expect(sut.Cache.AssertCalled(GinkgoT(), "Delete", ctx, challenge)).To(BeTrue())
+			Expect(sut.TOTP.AssertNotCalled(GinkgoT(), "Match", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("#VerifyCode", func() {
+		It("should fail when the user has no confirmed enrollment", func() {
+			pending := entity.BuilderUserMFA().WithUserID(userID).WithTOTPSecret(secret).Build()
+			sut.MFARepo.On("FindUserMFA", ctx, userID).Return(&pending, nil)
+
+			valid, err := sut.Build().VerifyCode(ctx, userID, "123456")
+
+			Expect(valid).To(BeFalse())
+			Expect(err).To(Equal(errors.ErrorMFANotEnabled()))
+		})
+	})
+
+	Describe("#VerifyUserCode", func() {
+		const attempts = "auth:mfa:user:7:attempts"
+
+		It("should clear the attempts of the user once a code is accepted", func() {
+			sut.Cache.On("Increment", ctx, attempts, 300).Return(int64(2), nil)
+			sut.MFARepo.On("FindUserMFA", ctx, userID).Return(&confirmed, nil)
+			sut.TOTP.On("Match", secret, "123456", mock.Anything).Return(int64(42), true)
+			sut.MFARepo.On("RecordTOTPStep", ctx, userID, int64(42)).Return(true, nil)
+			sut.Cache.On("Delete", ctx, attempts).Return(nil)
+
+			valid, err := sut.Build().VerifyUserCode(ctx, userID, "123456")
+
+			Expect(err).To(BeNil())
+			Expect(valid).To(BeTrue())
+			Expect(sut.Cache.AssertCalled(GinkgoT(), "Delete", ctx, attempts)).To(BeTrue())
+		})
+
+		It("should stop checking codes after too many attempts", func() {
+			sut.Cache.On("Increment", ctx, attempts, 300).Return(int64(4), nil)
+
+			valid, err := sut.Build().VerifyUserCode(ctx, userID, "123456")
+
+			Expect(valid).To(BeFalse())
+			Expect(err).To(Equal(errors.ErrorTooManyMFACodeAttempts()))
+			Expect(sut.MFARepo.AssertNotCalled(GinkgoT(), "FindUserMFA", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("#IssueRecoveryCodes", func() {
+		It("should store only the hashes of the normalized codes", func() {
+			sut.TOTP.On("GenerateRecoveryCodes", 2).Return([]string{"abcde-fghjk", "mnpqr-stuvw"}, nil)
+			sut.OpaqueToken.On("Hash", "abcdefghjk").Return("hash-1")
+			sut.OpaqueToken.On("Hash", "mnpqrstuvw").Return("hash-2")
+			sut.RecoveryRepo.On("ReplaceRecoveryCodes", ctx, userID, []string{"hash-1", "hash-2"}).Return(nil)
+
+			codes, err := sut.Build().IssueRecoveryCodes(ctx, userID)
+
+			Expect(err).To(BeNil())
+			Expect(codes).To(Equal([]string{"abcde-fghjk", "mnpqr-stuvw"}))
+		})
+	})
+})
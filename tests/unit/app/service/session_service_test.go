@@ -0,0 +1,172 @@
+//go:build unit
+
+package service_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/errors"
+	"github.com/andreis3/auth-ms/internal/domain/interfaces/adapter"
+	"github.com/andreis3/auth-ms/internal/domain/vo"
+	"github.com/andreis3/auth-ms/tests/suts"
+)
+
+var _ = Describe("INTERNAL :: APP :: SERVICE :: SESSION_SERVICE", func() {
+	const (
+		userID    = int64(7)
+		sessionID = "5f0c8a36-6d4e-4b8e-9a57-2f1c3d4e5a6b"
+		userAgent = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"
+	)
+
+	var (
+		ctx context.Context
+		sut *suts.SessionServiceSut
+	)
+
+	BeforeEach(func() {
+		ctx = vo.WithUserAgent(vo.WithClientIP(context.Background(), "203.0.113.7"), userAgent)
+
+		sut = suts.MakeSessionServiceSut()
+		sut.Tracer.On("Start", ctx, mock.Anything).Return(ctx, adapter.Span(sut.Span))
+		sut.Span.On("SpanContext").Return(adapter.SpanContext(sut.Sc))
+		sut.Span.On("End").Return()
+		sut.Span.On("RecordError", mock.Anything).Return()
+		sut.Sc.On("TraceID").Return("trace-123")
+		sut.Log.On("WarnJSON", mock.Anything, mock.Anything).Return()
+		sut.Log.On("ErrorJSON", mock.Anything, mock.Anything).Return()
+	})
+
+	Describe("#StartSession", func() {
+		It("should record the device and address of the sign-in", func() {
+			seenAt := time.Now().UTC()
+			created := entity.BuilderSession().
+				WithUserID(userID).
+				WithFamilyID(sessionID).
+				WithIPAddress("203.0.113.7").
+				WithLastSeenAt(seenAt).
+				Build()
+			sut.SessionRepo.On("CreateSession", ctx, mock.MatchedBy(func(s entity.Session) bool {
+				return s.UserID() == userID && s.FamilyID() == sessionID &&
+					s.DeviceName() == "Chrome on macOS" &&
+					s.UserAgent() == userAgent &&
+					s.IPAddress() == "203.0.113.7"
+			})).Return(&created, nil)
+			sut.Cache.On("Set", ctx, sessionID, vo.SessionActivity{UserID: userID, IPAddress: "203.0.113.7", LastSeenAt: seenAt}).Return(nil)
+
+			err := sut.Build().StartSession(ctx, userID, sessionID)
+
+			Expect(err).To(BeNil())
+			Expect(sut.Cache.AssertNumberOfCalls(GinkgoT(), "Set", 1)).To(BeTrue())
+		})
+
+		It("should still succeed when the cache is unavailable", func() {
+			created := entity.BuilderSession().WithUserID(userID).WithFamilyID(sessionID).Build()
+			sut.SessionRepo.On("CreateSession", ctx, mock.Anything).Return(&created, nil)
+			sut.Cache.On("Set", ctx, sessionID, mock.Anything).Return(errors.ErrorSetCache(assert.AnError))
+
+			err := sut.Build().StartSession(ctx, userID, sessionID)
+
+			Expect(err).To(BeNil())
+		})
+
+		It("should return the repository error when the session cannot be created", func() {
+			repoErr := errors.ErrorCreateSession(assert.AnError)
+			sut.SessionRepo.On("CreateSession", ctx, mock.Anything).Return(nil, repoErr)
+
+			err := sut.Build().StartSession(ctx, userID, sessionID)
+
+			Expect(err).To(Equal(repoErr))
+			Expect(sut.Cache.AssertNotCalled(GinkgoT(), "Set", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+		})
+	})
+
+	Describe("#TouchSession", func() {
+		It("should not write to the database while the cached activity is fresh", func() {
+			sut.Cache.On("Get", ctx, sessionID).Return(&vo.SessionActivity{
+				UserID:     userID,
+				IPAddress:  "203.0.113.7",
+				LastSeenAt: time.Now().UTC().Add(-10 * time.Second),
+			}, nil)
+
+			err := sut.Build().TouchSession(ctx, sessionID)
+
+			Expect(err).To(BeNil())
+			Expect(sut.SessionRepo.AssertNotCalled(GinkgoT(), "TouchSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			Expect(sut.Cache.AssertNotCalled(GinkgoT(), "Set", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("should record the activity once the cached one is stale", func() {
+			sut.Cache.On("Get", ctx, sessionID).Return(&vo.SessionActivity{
+				UserID:     userID,
+				IPAddress:  "203.0.113.7",
+				LastSeenAt: time.Now().UTC().Add(-2 * time.Minute),
+			}, nil)
+			sut.SessionRepo.On("TouchSession", ctx, sessionID, "203.0.113.7", mock.Anything).Return(nil)
+			sut.Cache.On("Set", ctx, sessionID, mock.Anything).Return(nil)
+
+			err := sut.Build().TouchSession(ctx, sessionID)
+
+			Expect(err).To(BeNil())
+			Expect(sut.SessionRepo.AssertNumberOfCalls(GinkgoT(), "TouchSession", 1)).To(BeTrue())
+		})
+
+		It("should record the activity at once when the address changed", func() {
+			sut.Cache.On("Get", ctx, sessionID).Return(&vo.SessionActivity{
+				UserID:     userID,
+				IPAddress:  "198.51.100.1",
+				LastSeenAt: time.Now().UTC(),
+			}, nil)
+			sut.SessionRepo.On("TouchSession", ctx, sessionID, "203.0.113.7", mock.Anything).Return(nil)
+			sut.Cache.On("Set", ctx, sessionID, mock.MatchedBy(func(a vo.SessionActivity) bool {
+				return a.IPAddress == "203.0.113.7"
+			})).Return(nil)
+
+			err := sut.Build().TouchSession(ctx, sessionID)
+
+			Expect(err).To(BeNil())
+			Expect(sut.Cache.AssertNumberOfCalls(GinkgoT(), "Set", 1)).To(BeTrue())
+		})
+
+		It("should warm the cache from the database on a miss", func() {
+			stored := entity.BuilderSession().
+				WithUserID(userID).
+				WithFamilyID(sessionID).
+				WithIPAddress("203.0.113.7").
+				WithLastSeenAt(time.Now().UTC()).
+				Build()
+			sut.Cache.On("Get", ctx, sessionID).Return(nil, nil)
+			sut.SessionRepo.On("FindSessionByFamilyID", ctx, sessionID).Return(&stored, nil)
+			sut.Cache.On("Set", ctx, sessionID, mock.Anything).Return(nil)
+
+			err := sut.Build().TouchSession(ctx, sessionID)
+
+			Expect(err).To(BeNil())
+			Expect(sut.SessionRepo.AssertNotCalled(GinkgoT(), "TouchSession", mock.Anything, mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+			Expect(sut.Cache.AssertNumberOfCalls(GinkgoT(), "Set", 1)).To(BeTrue())
+		})
+
+		It("should ignore ids that are not session ids", func() {
+			err := sut.Build().TouchSession(ctx, "client-token")
+
+			Expect(err).To(BeNil())
+			Expect(sut.Cache.AssertNotCalled(GinkgoT(), "Get", mock.Anything, mock.Anything)).To(BeTrue())
+		})
+
+		It("should ignore sessions it does not know", func() {
+			sut.Cache.On("Get", ctx, sessionID).Return(nil, nil)
+			sut.SessionRepo.On("FindSessionByFamilyID", ctx, sessionID).Return(nil, nil)
+
+			err := sut.Build().TouchSession(ctx, sessionID)
+
+			Expect(err).To(BeNil())
+			Expect(sut.Cache.AssertNotCalled(GinkgoT(), "Set", mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,96 @@
+//go:build unit
+
+package entity_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+	"github.com/andreis3/auth-ms/internal/domain/validator"
+)
+
+var _ = Describe("INTERNAL :: DOMAIN :: ENTITY :: OAUTH_CLIENT", func() {
+	Describe("#Validate", func() {
+		Context("success cases", func() {
+			It("should accept a user-facing client with registered redirect URIs and known scopes", func() {
+				client := entity.BuilderOAuthClient().
+					WithName("Web App").
+					WithRedirectURIs([]string{"https://app.example.com/callback", "http://localhost:8080/cb"}).
+					WithScopes([]string{string(entity.PermissionProfileRead)}).
+					WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).
+					Build()
+
+				Expect(client.Validate().HasErrors()).To(BeFalse())
+			})
+
+			It("should accept a service client with its own scopes and audiences", func() {
+				client := entity.BuilderOAuthClient().
+					WithName("Orders").
+					WithSecretHash("secret-hash").
+					WithScopes([]string{"payments:charge"}).
+					WithAudiences([]string{"payments"}).
+					WithGrantTypes([]string{entity.GrantTypeClientCredentials}).
+					Build()
+
+				Expect(client.Validate().HasErrors()).To(BeFalse())
+			})
+		})
+
+		Context("error cases", func() {
+			It("should reject an unknown scope for a user-facing client", func() {
+				client := entity.BuilderOAuthClient().
+					WithName("Web App").
+					WithRedirectURIs([]string{"https://app.example.com/callback"}).
+					WithScopes([]string{"payments:charge"}).
+					WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).
+					Build()
+
+				Expect(client.Validate().FieldErrors["scopes"]).To(ContainElement(validator.ErrUnknownScope))
+			})
+
+			It("should reject a redirect URI over plain http outside loopback", func() {
+				client := entity.BuilderOAuthClient().
+					WithName("Web App").
+					WithRedirectURIs([]string{"http://app.example.com/callback"}).
+					WithGrantTypes([]string{entity.GrantTypeAuthorizationCode}).
+					Build()
+
+				Expect(client.Validate().FieldErrors["redirect_uris"]).To(ContainElement(validator.ErrInvalidRedirectURI))
+			})
+
+			It("should require a secret and an audience for a service client", func() {
+				client := entity.BuilderOAuthClient().
+					WithName("Orders").
+					WithScopes([]string{"payments:charge"}).
+					WithGrantTypes([]string{entity.GrantTypeClientCredentials}).
+					Build()
+
+				errs := client.Validate().FieldErrors
+				Expect(errs).To(HaveKey("client_secret"))
+				Expect(errs["audiences"]).To(ContainElement(validator.ErrNotBlank))
+			})
+
+			It("should reject scopes that cannot travel in a scope parameter", func() {
+				client := entity.BuilderOAuthClient().
+					WithName("Orders").
+					WithSecretHash("secret-hash").
+					WithScopes([]string{"payments charge"}).
+					WithAudiences([]string{"payments"}).
+					WithGrantTypes([]string{entity.GrantTypeClientCredentials}).
+					Build()
+
+				Expect(client.Validate().FieldErrors["scopes"]).To(ContainElement(validator.ErrInvalidScopeToken))
+			})
+
+			It("should reject unsupported grant types", func() {
+				client := entity.BuilderOAuthClient().
+					WithName("Legacy").
+					WithGrantTypes([]string{"password"}).
+					Build()
+
+				Expect(client.Validate().FieldErrors).To(HaveKey("grant_types"))
+			})
+		})
+	})
+})
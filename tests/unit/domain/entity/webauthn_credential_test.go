@@ -0,0 +1,39 @@
+//go:build unit
+
+package entity_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/andreis3/auth-ms/internal/domain/entity"
+)
+
+var _ = Describe("INTERNAL :: DOMAIN :: ENTITY :: WEBAUTHN_CREDENTIAL", func() {
+	Describe("#IsCloneSuspected", func() {
+		It("should trust a counter that moved forward", func() {
+			credential := entity.BuilderWebAuthnCredential().WithSignCount(10).Build()
+
+			Expect(credential.IsCloneSuspected(11)).To(BeFalse())
+		})
+
+		It("should suspect a counter that did not move forward", func() {
+			credential := entity.BuilderWebAuthnCredential().WithSignCount(10).Build()
+
+			Expect(credential.IsCloneSuspected(10)).To(BeTrue())
+			Expect(credential.IsCloneSuspected(3)).To(BeTrue())
+		})
+
+		It("should trust authenticators that keep no counter", func() {
+			credential := entity.BuilderWebAuthnCredential().Build()
+
+			Expect(credential.IsCloneSuspected(0)).To(BeFalse())
+		})
+
+		It("should suspect a counter reset after it started counting", func() {
+			credential := entity.BuilderWebAuthnCredential().WithSignCount(5).Build()
+
+			Expect(credential.IsCloneSuspected(0)).To(BeTrue())
+		})
+	})
+})